@@ -49,6 +49,10 @@ func NewAnteHandler(
 		// Ensure that the tx's gas price is >= the network minimum gas price.
 		// Side effect: deducts fees from the fee payer. Sets the tx priority in context.
 		ante.NewDeductFeeDecorator(accountKeeper, bankKeeper, feegrantKeeper, ValidateTxFeeWrapper(minfeeKeeper)),
+		// Burn or redistribute the part of the fee defined by the minfee fee split params.
+		// Side effect: consumes gas from the gas meter for the transfer or burn of the split
+		// and the update of the split totals once the split is enabled.
+		NewFeeSplitDecorator(minfeeKeeper),
 		// Set public keys in the context for fee-payer and all signers.
 		// Contract: must be called before all signature verification decorators.
 		ante.NewSetPubKeyDecorator(accountKeeper),
@@ -72,6 +76,10 @@ func NewAnteHandler(
 		// Ensure that the blob shares occupied by the tx <= the max shares
 		// available to blob data in a data square.
 		blobante.NewBlobShareDecorator(blobKeeper),
+		// Ensure that the signer of each PFB is allowed to post blobs in the
+		// namespaces that have been claimed in the namespace registry.
+		// Note: does not consume gas from the gas meter.
+		blobante.NewNamespaceOwnershipDecorator(blobKeeper),
 		// Ensure that txs with MsgSubmitProposal/MsgExec have at least one message and param filters are applied.
 		NewParamFilterDecorator(paramFilters),
 		// Side effect: increment the nonce for all tx signers.
@@ -1,6 +1,8 @@
 package ante
 
 import (
+	stdmath "math"
+
 	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
@@ -48,7 +50,7 @@ func ValidateTxFee(ctx sdk.Context, tx sdk.Tx, minfeeKeeper *minfeekeeper.Keeper
 		}
 	}
 
-	networkMinGasPrice := minfeeKeeper.GetParams(ctx).NetworkMinGasPrice
+	networkMinGasPrice := minfeeKeeper.GetNetworkMinGasPrice(ctx)
 
 	err := verifyMinFee(fee, gas, networkMinGasPrice, "insufficient gas price for the network")
 	if err != nil {
@@ -71,6 +73,17 @@ func verifyMinFee(fee math.Int, gas uint64, minGasPrice math.LegacyDec, errMsg s
 	return nil
 }
 
+// TxPriority returns the priority that ValidateTxFee assigns to the provided
+// transaction, i.e. its effective gas price scaled by priorityScalingFactor.
+// Transactions without a gas limit have a priority of zero.
+func TxPriority(feeTx sdk.FeeTx) int64 {
+	gas := feeTx.GetGas()
+	if gas == 0 || gas > stdmath.MaxInt64 {
+		return 0
+	}
+	return getTxPriority(feeTx.GetFee(), int64(gas))
+}
+
 // getTxPriority returns a naive tx priority based on the amount of the smallest denomination of the gas price
 // provided in a transaction.
 // NOTE: This implementation should not be used for txs with multiple coins.
@@ -0,0 +1,46 @@
+package ante
+
+import (
+	"cosmossdk.io/errors"
+	blobtypes "github.com/celestiaorg/celestia-app/v5/x/blob/types"
+	minfeekeeper "github.com/celestiaorg/celestia-app/v5/x/minfee/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.AnteDecorator = FeeSplitDecorator{}
+
+// FeeSplitDecorator burns, or sends to the fee split recipient, the part of
+// the tx fee defined by the minfee fee split params.
+// Contract: must be called after the DeductFeeDecorator.
+type FeeSplitDecorator struct {
+	minfeeKeeper *minfeekeeper.Keeper
+}
+
+func NewFeeSplitDecorator(minfeeKeeper *minfeekeeper.Keeper) FeeSplitDecorator {
+	return FeeSplitDecorator{minfeeKeeper: minfeeKeeper}
+}
+
+func (d FeeSplitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if err := d.minfeeKeeper.SplitFee(ctx, feeTx.GetFee(), containsPFB(tx.GetMsgs())); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// containsPFB returns true if one of the msgs is a MsgPayForBlobs. PFBs nested
+// in a MsgExec are rejected by the MsgExecDecorator so they are not looked for.
+func containsPFB(msgs []sdk.Msg) bool {
+	for _, msg := range msgs {
+		if _, ok := msg.(*blobtypes.MsgPayForBlobs); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -163,6 +163,6 @@ func setUp(t *testing.T) (paramkeeper.Keeper, *minfeekeeper.Keeper, storetypes.C
 	paramsKeeper := paramkeeper.NewKeeper(codec.NewProtoCodec(registry), codec.NewLegacyAmino(), storeKey, tStoreKey)
 	subspace := paramsKeeper.Subspace(minfeetypes.ModuleName)
 
-	mfk := minfeekeeper.NewKeeper(encoding.MakeConfig(app.ModuleEncodingRegisters...).Codec, mfStoreKey, paramsKeeper, subspace, "")
+	mfk := minfeekeeper.NewKeeper(encoding.MakeConfig(app.ModuleEncodingRegisters...).Codec, mfStoreKey, paramsKeeper, subspace, nil, nil, nil, "")
 	return paramsKeeper, mfk, stateStore
 }
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -30,7 +31,9 @@ import (
 	warptypes "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
 	"github.com/celestiaorg/celestia-app/v5/app/ante"
 	"github.com/celestiaorg/celestia-app/v5/app/encoding"
+	"github.com/celestiaorg/celestia-app/v5/app/grpc/blobindex"
 	"github.com/celestiaorg/celestia-app/v5/app/grpc/gasestimation"
+	"github.com/celestiaorg/celestia-app/v5/app/grpc/proposal"
 	celestiatx "github.com/celestiaorg/celestia-app/v5/app/grpc/tx"
 	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v5/pkg/proof"
@@ -123,7 +126,9 @@ import (
 )
 
 // maccPerms is short for module account permissions. It is a map from module
-// account name to a list of permissions for that module account.
+// account name to a list of permissions for that module account. The blob and
+// minfee module accounts are only used from app version 6 onwards and are
+// created by the v6 upgrade.
 var maccPerms = map[string][]string{
 	authtypes.FeeCollectorName:     nil,
 	distrtypes.ModuleName:          nil,
@@ -135,6 +140,8 @@ var maccPerms = map[string][]string{
 	icatypes.ModuleName:            nil,
 	hyperlanetypes.ModuleName:      nil,
 	warptypes.ModuleName:           {authtypes.Minter, authtypes.Burner},
+	blobtypes.ModuleName:           nil,
+	minfeetypes.ModuleName:         {authtypes.Burner},
 }
 
 var (
@@ -192,6 +199,23 @@ type App struct {
 	// useful for testing purposes and should not be used on public networks
 	// (Arabica, Mocha, or Mainnet Beta).
 	timeoutCommit time.Duration
+	// txOrdering is the order in which candidate transactions are considered
+	// when this node prepares a proposal.
+	txOrdering TxOrdering
+	// exclusionReports holds the transactions that were excluded from the
+	// most recent proposals prepared by this node.
+	exclusionReports *proposal.ReportStore
+	// blobIndexer indexes the blobs of finalized blocks. It is nil if the blob
+	// index is not enabled.
+	blobIndexer *blobindex.Indexer
+	// gasPriceHistory keeps the gas prices of the recently committed blocks
+	// for the gas estimation service. It is nil if the gas price history is
+	// not enabled.
+	gasPriceHistory *gasestimation.GasPriceHistory
+	// usedShares keeps the number of used shares of the squares of the
+	// processed proposals so that the square of a finalized block isn't
+	// rebuilt.
+	usedShares *usedSharesCache
 }
 
 // New returns a reference to an uninitialized app. Callers must subsequently
@@ -217,12 +241,40 @@ func New(
 
 	govModuleAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
 
+	txOrdering, err := ParseTxOrdering(cast.ToString(appOpts.Get(FlagTxOrdering)))
+	if err != nil {
+		panic(err)
+	}
+
+	blobIndexer, err := newBlobIndexer(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	gasPriceHistory := newGasPriceHistory(appOpts, encodingConfig.TxConfig.TxDecoder())
+	var abciListeners []storetypes.ABCIListener
+	if gasPriceHistory != nil {
+		abciListeners = append(abciListeners, gasPriceHistory)
+	}
+	if blobIndexer != nil {
+		abciListeners = append(abciListeners, blobIndexer)
+	}
+	if len(abciListeners) > 0 {
+		baseApp.SetStreamingManager(storetypes.StreamingManager{
+			ABCIListeners: abciListeners,
+		})
+	}
+
 	app := &App{
-		BaseApp:       baseApp,
-		keys:          keys,
-		tkeys:         tkeys,
-		memKeys:       memKeys,
-		timeoutCommit: timeoutCommit,
+		BaseApp:          baseApp,
+		keys:             keys,
+		tkeys:            tkeys,
+		memKeys:          memKeys,
+		timeoutCommit:    timeoutCommit,
+		txOrdering:       txOrdering,
+		exclusionReports: proposal.NewReportStore(proposal.DefaultReportRetention),
+		blobIndexer:      blobIndexer,
+		gasPriceHistory:  gasPriceHistory,
+		usedShares:       newUsedSharesCache(),
 	}
 
 	// needed for migration from x/params -> module's ownership of own params
@@ -276,7 +328,11 @@ func New(
 	for _, h := range cast.ToIntSlice(appOpts.Get(server.FlagUnsafeSkipUpgrades)) {
 		skipUpgradeHeights[int64(h)] = true
 	}
-	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, runtime.NewKVStoreService(keys[upgradetypes.StoreKey]), encodingConfig.Codec, NodeHome, app.BaseApp, govModuleAddr)
+	upgradeHome := NodeHome
+	if home := cast.ToString(appOpts.Get(FlagUpgradeHome)); home != "" {
+		upgradeHome = home
+	}
+	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, runtime.NewKVStoreService(keys[upgradetypes.StoreKey]), encodingConfig.Codec, upgradeHome, app.BaseApp, govModuleAddr)
 
 	// Register the staking hooks. NOTE: stakingKeeper is passed by reference
 	// above so that it will contain these hooks.
@@ -291,6 +347,7 @@ func New(
 		encodingConfig.Codec,
 		keys[signaltypes.StoreKey],
 		app.StakingKeeper,
+		govModuleAddr,
 	)
 
 	app.IBCKeeper = ibckeeper.NewKeeper(
@@ -364,10 +421,11 @@ func New(
 		encodingConfig.Codec,
 		keys[blobtypes.StoreKey],
 		app.GetSubspace(blobtypes.ModuleName),
+		app.BankKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
-	app.MinFeeKeeper = minfeekeeper.NewKeeper(encodingConfig.Codec, keys[minfeetypes.StoreKey], app.ParamsKeeper, app.GetSubspace(minfeetypes.ModuleName), authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	app.MinFeeKeeper = minfeekeeper.NewKeeper(encodingConfig.Codec, keys[minfeetypes.StoreKey], app.ParamsKeeper, app.GetSubspace(minfeetypes.ModuleName), app.BankKeeper, app.AccountKeeper, app.DistrKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	app.PacketForwardKeeper.SetTransferKeeper(app.TransferKeeper)
 	ibcRouter := ibcporttypes.NewRouter()                                                   // Create static IBC router
@@ -415,7 +473,7 @@ func New(
 		ibcModule{ibc.NewAppModule(app.IBCKeeper)},
 		transfer.NewAppModule(app.TransferKeeper),
 		blob.NewAppModule(encodingConfig.Codec, app.BlobKeeper),
-		signal.NewAppModule(app.SignalKeeper),
+		signal.NewAppModule(encodingConfig.Codec, app.SignalKeeper),
 		minfee.NewAppModule(encodingConfig.Codec, app.MinFeeKeeper),
 		pfm{packetforward.NewAppModule(app.PacketForwardKeeper, app.GetSubspace(packetforwardtypes.ModuleName))},
 		// ensure the light client module types are registered.
@@ -443,6 +501,7 @@ func New(
 
 	app.CustomQueryRouter().AddRoute(proof.TxInclusionQueryPath, proof.QueryTxInclusionProof)
 	app.CustomQueryRouter().AddRoute(proof.ShareInclusionQueryPath, proof.QueryShareInclusionProof)
+	app.CustomQueryRouter().AddRoute(proof.NamespaceAbsenceQueryPath, proof.QueryNamespaceAbsenceProof)
 
 	app.configurator = module.NewConfigurator(encodingConfig.Codec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 	if err := app.ModuleManager.RegisterServices(app.configurator); err != nil {
@@ -517,8 +576,13 @@ func (app *App) Info(req *abci.RequestInfo) (*abci.ResponseInfo, error) {
 }
 
 // PreBlocker application updates every pre block
-func (app *App) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
-	return app.ModuleManager.PreBlock(ctx)
+func (app *App) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	res, err := app.ModuleManager.PreBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	app.recordSquareUtilization(ctx, req)
+	return res, nil
 }
 
 // BeginBlocker application updates every begin block
@@ -550,16 +614,27 @@ func (app *App) EndBlocker(ctx sdk.Context) (sdk.EndBlock, error) {
 				Height: upgrade.UpgradeHeight + 1, // next block is performing the upgrade.
 			}
 
-			if err := app.UpgradeKeeper.ScheduleUpgrade(ctx, plan); err != nil {
-				return sdk.EndBlock{}, fmt.Errorf("failed to schedule upgrade: %v", err)
-			}
-
-			if err := app.UpgradeKeeper.DumpUpgradeInfoToDisk(upgrade.UpgradeHeight, plan); err != nil {
-				return sdk.EndBlock{}, fmt.Errorf("failed to dump upgrade info to disk: %v", err)
-			}
-
-			if err := app.SetAppVersion(ctx, upgrade.AppVersion); err != nil {
-				return sdk.EndBlock{}, err
+			if upgrade.AppVersion == currentVersion+1 && app.UpgradeKeeper.HasHandler(plan.Name) {
+				// The upgrade handler of the next version is registered in
+				// this binary, so the upgrade is applied at the upgrade height.
+				// The upgrade keeper increments the app version once the
+				// handler has run.
+				plan.Height = upgrade.UpgradeHeight
+				if err := app.UpgradeKeeper.ApplyUpgrade(ctx, plan); err != nil {
+					return sdk.EndBlock{}, fmt.Errorf("failed to apply upgrade: %v", err)
+				}
+			} else {
+				if err := app.UpgradeKeeper.ScheduleUpgrade(ctx, plan); err != nil {
+					return sdk.EndBlock{}, fmt.Errorf("failed to schedule upgrade: %v", err)
+				}
+
+				if err := app.UpgradeKeeper.DumpUpgradeInfoToDisk(upgrade.UpgradeHeight, plan); err != nil {
+					return sdk.EndBlock{}, fmt.Errorf("failed to dump upgrade info to disk: %v", err)
+				}
+
+				if err := app.SetAppVersion(ctx, upgrade.AppVersion); err != nil {
+					return sdk.EndBlock{}, err
+				}
 			}
 			app.SignalKeeper.ResetTally(ctx)
 
@@ -579,7 +654,13 @@ func (app *App) InitChainer(ctx sdk.Context, req *abci.RequestInitChain) (*abci.
 		return nil, err
 	}
 
-	versionMap := app.ModuleManager.GetVersionMap()
+	// the context of InitChain has no consensus params, so set them for the
+	// modules whose genesis depends on the app version.
+	if req.ConsensusParams != nil {
+		ctx = ctx.WithConsensusParams(*req.ConsensusParams)
+	}
+
+	versionMap := app.moduleVersionMap(ctx.ConsensusParams().Version.GetApp())
 	if err := app.UpgradeKeeper.SetModuleVersionMap(ctx, versionMap); err != nil {
 		return nil, err
 	}
@@ -658,6 +739,21 @@ func (app *App) AppCodec() codec.Codec {
 	return app.encodingConfig.Codec
 }
 
+// ExclusionReports returns the store holding the exclusion reports of the
+// most recent proposals prepared by this node.
+func (app *App) ExclusionReports() *proposal.ReportStore {
+	return app.exclusionReports
+}
+
+// Close closes the blob index, if enabled, along with the BaseApp.
+func (app *App) Close() error {
+	err := app.BaseApp.Close()
+	if app.blobIndexer != nil {
+		err = errors.Join(err, app.blobIndexer.Close())
+	}
+	return err
+}
+
 // GetEncodingConfig returns the app encoding config.
 func (app *App) GetEncodingConfig() encoding.Config {
 	return app.encodingConfig
@@ -704,6 +800,9 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
 	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	// Register new celestia routes from grpc-gateway.
 	celestiatx.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	proposal.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	proof.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	blobindex.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	// Register grpc-gateway routes for all modules.
 	app.BasicManager.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 }
@@ -712,7 +811,10 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
 func (app *App) RegisterTxService(clientCtx client.Context) {
 	authtx.RegisterTxService(app.GRPCQueryRouter(), clientCtx, app.Simulate, app.encodingConfig.InterfaceRegistry)
 	celestiatx.RegisterTxService(app.GRPCQueryRouter(), clientCtx, app.encodingConfig.InterfaceRegistry)
-	gasestimation.RegisterGasEstimationService(app.GRPCQueryRouter(), clientCtx, app.encodingConfig.TxConfig.TxDecoder(), app.getGovMaxSquareBytes, app.Simulate)
+	proposal.RegisterProposalService(app.GRPCQueryRouter(), app.exclusionReports)
+	proof.RegisterQueryService(app.GRPCQueryRouter(), clientCtx)
+	blobindex.RegisterBlobIndexService(app.GRPCQueryRouter(), app.blobIndexer)
+	gasestimation.RegisterGasEstimationService(app.GRPCQueryRouter(), clientCtx, app.encodingConfig.TxConfig.TxDecoder(), app.getGovMaxSquareBytes, app.getNetworkMinGasPrice, app.Simulate, app.gasPriceHistory)
 }
 
 func (app *App) getGovMaxSquareBytes() (uint64, error) {
@@ -724,6 +826,14 @@ func (app *App) getGovMaxSquareBytes() (uint64, error) {
 	return maxSquareSize * maxSquareSize * share.ShareSize, nil
 }
 
+func (app *App) getNetworkMinGasPrice() (float64, error) {
+	ctx, err := app.CreateQueryContext(app.LastBlockHeight(), false)
+	if err != nil {
+		return 0, err
+	}
+	return app.MinFeeKeeper.GetNetworkMinGasPrice(ctx).Float64()
+}
+
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
 func (app *App) RegisterTendermintService(clientCtx client.Context) {
 	tmservice.RegisterTendermintService(clientCtx, app.GRPCQueryRouter(), app.encodingConfig.InterfaceRegistry, app.Query)
@@ -9,6 +9,7 @@ import (
 	"github.com/celestiaorg/celestia-app/v5/app"
 	"github.com/celestiaorg/celestia-app/v5/app/encoding"
 	"github.com/celestiaorg/celestia-app/v5/app/grpc/gasestimation"
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v5/pkg/user"
 	testutil "github.com/celestiaorg/celestia-app/v5/test/util"
 	"github.com/celestiaorg/celestia-app/v5/test/util/blobfactory"
@@ -70,7 +71,9 @@ func BenchmarkGasPriceEstimation(b *testing.B) {
 				mempool,
 				encfg.TxConfig.TxDecoder(),
 				func() (uint64, error) { return 128 * 128 * share.ContinuationSparseShareContentSize, nil },
+				func() (float64, error) { return appconsts.DefaultNetworkMinGasPrice, nil },
 				func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error) { return sdk.GasInfo{}, nil, nil },
+				nil,
 			)
 			for i := 0; i < b.N; i++ {
 				_, err := gasEstimationServer.EstimateGasPrice(context.Background(), &gasestimation.EstimateGasPriceRequest{})
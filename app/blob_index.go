@@ -0,0 +1,31 @@
+package app
+
+import (
+	"path/filepath"
+
+	"github.com/celestiaorg/celestia-app/v5/app/grpc/blobindex"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+// FlagBlobIndex is the app option used to enable the index of the blobs in
+// finalized blocks by namespace and share commitment.
+const FlagBlobIndex = "blob-index"
+
+// newBlobIndexer opens the blob index database in the data directory of the
+// node home and returns an indexer backed by it. It returns nil if the blob
+// index is not enabled.
+func newBlobIndexer(appOpts servertypes.AppOptions) (*blobindex.Indexer, error) {
+	if !cast.ToBool(appOpts.Get(FlagBlobIndex)) {
+		return nil, nil
+	}
+	dataDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data")
+	db, err := dbm.NewDB(blobindex.DBName, server.GetAppDBBackend(appOpts), dataDir)
+	if err != nil {
+		return nil, err
+	}
+	return blobindex.NewIndexer(db), nil
+}
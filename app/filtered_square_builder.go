@@ -1,6 +1,8 @@
 package app
 
 import (
+	"cosmossdk.io/errors"
+	"github.com/celestiaorg/celestia-app/v5/app/grpc/proposal"
 	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
 	square "github.com/celestiaorg/go-square/v2"
 	"github.com/celestiaorg/go-square/v2/tx"
@@ -17,6 +19,22 @@ type FilteredSquareBuilder struct {
 	handler  sdk.AnteHandler
 	txConfig client.TxConfig
 	builder  *square.Builder
+	ordering TxOrdering
+	// excluded holds the transactions that were dropped by Fill along with
+	// the reason they were dropped.
+	excluded []*proposal.ExcludedTx
+}
+
+// FilteredSquareBuilderOption configures optional behaviour of the
+// FilteredSquareBuilder.
+type FilteredSquareBuilderOption func(*FilteredSquareBuilder)
+
+// WithTxOrdering sets the order in which Fill considers candidate
+// transactions. Defaults to FIFOOrdering.
+func WithTxOrdering(ordering TxOrdering) FilteredSquareBuilderOption {
+	return func(fsb *FilteredSquareBuilder) {
+		fsb.ordering = ordering
+	}
 }
 
 func NewFilteredSquareBuilder(
@@ -24,16 +42,22 @@ func NewFilteredSquareBuilder(
 	txConfig client.TxConfig,
 	maxSquareSize,
 	subtreeRootThreshold int,
+	opts ...FilteredSquareBuilderOption,
 ) (*FilteredSquareBuilder, error) {
 	builder, err := square.NewBuilder(maxSquareSize, subtreeRootThreshold)
 	if err != nil {
 		return nil, err
 	}
-	return &FilteredSquareBuilder{
+	fsb := &FilteredSquareBuilder{
 		handler:  handler,
 		txConfig: txConfig,
 		builder:  builder,
-	}, nil
+		ordering: FIFOOrdering,
+	}
+	for _, opt := range opts {
+		opt(fsb)
+	}
+	return fsb, nil
 }
 
 func (fsb *FilteredSquareBuilder) Build() (square.Square, error) {
@@ -44,6 +68,30 @@ func (fsb *FilteredSquareBuilder) Builder() *square.Builder {
 	return fsb.builder
 }
 
+// Excluded returns the transactions that were dropped by Fill along with the
+// reason each of them was dropped.
+func (fsb *FilteredSquareBuilder) Excluded() []*proposal.ExcludedTx {
+	return fsb.excluded
+}
+
+// exclude records that the provided transaction was dropped by Fill.
+func (fsb *FilteredSquareBuilder) exclude(tx []byte, isBlobTx bool, reason proposal.ExclusionReason, err error) {
+	excludedTx := &proposal.ExcludedTx{
+		TxHash:   tmbytes.HexBytes(coretypes.Tx(tx).Hash()).String(),
+		IsBlobTx: isBlobTx,
+		Reason:   reason,
+	}
+	if err != nil {
+		codespace, code, log := errors.ABCIInfo(err, false)
+		if reason == proposal.ExclusionReason_EXCLUSION_REASON_ANTE_ERROR {
+			excludedTx.Codespace = codespace
+			excludedTx.Code = code
+		}
+		excludedTx.Log = log
+	}
+	fsb.excluded = append(fsb.excluded, excludedTx)
+}
+
 func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 	logger := ctx.Logger().With("app/filtered-square-builder")
 
@@ -57,10 +105,18 @@ func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 		m                  = 0
 	)
 
+	if fsb.ordering == FeePriorityOrdering {
+		// Normal txs are still added before blob txs so the ordering is only
+		// applied within each group.
+		normalTxs = orderByFeePriority(dec, normalTxs, func(tx []byte) []byte { return tx })
+		blobTxs = orderByFeePriority(dec, blobTxs, func(btx *tx.BlobTx) []byte { return btx.Tx })
+	}
+
 	for _, tx := range normalTxs {
 		sdkTx, err := dec(tx)
 		if err != nil {
 			logger.Error("decoding already checked transaction", "tx", tmbytes.HexBytes(coretypes.Tx(tx).Hash()), "error", err)
+			fsb.exclude(tx, false, proposal.ExclusionReason_EXCLUSION_REASON_DECODE_FAILURE, err)
 			continue
 		}
 
@@ -70,11 +126,13 @@ func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 		msgTypes := msgTypes(sdkTx)
 		if nonPFBMessageCount+len(sdkTx.GetMsgs()) > appconsts.MaxNonPFBMessages {
 			logger.Debug("skipping tx because the max non PFB message count was reached", "tx", tmbytes.HexBytes(coretypes.Tx(tx).Hash()))
+			fsb.exclude(tx, false, proposal.ExclusionReason_EXCLUSION_REASON_MESSAGE_COUNT_CAP, nil)
 			continue
 		}
 
 		if !fsb.builder.AppendTx(tx) {
 			logger.Debug("skipping tx because it was too large to fit in the square", "tx", tmbytes.HexBytes(coretypes.Tx(tx).Hash()))
+			fsb.exclude(tx, false, proposal.ExclusionReason_EXCLUSION_REASON_SQUARE_FULL, nil)
 			continue
 		}
 
@@ -90,6 +148,7 @@ func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 				"msgs", msgTypes,
 			)
 			telemetry.IncrCounter(1, "prepare_proposal", "invalid_std_txs")
+			fsb.exclude(tx, false, proposal.ExclusionReason_EXCLUSION_REASON_ANTE_ERROR, err)
 			err = fsb.builder.RevertLastTx()
 			if err != nil {
 				logger.Error("reverting last transaction", "error", err)
@@ -106,6 +165,7 @@ func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 		sdkTx, err := dec(tx.Tx)
 		if err != nil {
 			logger.Error("decoding already checked blob transaction", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()), "error", err)
+			fsb.exclude(tx.Tx, true, proposal.ExclusionReason_EXCLUSION_REASON_DECODE_FAILURE, err)
 			continue
 		}
 
@@ -114,11 +174,13 @@ func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 
 		if pfbMessageCount+len(sdkTx.GetMsgs()) > appconsts.MaxPFBMessages {
 			logger.Debug("skipping blob tx because the max pfb message count was reached", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()))
+			fsb.exclude(tx.Tx, true, proposal.ExclusionReason_EXCLUSION_REASON_MESSAGE_COUNT_CAP, nil)
 			continue
 		}
 
 		if !fsb.builder.AppendBlobTx(tx) {
 			logger.Debug("skipping tx because it was too large to fit in the square", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()))
+			fsb.exclude(tx.Tx, true, proposal.ExclusionReason_EXCLUSION_REASON_SQUARE_FULL, nil)
 			continue
 		}
 
@@ -131,6 +193,7 @@ func (fsb *FilteredSquareBuilder) Fill(ctx sdk.Context, txs [][]byte) [][]byte {
 				"filtering already checked blob transaction", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()), "error", err,
 			)
 			telemetry.IncrCounter(1, "prepare_proposal", "invalid_blob_txs")
+			fsb.exclude(tx.Tx, true, proposal.ExclusionReason_EXCLUSION_REASON_ANTE_ERROR, err)
 			err = fsb.builder.RevertLastBlobTx()
 			if err != nil {
 				logger.Error("reverting last blob transaction failed", "error", err)
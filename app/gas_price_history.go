@@ -0,0 +1,22 @@
+package app
+
+import (
+	"github.com/celestiaorg/celestia-app/v5/app/grpc/gasestimation"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+)
+
+// FlagGasPriceHistory is the app option used to enable the history of the gas
+// prices of the recently committed blocks that the gas estimation service
+// uses.
+const FlagGasPriceHistory = "gas-price-history"
+
+// newGasPriceHistory returns the gas price history of the recently committed
+// blocks. It returns nil if the gas price history is not enabled.
+func newGasPriceHistory(appOpts servertypes.AppOptions, txDecoder sdk.TxDecoder) *gasestimation.GasPriceHistory {
+	if !cast.ToBool(appOpts.Get(FlagGasPriceHistory)) {
+		return nil
+	}
+	return gasestimation.NewGasPriceHistory(txDecoder, gasestimation.DefaultGasPriceHistoryBlocks)
+}
@@ -0,0 +1,138 @@
+package blobindex
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v5/pkg/proof"
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// DBName is the name of the database that the blob index is stored in.
+const DBName = "blob_index"
+
+var _ storetypes.ABCIListener = &Indexer{}
+
+// Indexer maps the namespace and share commitment of every blob in the
+// finalized blocks to its location in the data square. It implements
+// storetypes.ABCIListener so that it can be registered on the BaseApp
+// streaming manager.
+//
+// Blobs are indexed based on their position in the data square, regardless of
+// whether the PayForBlobs transaction that paid for them succeeded.
+type Indexer struct {
+	db dbm.DB
+}
+
+// NewIndexer returns an Indexer that stores the index in the provided db.
+func NewIndexer(db dbm.DB) *Indexer {
+	return &Indexer{db: db}
+}
+
+// ListenFinalizeBlock indexes the blobs of the finalized block.
+func (i *Indexer) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, _ abci.ResponseFinalizeBlock) error {
+	return i.IndexBlock(req.Height, req.Txs)
+}
+
+// ListenCommit is a no-op as the index only depends on the block data.
+func (i *Indexer) ListenCommit(_ context.Context, _ abci.ResponseCommit, _ []*storetypes.StoreKVPair) error {
+	return nil
+}
+
+// IndexBlock rebuilds the data square from the provided block txs and indexes
+// the location of each blob in it. Indexing the same block more than once is
+// idempotent.
+func (i *Indexer) IndexBlock(height int64, txs [][]byte) error {
+	_, shareRanges, err := proof.BlobShareRanges(txs)
+	if err != nil {
+		return err
+	}
+
+	batch := i.db.NewBatch()
+	defer batch.Close()
+
+	for txIndex, rawTx := range txs {
+		bTx, isBlobTx, err := blobtx.UnmarshalBlobTx(rawTx)
+		if !isBlobTx {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling blob tx at index %d: %w", txIndex, err)
+		}
+
+		for blobIndex, blob := range bTx.Blobs {
+			shareRange := shareRanges[txIndex][blobIndex]
+			commitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold)
+			if err != nil {
+				return err
+			}
+
+			location := BlobLocation{
+				Height:     height,
+				TxIndex:    uint32(txIndex),
+				BlobIndex:  uint32(blobIndex),
+				StartShare: uint32(shareRange.Start),
+				EndShare:   uint32(shareRange.End),
+			}
+			value, err := location.Marshal()
+			if err != nil {
+				return err
+			}
+			if err := batch.Set(locationKey(blob.Namespace().Bytes(), commitment, &location), value); err != nil {
+				return err
+			}
+		}
+	}
+	return batch.Write()
+}
+
+// Locations returns the indexed locations of the blobs with the provided
+// namespace and share commitment ordered by height.
+func (i *Indexer) Locations(namespace, commitment []byte) ([]*BlobLocation, error) {
+	prefix := blobKey(namespace, commitment)
+	it, err := i.db.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	locations := make([]*BlobLocation, 0)
+	for ; it.Valid(); it.Next() {
+		location := new(BlobLocation)
+		if err := location.Unmarshal(it.Value()); err != nil {
+			return nil, err
+		}
+		locations = append(locations, location)
+	}
+	return locations, it.Error()
+}
+
+// Close closes the underlying db.
+func (i *Indexer) Close() error {
+	return i.db.Close()
+}
+
+// blobKey returns the key prefix of all the locations of a blob. Namespaces
+// have a fixed size so the commitment can not collide with the namespace.
+func blobKey(namespace, commitment []byte) []byte {
+	key := make([]byte, 0, share.NamespaceSize+len(commitment))
+	key = append(key, namespace...)
+	return append(key, commitment...)
+}
+
+// locationKey returns the key of a single location of a blob. The location is
+// encoded in big endian so that the locations are iterated in order.
+func locationKey(namespace, commitment []byte, location *BlobLocation) []byte {
+	key := blobKey(namespace, commitment)
+	key = binary.BigEndian.AppendUint64(key, uint64(location.Height))
+	key = binary.BigEndian.AppendUint32(key, location.TxIndex)
+	return binary.BigEndian.AppendUint32(key, location.BlobIndex)
+}
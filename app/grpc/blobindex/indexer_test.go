@@ -0,0 +1,123 @@
+package blobindex_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v5/app/grpc/blobindex"
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v5/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v5/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v5/test/util/testnode"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	coretypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIndexer(t *testing.T) {
+	ns1 := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	ns2 := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	blobTxs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []share.Namespace{ns1, ns2}, []int{1000, 3000})
+	txs := testfactory.GenerateRandomTxs(5, 200)
+	txs = append(txs, blobTxs...)
+	rawTxs := txs.ToSliceOfBytes()
+
+	indexer := blobindex.NewIndexer(dbm.NewMemDB())
+	require.NoError(t, indexer.IndexBlock(10, rawTxs))
+	// indexing the same block again must not duplicate the locations
+	require.NoError(t, indexer.IndexBlock(10, rawTxs))
+
+	builder, err := square.NewBuilder(appconsts.SquareSizeUpperBound, appconsts.SubtreeRootThreshold, rawTxs...)
+	require.NoError(t, err)
+	_, err = builder.Export()
+	require.NoError(t, err)
+
+	for txIndex := 5; txIndex < len(rawTxs); txIndex++ {
+		bTx, isBlobTx, err := blobtx.UnmarshalBlobTx(rawTxs[txIndex])
+		require.NoError(t, err)
+		require.True(t, isBlobTx)
+		blob := bTx.Blobs[0]
+		commitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold)
+		require.NoError(t, err)
+
+		locations, err := indexer.Locations(blob.Namespace().Bytes(), commitment)
+		require.NoError(t, err)
+		require.Len(t, locations, 1)
+
+		start, err := builder.FindBlobStartingIndex(txIndex, 0)
+		require.NoError(t, err)
+		length, err := builder.BlobShareLength(txIndex, 0)
+		require.NoError(t, err)
+		assert.Equal(t, &blobindex.BlobLocation{
+			Height:     10,
+			TxIndex:    uint32(txIndex),
+			BlobIndex:  0,
+			StartShare: uint32(start),
+			EndShare:   uint32(start + length),
+		}, locations[0])
+
+		// the same commitment in another namespace is not indexed
+		other := ns1
+		if blob.Namespace().Equals(ns1) {
+			other = ns2
+		}
+		locations, err = indexer.Locations(other.Bytes(), commitment)
+		require.NoError(t, err)
+		assert.Empty(t, locations)
+	}
+}
+
+func TestBlobLocations(t *testing.T) {
+	namespace := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	blobTxs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []share.Namespace{namespace}, []int{100})
+	bTx, _, err := blobtx.UnmarshalBlobTx(blobTxs[0])
+	require.NoError(t, err)
+	commitment, err := inclusion.CreateCommitment(bTx.Blobs[0], merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold)
+	require.NoError(t, err)
+
+	indexer := blobindex.NewIndexer(dbm.NewMemDB())
+	require.NoError(t, indexer.IndexBlock(1, coretypes.Txs(blobTxs).ToSliceOfBytes()))
+	require.NoError(t, indexer.IndexBlock(3, coretypes.Txs(blobTxs).ToSliceOfBytes()))
+	server := blobindex.NewBlobIndexServer(indexer)
+
+	resp, err := server.BlobLocations(context.Background(), &blobindex.QueryBlobLocationsRequest{
+		Namespace:  namespace.Bytes(),
+		Commitment: commitment,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Locations, 2)
+	assert.EqualValues(t, 1, resp.Locations[0].Height)
+	assert.EqualValues(t, 3, resp.Locations[1].Height)
+
+	testCases := []struct {
+		name   string
+		server blobindex.BlobIndexServer
+		req    *blobindex.QueryBlobLocationsRequest
+		code   codes.Code
+	}{
+		{"nil request", server, nil, codes.InvalidArgument},
+		{"index not enabled", blobindex.NewBlobIndexServer(nil), &blobindex.QueryBlobLocationsRequest{Namespace: namespace.Bytes(), Commitment: commitment}, codes.Unavailable},
+		{"invalid namespace", server, &blobindex.QueryBlobLocationsRequest{Namespace: []byte{1}, Commitment: commitment}, codes.InvalidArgument},
+		{"invalid commitment", server, &blobindex.QueryBlobLocationsRequest{Namespace: namespace.Bytes(), Commitment: commitment[:10]}, codes.InvalidArgument},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.server.BlobLocations(context.Background(), tc.req)
+			assert.Equal(t, tc.code, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package blobindex
+
+import (
+	"context"
+
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RegisterBlobIndexService registers the blob index service on the gRPC
+// router. The indexer may be nil if the blob index is not enabled on this
+// node, in which case every query returns codes.Unavailable.
+func RegisterBlobIndexService(qrt gogogrpc.Server, indexer *Indexer) {
+	RegisterBlobIndexServer(qrt, NewBlobIndexServer(indexer))
+}
+
+// RegisterGRPCGatewayRoutes mounts the blob index service's GRPC-gateway
+// routes on the given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	err := RegisterBlobIndexHandlerClient(context.Background(), mux, NewBlobIndexClient(clientConn))
+	if err != nil {
+		panic(err)
+	}
+}
+
+var _ BlobIndexServer = &blobIndexServer{}
+
+type blobIndexServer struct {
+	indexer *Indexer
+}
+
+func NewBlobIndexServer(indexer *Indexer) BlobIndexServer {
+	return &blobIndexServer{indexer: indexer}
+}
+
+// BlobLocations implements the BlobIndexServer.BlobLocations method.
+func (s *blobIndexServer) BlobLocations(_ context.Context, req *QueryBlobLocationsRequest) (*QueryBlobLocationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if s.indexer == nil {
+		return nil, status.Error(codes.Unavailable, "the blob index is not enabled on this node")
+	}
+	if len(req.Namespace) != share.NamespaceSize {
+		return nil, status.Errorf(codes.InvalidArgument, "namespace must be %d bytes, got %d", share.NamespaceSize, len(req.Namespace))
+	}
+	if len(req.Commitment) != tmhash.Size {
+		return nil, status.Errorf(codes.InvalidArgument, "commitment must be %d bytes, got %d", tmhash.Size, len(req.Commitment))
+	}
+
+	locations, err := s.indexer.Locations(req.Namespace, req.Commitment)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &QueryBlobLocationsResponse{Locations: locations}, nil
+}
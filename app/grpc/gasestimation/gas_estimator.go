@@ -14,6 +14,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // gasMultiplier is the multiplier for the gas limit. It's used to account for the fact that
@@ -27,34 +29,44 @@ type baseAppSimulateFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
 // current max square size in bytes.
 type govMaxSquareBytesFn func() (uint64, error)
 
+// networkMinGasPriceFn is the signature of a function that returns the
+// current network min gas price.
+type networkMinGasPriceFn func() (float64, error)
+
 // RegisterGasEstimationService registers the gas estimation service on the gRPC router.
-func RegisterGasEstimationService(qrt gogogrpc.Server, clientCtx client.Context, txDecoder sdk.TxDecoder, govMaxSquareBytesFn govMaxSquareBytesFn, simulateFn baseAppSimulateFn) {
+// The history may be nil, in which case the gas price history is not used in
+// the estimations and querying it returns codes.Unavailable.
+func RegisterGasEstimationService(qrt gogogrpc.Server, clientCtx client.Context, txDecoder sdk.TxDecoder, govMaxSquareBytesFn govMaxSquareBytesFn, networkMinGasPriceFn networkMinGasPriceFn, simulateFn baseAppSimulateFn, history *GasPriceHistory) {
 	RegisterGasEstimatorServer(
 		qrt,
-		NewGasEstimatorServer(clientCtx.Client, txDecoder, govMaxSquareBytesFn, simulateFn),
+		NewGasEstimatorServer(clientCtx.Client, txDecoder, govMaxSquareBytesFn, networkMinGasPriceFn, simulateFn, history),
 	)
 }
 
 var _ GasEstimatorServer = &gasEstimatorServer{}
 
 type gasEstimatorServer struct {
-	mempoolClient       cmtclient.MempoolClient
-	simulateFn          baseAppSimulateFn
-	txDecoder           sdk.TxDecoder
-	govMaxSquareBytesFn govMaxSquareBytesFn
+	mempoolClient        cmtclient.MempoolClient
+	simulateFn           baseAppSimulateFn
+	txDecoder            sdk.TxDecoder
+	govMaxSquareBytesFn  govMaxSquareBytesFn
+	networkMinGasPriceFn networkMinGasPriceFn
+	history              *GasPriceHistory
 }
 
-func NewGasEstimatorServer(mempoolClient cmtclient.MempoolClient, txDecoder sdk.TxDecoder, govMaxSquareBytesFn govMaxSquareBytesFn, simulateFn baseAppSimulateFn) GasEstimatorServer {
+func NewGasEstimatorServer(mempoolClient cmtclient.MempoolClient, txDecoder sdk.TxDecoder, govMaxSquareBytesFn govMaxSquareBytesFn, networkMinGasPriceFn networkMinGasPriceFn, simulateFn baseAppSimulateFn, history *GasPriceHistory) GasEstimatorServer {
 	return &gasEstimatorServer{
-		mempoolClient:       mempoolClient,
-		simulateFn:          simulateFn,
-		txDecoder:           txDecoder,
-		govMaxSquareBytesFn: govMaxSquareBytesFn,
+		mempoolClient:        mempoolClient,
+		simulateFn:           simulateFn,
+		txDecoder:            txDecoder,
+		govMaxSquareBytesFn:  govMaxSquareBytesFn,
+		networkMinGasPriceFn: networkMinGasPriceFn,
+		history:              history,
 	}
 }
 
 func (s *gasEstimatorServer) EstimateGasPrice(ctx context.Context, request *EstimateGasPriceRequest) (*EstimateGasPriceResponse, error) {
-	gasPrice, err := s.estimateGasPrice(ctx, request.TxPriority)
+	gasPrice, err := s.estimateGasPrice(ctx, request.TxPriority, TxType_TX_TYPE_UNSPECIFIED)
 	if err != nil {
 		return nil, err
 	}
@@ -69,25 +81,30 @@ func (s *gasEstimatorServer) EstimateGasPrice(ctx context.Context, request *Esti
 // to the minimum gas price set by that node.
 // The gas used is estimated using the state machine simulation.
 func (s *gasEstimatorServer) EstimateGasPriceAndUsage(ctx context.Context, request *EstimateGasPriceAndUsageRequest) (*EstimateGasPriceAndUsageResponse, error) {
-	// estimate the gas price
-	gasPrice, err := s.estimateGasPrice(ctx, request.TxPriority)
-	if err != nil {
-		return nil, err
-	}
-
-	// estimate the gas used
 	btx, isBlob, err := blobtx.UnmarshalBlobTx(request.TxBytes)
 	if isBlob && err != nil {
 		return nil, err
 	}
 
 	var txBytes []byte
+	txType := TxType_TX_TYPE_UNSPECIFIED
 	if isBlob {
 		txBytes = btx.Tx
+		txType = TxType_TX_TYPE_PAY_FOR_BLOBS
 	} else {
 		txBytes = request.TxBytes
+		if sdkTx, err := s.txDecoder(txBytes); err == nil {
+			txType = txTypeOf(sdkTx)
+		}
+	}
+
+	// estimate the gas price
+	gasPrice, err := s.estimateGasPrice(ctx, request.TxPriority, txType)
+	if err != nil {
+		return nil, err
 	}
 
+	// estimate the gas used
 	gasUsedInfo, _, err := s.simulateFn(txBytes)
 	if err != nil {
 		return nil, err
@@ -103,15 +120,16 @@ func (s *gasEstimatorServer) EstimateGasPriceAndUsage(ctx context.Context, reque
 // gasPriceEstimationThreshold the threshold of mempool transactions to
 // estimate the gas price.
 // If the returned transactions from the mempool can't fill more than 70% of
-// the max block, the node min gas price is returned.
+// the max block, the gas price is estimated from the gas price history.
 // Otherwise, the gas is estimated following the provided priority.
 var gasPriceEstimationThreshold = 0.70
 
 // estimateGasPrice takes a transaction priority and estimates the gas price based
 // on the gas prices of the transactions in the mempool.
-// If the mempool transactions can't fill more than 70% of the block, the min gas
-// price is returned.
-func (s *gasEstimatorServer) estimateGasPrice(ctx context.Context, priority TxPriority) (float64, error) {
+// If the mempool transactions can't fill more than 70% of the block, the gas
+// price is estimated from the recently committed blocks of the provided
+// transaction type instead.
+func (s *gasEstimatorServer) estimateGasPrice(ctx context.Context, priority TxPriority, txType TxType) (float64, error) {
 	// Use -1 to query all the unconfirmed transactions.
 	limit := -1
 	txsResp, err := s.mempoolClient.UnconfirmedTxs(ctx, &limit)
@@ -123,7 +141,7 @@ func (s *gasEstimatorServer) estimateGasPrice(ctx context.Context, priority TxPr
 		return 0, err
 	}
 	if float64(txsResp.TotalBytes) < float64(govMaxSquareBytes)*gasPriceEstimationThreshold {
-		return appconsts.DefaultMinGasPrice, nil
+		return s.estimateGasPriceFromHistory(priority, txType, govMaxSquareBytes)
 	}
 	gasPrices, err := SortAndExtractGasPrices(s.txDecoder, txsResp.Txs, int64(appconsts.DefaultUpperBoundMaxBytes))
 	if err != nil {
@@ -132,6 +150,58 @@ func (s *gasEstimatorServer) estimateGasPrice(ctx context.Context, priority TxPr
 	return estimateGasPriceForTransactions(gasPrices, priority)
 }
 
+// estimateGasPriceFromHistory estimates the gas price based on the clearing gas
+// prices of the recently committed blocks, following the provided priority.
+// The min gas price is returned if no history is kept or if the recent blocks
+// were not congested.
+func (s *gasEstimatorServer) estimateGasPriceFromHistory(priority TxPriority, txType TxType, govMaxSquareBytes uint64) (float64, error) {
+	minGasPrice, err := s.minGasPrice()
+	if err != nil {
+		return 0, err
+	}
+	if s.history == nil {
+		return minGasPrice, nil
+	}
+	clearingGasPrices := s.history.ClearingGasPrices(txType, govMaxSquareBytes)
+	if len(clearingGasPrices) == 0 {
+		return minGasPrice, nil
+	}
+	estimation, err := estimateGasPriceForTransactions(clearingGasPrices, priority)
+	if err != nil {
+		return 0, err
+	}
+	return math.Max(estimation, minGasPrice), nil
+}
+
+// minGasPrice returns the lowest gas price that is accepted by default, i.e.
+// the default min gas price of the nodes or the network min gas price if it
+// is higher.
+func (s *gasEstimatorServer) minGasPrice() (float64, error) {
+	networkMinGasPrice, err := s.networkMinGasPriceFn()
+	if err != nil {
+		return 0, err
+	}
+	return math.Max(appconsts.DefaultMinGasPrice, networkMinGasPrice), nil
+}
+
+// GasPriceHistory implements the GasEstimatorServer.GasPriceHistory method.
+func (s *gasEstimatorServer) GasPriceHistory(_ context.Context, request *GasPriceHistoryRequest) (*GasPriceHistoryResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if _, ok := TxType_name[int32(request.TxType)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown tx type: %d", request.TxType)
+	}
+	if s.history == nil {
+		return nil, status.Error(codes.Unavailable, "the gas price history is not enabled on this node")
+	}
+	govMaxSquareBytes, err := s.govMaxSquareBytesFn()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return s.history.Query(request.TxType, govMaxSquareBytes), nil
+}
+
 const (
 	// highPriorityGasAdjustmentRate is the percentage increase applied to the
 	// estimated gas price when the block is more than 70% full, i.e., gasPriceEstimationThreshold,
@@ -215,10 +285,9 @@ func SortAndExtractGasPrices(txDecoder sdk.TxDecoder, txs []types.Tx, maxBytes i
 			return nil, err
 		}
 		feeTx := sdkTx.(sdk.FeeTx)
-		gasPrice := float64(feeTx.GetFee().AmountOf(appconsts.BondDenom).Uint64()) / float64(feeTx.GetGas())
 		gasPriceAndSizes[index] = gasPriceAndSize{
 			size:     int64(len(rawTx)),
-			gasPrice: gasPrice,
+			gasPrice: txGasPrice(feeTx),
 		}
 	}
 
@@ -241,6 +310,12 @@ func SortAndExtractGasPrices(txDecoder sdk.TxDecoder, txs []types.Tx, maxBytes i
 	return gasPrices, nil
 }
 
+// txGasPrice returns the gas price paid by the provided transaction in the
+// bond denom.
+func txGasPrice(feeTx sdk.FeeTx) float64 {
+	return float64(feeTx.GetFee().AmountOf(appconsts.BondDenom).Uint64()) / float64(feeTx.GetGas())
+}
+
 // Median calculates the median value of the provided gas prices.
 // Expects a sorted slice.
 func Median(gasPrices []float64) (float64, error) {
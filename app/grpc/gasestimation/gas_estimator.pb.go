@@ -74,6 +74,39 @@ func (TxPriority) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_67d02876d749b9cc, []int{0}
 }
 
+// TxType is the type of the transactions that the gas price history is
+// queried for.
+type TxType int32
+
+const (
+	// TX_TYPE_UNSPECIFIED all the transactions regardless of their messages.
+	TxType_TX_TYPE_UNSPECIFIED TxType = 0
+	// TX_TYPE_PAY_FOR_BLOBS blob transactions.
+	TxType_TX_TYPE_PAY_FOR_BLOBS TxType = 1
+	// TX_TYPE_SEND transactions that only contain bank send messages.
+	TxType_TX_TYPE_SEND TxType = 2
+)
+
+var TxType_name = map[int32]string{
+	0: "TX_TYPE_UNSPECIFIED",
+	1: "TX_TYPE_PAY_FOR_BLOBS",
+	2: "TX_TYPE_SEND",
+}
+
+var TxType_value = map[string]int32{
+	"TX_TYPE_UNSPECIFIED":   0,
+	"TX_TYPE_PAY_FOR_BLOBS": 1,
+	"TX_TYPE_SEND":          2,
+}
+
+func (x TxType) String() string {
+	return proto.EnumName(TxType_name, int32(x))
+}
+
+func (TxType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{1}
+}
+
 // EstimateGasPriceRequest the request to estimate the gas price of the network.
 // Takes a priority enum to define the priority level.
 type EstimateGasPriceRequest struct {
@@ -273,12 +306,265 @@ func (m *EstimateGasPriceAndUsageResponse) GetEstimatedGasUsed() uint64 {
 	return 0
 }
 
+// GasPriceHistoryRequest the request to query the gas price history of the
+// recently committed blocks.
+type GasPriceHistoryRequest struct {
+	TxType TxType `protobuf:"varint,1,opt,name=tx_type,json=txType,proto3,enum=celestia.core.v1.gas_estimation.TxType" json:"tx_type,omitempty"`
+}
+
+func (m *GasPriceHistoryRequest) Reset()         { *m = GasPriceHistoryRequest{} }
+func (m *GasPriceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GasPriceHistoryRequest) ProtoMessage()    {}
+func (*GasPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{4}
+}
+func (m *GasPriceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPriceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPriceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceHistoryRequest.Merge(m, src)
+}
+func (m *GasPriceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPriceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPriceHistoryRequest proto.InternalMessageInfo
+
+func (m *GasPriceHistoryRequest) GetTxType() TxType {
+	if m != nil {
+		return m.TxType
+	}
+	return TxType_TX_TYPE_UNSPECIFIED
+}
+
+// GasPriceHistoryResponse the gas price history of the recently committed
+// blocks.
+type GasPriceHistoryResponse struct {
+	// start_height is the height of the oldest block in the window.
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the height of the newest block in the window.
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// blocks is the number of blocks in the window.
+	Blocks uint64 `protobuf:"varint,3,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// tx_count is the number of transactions of the requested type in the
+	// window.
+	TxCount uint64 `protobuf:"varint,4,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// percentiles are the gas price percentiles of the transactions of the
+	// requested type. It is empty if no such transaction was committed.
+	Percentiles []*GasPricePercentile `protobuf:"bytes,5,rep,name=percentiles,proto3" json:"percentiles,omitempty"`
+	// inclusion_curve maps gas prices, in ascending order, to the fraction of
+	// the blocks in the window that a transaction of the requested type paying
+	// that gas price would have been included in.
+	InclusionCurve []*InclusionProbability `protobuf:"bytes,6,rep,name=inclusion_curve,json=inclusionCurve,proto3" json:"inclusion_curve,omitempty"`
+}
+
+func (m *GasPriceHistoryResponse) Reset()         { *m = GasPriceHistoryResponse{} }
+func (m *GasPriceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GasPriceHistoryResponse) ProtoMessage()    {}
+func (*GasPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{5}
+}
+func (m *GasPriceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPriceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPriceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceHistoryResponse.Merge(m, src)
+}
+func (m *GasPriceHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPriceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPriceHistoryResponse proto.InternalMessageInfo
+
+func (m *GasPriceHistoryResponse) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *GasPriceHistoryResponse) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *GasPriceHistoryResponse) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *GasPriceHistoryResponse) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *GasPriceHistoryResponse) GetPercentiles() []*GasPricePercentile {
+	if m != nil {
+		return m.Percentiles
+	}
+	return nil
+}
+
+func (m *GasPriceHistoryResponse) GetInclusionCurve() []*InclusionProbability {
+	if m != nil {
+		return m.InclusionCurve
+	}
+	return nil
+}
+
+// GasPricePercentile a gas price percentile.
+type GasPricePercentile struct {
+	Percentile uint32  `protobuf:"varint,1,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	GasPrice   float64 `protobuf:"fixed64,2,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+}
+
+func (m *GasPricePercentile) Reset()         { *m = GasPricePercentile{} }
+func (m *GasPricePercentile) String() string { return proto.CompactTextString(m) }
+func (*GasPricePercentile) ProtoMessage()    {}
+func (*GasPricePercentile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{6}
+}
+func (m *GasPricePercentile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPricePercentile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPricePercentile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPricePercentile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPricePercentile.Merge(m, src)
+}
+func (m *GasPricePercentile) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPricePercentile) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPricePercentile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPricePercentile proto.InternalMessageInfo
+
+func (m *GasPricePercentile) GetPercentile() uint32 {
+	if m != nil {
+		return m.Percentile
+	}
+	return 0
+}
+
+func (m *GasPricePercentile) GetGasPrice() float64 {
+	if m != nil {
+		return m.GasPrice
+	}
+	return 0
+}
+
+// InclusionProbability a point of the inclusion probability curve.
+type InclusionProbability struct {
+	GasPrice    float64 `protobuf:"fixed64,1,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	Probability float64 `protobuf:"fixed64,2,opt,name=probability,proto3" json:"probability,omitempty"`
+}
+
+func (m *InclusionProbability) Reset()         { *m = InclusionProbability{} }
+func (m *InclusionProbability) String() string { return proto.CompactTextString(m) }
+func (*InclusionProbability) ProtoMessage()    {}
+func (*InclusionProbability) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{7}
+}
+func (m *InclusionProbability) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InclusionProbability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InclusionProbability.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InclusionProbability) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InclusionProbability.Merge(m, src)
+}
+func (m *InclusionProbability) XXX_Size() int {
+	return m.Size()
+}
+func (m *InclusionProbability) XXX_DiscardUnknown() {
+	xxx_messageInfo_InclusionProbability.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InclusionProbability proto.InternalMessageInfo
+
+func (m *InclusionProbability) GetGasPrice() float64 {
+	if m != nil {
+		return m.GasPrice
+	}
+	return 0
+}
+
+func (m *InclusionProbability) GetProbability() float64 {
+	if m != nil {
+		return m.Probability
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("celestia.core.v1.gas_estimation.TxPriority", TxPriority_name, TxPriority_value)
+	proto.RegisterEnum("celestia.core.v1.gas_estimation.TxType", TxType_name, TxType_value)
 	proto.RegisterType((*EstimateGasPriceRequest)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceRequest")
 	proto.RegisterType((*EstimateGasPriceResponse)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceResponse")
 	proto.RegisterType((*EstimateGasPriceAndUsageRequest)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceAndUsageRequest")
 	proto.RegisterType((*EstimateGasPriceAndUsageResponse)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceAndUsageResponse")
+	proto.RegisterType((*GasPriceHistoryRequest)(nil), "celestia.core.v1.gas_estimation.GasPriceHistoryRequest")
+	proto.RegisterType((*GasPriceHistoryResponse)(nil), "celestia.core.v1.gas_estimation.GasPriceHistoryResponse")
+	proto.RegisterType((*GasPricePercentile)(nil), "celestia.core.v1.gas_estimation.GasPricePercentile")
+	proto.RegisterType((*InclusionProbability)(nil), "celestia.core.v1.gas_estimation.InclusionProbability")
 }
 
 func init() {
@@ -286,35 +572,53 @@ func init() {
 }
 
 var fileDescriptor_67d02876d749b9cc = []byte{
-	// 444 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x4e, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x4f, 0xce, 0x2f, 0x4a, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0x2c,
-	0x8e, 0x07, 0x89, 0xe4, 0x26, 0x96, 0x64, 0xe6, 0xe7, 0x21, 0x73, 0xf3, 0x8b, 0xf4, 0x0a, 0x8a,
-	0xf2, 0x4b, 0xf2, 0x85, 0xe4, 0x61, 0x9a, 0xf4, 0x40, 0x9a, 0xf4, 0xca, 0x0c, 0xf5, 0x50, 0x35,
-	0x29, 0xa5, 0x73, 0x89, 0xbb, 0x42, 0x78, 0xa9, 0xee, 0x89, 0xc5, 0x01, 0x45, 0x99, 0xc9, 0xa9,
-	0x41, 0xa9, 0x85, 0xa5, 0xa9, 0xc5, 0x25, 0x42, 0x3e, 0x5c, 0xdc, 0x25, 0x15, 0xf1, 0x05, 0x45,
-	0x99, 0xf9, 0x45, 0x99, 0x25, 0x95, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x7c, 0x46, 0xda, 0x7a, 0x04,
-	0x4c, 0xd4, 0x0b, 0xa9, 0x08, 0x80, 0x6a, 0x09, 0xe2, 0x2a, 0x81, 0xb3, 0x95, 0xbc, 0xb8, 0x24,
-	0x30, 0x2d, 0x2a, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x15, 0xd2, 0xe3, 0x12, 0x86, 0x1a, 0x90, 0x9a,
-	0x12, 0x0f, 0x32, 0xae, 0x00, 0x24, 0x0d, 0xb6, 0x91, 0x31, 0x48, 0x10, 0x2e, 0x05, 0xd3, 0xa7,
-	0xd4, 0xc5, 0xc8, 0x25, 0x8f, 0x6e, 0x98, 0x63, 0x5e, 0x4a, 0x68, 0x71, 0x62, 0x3a, 0x6d, 0x5c,
-	0x2f, 0x24, 0xc9, 0xc5, 0x51, 0x52, 0x11, 0x9f, 0x54, 0x59, 0x92, 0x5a, 0x2c, 0xc1, 0xa4, 0xc0,
-	0xa8, 0xc1, 0x13, 0xc4, 0x5e, 0x52, 0xe1, 0x04, 0xe2, 0x2a, 0x35, 0x30, 0x72, 0x29, 0xe0, 0x76,
-	0x0c, 0x79, 0x3e, 0x14, 0xd2, 0xe1, 0x12, 0x42, 0x55, 0x5f, 0x5a, 0x9c, 0x9a, 0x02, 0xb6, 0x99,
-	0x25, 0x48, 0x00, 0x59, 0x79, 0x68, 0x71, 0x6a, 0x8a, 0x56, 0x0e, 0x17, 0x17, 0xc2, 0xdd, 0x42,
-	0xd2, 0x5c, 0xe2, 0x21, 0x11, 0xf1, 0x01, 0x41, 0x9e, 0xfe, 0x41, 0x9e, 0x21, 0x91, 0xf1, 0xa1,
-	0x7e, 0xc1, 0x01, 0xae, 0xce, 0x9e, 0x6e, 0x9e, 0xae, 0x2e, 0x02, 0x0c, 0x42, 0xc2, 0x5c, 0xfc,
-	0xc8, 0x92, 0x3e, 0xfe, 0xe1, 0x02, 0x8c, 0x42, 0x62, 0x5c, 0x42, 0xc8, 0x82, 0xbe, 0xae, 0x2e,
-	0x9e, 0xa1, 0xbe, 0x02, 0x4c, 0x42, 0x22, 0x5c, 0x02, 0xc8, 0xe2, 0x1e, 0x9e, 0xee, 0x1e, 0x02,
-	0xcc, 0x46, 0xfb, 0x98, 0xb8, 0x78, 0xdc, 0x13, 0x8b, 0x5d, 0x61, 0x49, 0x4d, 0xa8, 0x93, 0x91,
-	0x4b, 0x00, 0x3d, 0x04, 0x84, 0x2c, 0x08, 0x06, 0x35, 0x8e, 0x74, 0x27, 0x65, 0x49, 0x86, 0x4e,
-	0x48, 0x30, 0x2b, 0x31, 0x08, 0x2d, 0x64, 0xc4, 0x4c, 0x67, 0xb0, 0xd8, 0x10, 0x72, 0x20, 0xd9,
-	0x64, 0xb4, 0x54, 0x25, 0xe5, 0x48, 0x81, 0x09, 0x30, 0x37, 0x3a, 0x85, 0x9c, 0x78, 0x24, 0xc7,
-	0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c,
-	0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x55, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72,
-	0x7e, 0xae, 0x3e, 0xcc, 0xa2, 0xfc, 0xa2, 0x74, 0x38, 0x5b, 0x37, 0xb1, 0xa0, 0x40, 0x1f, 0x84,
-	0xd3, 0x8b, 0x0a, 0x92, 0x41, 0x19, 0x1e, 0x61, 0x71, 0x12, 0x1b, 0x38, 0xc7, 0x1b, 0x03, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0x47, 0x63, 0x14, 0xb9, 0x28, 0x04, 0x00, 0x00,
+	// 734 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xdf, 0x4f, 0xd3, 0x5e,
+	0x14, 0xdf, 0xdd, 0xf8, 0x0e, 0x38, 0xdb, 0x17, 0xea, 0x05, 0xd9, 0x80, 0x38, 0x66, 0x5f, 0x24,
+	0xa8, 0x5d, 0x80, 0x18, 0xd1, 0x27, 0x18, 0x0c, 0x36, 0x03, 0xac, 0x96, 0x2d, 0x02, 0x0f, 0x36,
+	0x5d, 0x77, 0x53, 0x1a, 0x47, 0x5b, 0x7b, 0xef, 0xc8, 0xf6, 0xe6, 0x93, 0x89, 0x3e, 0xf9, 0x2f,
+	0xf8, 0xc7, 0x18, 0x7d, 0xe4, 0xd1, 0x47, 0x03, 0xff, 0x88, 0x69, 0xe9, 0xdd, 0xba, 0x4d, 0x32,
+	0xc0, 0xf8, 0xb0, 0xac, 0xe7, 0xc7, 0xe7, 0x73, 0x7e, 0xf4, 0x9c, 0x53, 0x58, 0xd5, 0x49, 0x83,
+	0x50, 0x66, 0x6a, 0x39, 0xdd, 0x76, 0x49, 0xee, 0x6c, 0x39, 0x67, 0x68, 0x54, 0xf5, 0x34, 0xa7,
+	0x1a, 0x33, 0x6d, 0x2b, 0x2c, 0xda, 0xae, 0xe4, 0xb8, 0x36, 0xb3, 0xf1, 0x02, 0x07, 0x49, 0x1e,
+	0x48, 0x3a, 0x5b, 0x96, 0x7a, 0x41, 0xa2, 0x01, 0xa9, 0xc2, 0x95, 0x44, 0x76, 0x34, 0x2a, 0xbb,
+	0xa6, 0x4e, 0x14, 0xf2, 0xbe, 0x49, 0x28, 0xc3, 0xbb, 0x90, 0x60, 0x2d, 0xd5, 0x71, 0x4d, 0xdb,
+	0x35, 0x59, 0x3b, 0x8d, 0xb2, 0x68, 0x71, 0x62, 0xe5, 0xb1, 0x34, 0x84, 0x51, 0xaa, 0xb4, 0xe4,
+	0x00, 0xa2, 0x00, 0xeb, 0x3c, 0x8b, 0xaf, 0x20, 0x3d, 0x18, 0x88, 0x3a, 0xb6, 0x45, 0x09, 0x96,
+	0x60, 0x2a, 0x20, 0x20, 0x75, 0xd5, 0xa3, 0x73, 0x3c, 0xb3, 0x1f, 0x11, 0x29, 0xf7, 0x3a, 0x26,
+	0x8e, 0x13, 0x3f, 0x23, 0x58, 0xe8, 0x27, 0xdb, 0xb0, 0xea, 0x55, 0xaa, 0x19, 0xff, 0x26, 0x7b,
+	0x3c, 0x0b, 0x63, 0xac, 0xa5, 0xd6, 0xda, 0x8c, 0xd0, 0x74, 0x34, 0x8b, 0x16, 0x93, 0xca, 0x28,
+	0x6b, 0xe5, 0x3d, 0x51, 0xfc, 0x80, 0x20, 0x7b, 0x7d, 0x32, 0x77, 0xab, 0x10, 0x3f, 0x01, 0xdc,
+	0xeb, 0xdf, 0xa4, 0xa4, 0xee, 0x47, 0x1e, 0x51, 0x84, 0xb0, 0x7b, 0x95, 0x92, 0xba, 0x78, 0x0c,
+	0x33, 0x1c, 0x59, 0x34, 0x29, 0xb3, 0xdd, 0x36, 0xef, 0xc2, 0x3a, 0x8c, 0xb2, 0x96, 0xca, 0xda,
+	0x0e, 0x09, 0x3a, 0xf0, 0xe8, 0x06, 0x1d, 0xa8, 0xb4, 0x1d, 0xa2, 0xc4, 0x99, 0xff, 0x2f, 0x7e,
+	0x8b, 0x42, 0x6a, 0x80, 0x3c, 0xa8, 0xea, 0x21, 0x24, 0x29, 0xd3, 0x5c, 0xa6, 0x9e, 0x10, 0xd3,
+	0x38, 0x61, 0x7e, 0x88, 0x98, 0x92, 0xf0, 0x75, 0x45, 0x5f, 0x85, 0x1f, 0x00, 0x10, 0xab, 0xce,
+	0x1d, 0xa2, 0xbe, 0xc3, 0x38, 0xb1, 0xea, 0x81, 0x79, 0x06, 0xe2, 0xb5, 0x86, 0xad, 0xbf, 0xa3,
+	0xe9, 0x98, 0x5f, 0x5b, 0x20, 0x05, 0xfd, 0xd6, 0xed, 0xa6, 0xc5, 0xd2, 0x23, 0xbe, 0x65, 0x94,
+	0xb5, 0x36, 0x3d, 0x11, 0x57, 0x21, 0xe1, 0x10, 0x57, 0x27, 0x16, 0x33, 0x1b, 0x84, 0xa6, 0xff,
+	0xcb, 0xc6, 0x16, 0x13, 0x2b, 0xab, 0x43, 0xcb, 0xe2, 0x35, 0xc8, 0x1d, 0xac, 0x12, 0xe6, 0xc1,
+	0x6f, 0x61, 0xd2, 0xb4, 0xf4, 0x46, 0x93, 0x9a, 0xb6, 0xa5, 0xea, 0x4d, 0xf7, 0x8c, 0xa4, 0xe3,
+	0x3e, 0xf5, 0xb3, 0xa1, 0xd4, 0x25, 0x8e, 0x93, 0x5d, 0xbb, 0xa6, 0xd5, 0xcc, 0x86, 0x37, 0x3d,
+	0x13, 0x1d, 0xb6, 0x4d, 0x8f, 0x4c, 0x7c, 0x0d, 0x78, 0x30, 0x05, 0x9c, 0x01, 0xe8, 0x26, 0xe1,
+	0xf7, 0xef, 0x7f, 0x25, 0xa4, 0xc1, 0xf3, 0x30, 0xde, 0x9d, 0x96, 0xa8, 0x3f, 0x2d, 0x63, 0x06,
+	0x5f, 0x83, 0x2a, 0x4c, 0xff, 0x29, 0x74, 0x2f, 0x08, 0xf5, 0x82, 0x70, 0x16, 0x12, 0x4e, 0xd7,
+	0x37, 0xe0, 0x0c, 0xab, 0x96, 0x1a, 0x00, 0xdd, 0x2d, 0xc0, 0xf3, 0x90, 0xaa, 0x1c, 0xaa, 0xb2,
+	0x52, 0x2a, 0x2b, 0xa5, 0xca, 0x91, 0x5a, 0xdd, 0x3f, 0x90, 0x0b, 0x9b, 0xa5, 0xed, 0x52, 0x61,
+	0x4b, 0x88, 0xe0, 0x29, 0x98, 0x0c, 0x1b, 0x77, 0xcb, 0x6f, 0x04, 0x84, 0x67, 0x00, 0x87, 0x95,
+	0x7b, 0x85, 0xad, 0x52, 0x75, 0x4f, 0x88, 0xe2, 0x69, 0x10, 0xc2, 0xfa, 0x62, 0x69, 0xa7, 0x28,
+	0xc4, 0x96, 0xf6, 0x21, 0x7e, 0x35, 0x71, 0x38, 0x05, 0x53, 0x95, 0x43, 0xb5, 0x72, 0x24, 0x17,
+	0xfa, 0xa2, 0xcc, 0xc2, 0x7d, 0x6e, 0x90, 0x37, 0x8e, 0xd4, 0xed, 0xb2, 0xa2, 0xe6, 0x77, 0xcb,
+	0xf9, 0x03, 0x01, 0x61, 0x01, 0x92, 0xdc, 0x74, 0x50, 0xd8, 0xdf, 0x12, 0xa2, 0x2b, 0xdf, 0x63,
+	0x90, 0xdc, 0xd1, 0x68, 0x81, 0x1f, 0x42, 0xfc, 0x09, 0x81, 0xd0, 0xbf, 0x9f, 0x78, 0x6d, 0xe8,
+	0x4b, 0xbd, 0xe6, 0x2a, 0xce, 0xbd, 0xb8, 0x03, 0xf2, 0x6a, 0x5d, 0xc4, 0x08, 0xfe, 0x8a, 0x06,
+	0xaf, 0x20, 0xbf, 0x15, 0x78, 0xfd, 0xd6, 0xcc, 0x7d, 0x37, 0x6f, 0x6e, 0xe3, 0x2f, 0x18, 0x3a,
+	0x39, 0x7e, 0x44, 0x30, 0xd9, 0xb7, 0xf0, 0xf8, 0xf9, 0x8d, 0xd7, 0xab, 0xf7, 0xfe, 0xcc, 0xad,
+	0xdd, 0x1e, 0xc8, 0x13, 0xc9, 0x57, 0x7e, 0x5c, 0x64, 0xd0, 0xf9, 0x45, 0x06, 0xfd, 0xba, 0xc8,
+	0xa0, 0x2f, 0x97, 0x99, 0xc8, 0xf9, 0x65, 0x26, 0xf2, 0xf3, 0x32, 0x13, 0x39, 0x7e, 0x69, 0x98,
+	0xec, 0xa4, 0x59, 0x93, 0x74, 0xfb, 0x34, 0xc7, 0xf9, 0x6d, 0xd7, 0xe8, 0x3c, 0x3f, 0xd5, 0x1c,
+	0x27, 0xe7, 0xfd, 0x0c, 0xd7, 0xd1, 0xbd, 0xef, 0x62, 0x37, 0x5e, 0x2d, 0xee, 0x7f, 0x18, 0x57,
+	0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x11, 0xa1, 0x86, 0x19, 0x4f, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -343,6 +647,10 @@ type GasEstimatorClient interface {
 	// gas price in this case to the minimum gas price set by that node. The gas
 	// used is estimated using the state machine simulation.
 	EstimateGasPriceAndUsage(ctx context.Context, in *EstimateGasPriceAndUsageRequest, opts ...grpc.CallOption) (*EstimateGasPriceAndUsageResponse, error)
+	// GasPriceHistory returns the gas price percentiles and the inclusion
+	// probability curve of the transactions of the provided type that were
+	// committed in the recent blocks tracked by the node.
+	GasPriceHistory(ctx context.Context, in *GasPriceHistoryRequest, opts ...grpc.CallOption) (*GasPriceHistoryResponse, error)
 }
 
 type gasEstimatorClient struct {
@@ -371,6 +679,15 @@ func (c *gasEstimatorClient) EstimateGasPriceAndUsage(ctx context.Context, in *E
 	return out, nil
 }
 
+func (c *gasEstimatorClient) GasPriceHistory(ctx context.Context, in *GasPriceHistoryRequest, opts ...grpc.CallOption) (*GasPriceHistoryResponse, error) {
+	out := new(GasPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.gas_estimation.GasEstimator/GasPriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GasEstimatorServer is the server API for GasEstimator service.
 type GasEstimatorServer interface {
 	// estimateGasPrice takes a transaction priority and estimates the gas price
@@ -387,6 +704,10 @@ type GasEstimatorServer interface {
 	// gas price in this case to the minimum gas price set by that node. The gas
 	// used is estimated using the state machine simulation.
 	EstimateGasPriceAndUsage(context.Context, *EstimateGasPriceAndUsageRequest) (*EstimateGasPriceAndUsageResponse, error)
+	// GasPriceHistory returns the gas price percentiles and the inclusion
+	// probability curve of the transactions of the provided type that were
+	// committed in the recent blocks tracked by the node.
+	GasPriceHistory(context.Context, *GasPriceHistoryRequest) (*GasPriceHistoryResponse, error)
 }
 
 // UnimplementedGasEstimatorServer can be embedded to have forward compatible implementations.
@@ -399,6 +720,9 @@ func (*UnimplementedGasEstimatorServer) EstimateGasPrice(ctx context.Context, re
 func (*UnimplementedGasEstimatorServer) EstimateGasPriceAndUsage(ctx context.Context, req *EstimateGasPriceAndUsageRequest) (*EstimateGasPriceAndUsageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGasPriceAndUsage not implemented")
 }
+func (*UnimplementedGasEstimatorServer) GasPriceHistory(ctx context.Context, req *GasPriceHistoryRequest) (*GasPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPriceHistory not implemented")
+}
 
 func RegisterGasEstimatorServer(s grpc1.Server, srv GasEstimatorServer) {
 	s.RegisterService(&_GasEstimator_serviceDesc, srv)
@@ -440,6 +764,24 @@ func _GasEstimator_EstimateGasPriceAndUsage_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GasEstimator_GasPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GasPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GasEstimatorServer).GasPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.gas_estimation.GasEstimator/GasPriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GasEstimatorServer).GasPriceHistory(ctx, req.(*GasPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var GasEstimator_serviceDesc = _GasEstimator_serviceDesc
 var _GasEstimator_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.core.v1.gas_estimation.GasEstimator",
@@ -453,6 +795,10 @@ var _GasEstimator_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateGasPriceAndUsage",
 			Handler:    _GasEstimator_EstimateGasPriceAndUsage_Handler,
 		},
+		{
+			MethodName: "GasPriceHistory",
+			Handler:    _GasEstimator_GasPriceHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/core/v1/gas_estimation/gas_estimator.proto",
@@ -584,73 +930,316 @@ func (m *EstimateGasPriceAndUsageResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGasEstimator(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGasEstimator(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GasPriceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EstimateGasPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TxPriority != 0 {
-		n += 1 + sovGasEstimator(uint64(m.TxPriority))
-	}
-	return n
+
+func (m *GasPriceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateGasPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GasPriceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.EstimatedGasPrice != 0 {
-		n += 9
+	if m.TxType != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.TxType))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EstimateGasPriceAndUsageRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GasPriceHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TxPriority != 0 {
-		n += 1 + sovGasEstimator(uint64(m.TxPriority))
+	if len(m.InclusionCurve) > 0 {
+		for iNdEx := len(m.InclusionCurve) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InclusionCurve[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGasEstimator(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	l = len(m.TxBytes)
-	if l > 0 {
-		n += 1 + l + sovGasEstimator(uint64(l))
+	if len(m.Percentiles) > 0 {
+		for iNdEx := len(m.Percentiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Percentiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGasEstimator(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	return n
-}
-
-func (m *EstimateGasPriceAndUsageResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.TxCount != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	if m.EstimatedGasPrice != 0 {
-		n += 9
+	if m.Blocks != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.EstimatedGasUsed != 0 {
-		n += 1 + sovGasEstimator(uint64(m.EstimatedGasUsed))
+	if m.EndHeight != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
-}
-
-func sovGasEstimator(x uint64) (n int) {
+	if m.StartHeight != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPricePercentile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPricePercentile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPricePercentile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasPrice != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.GasPrice))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.Percentile != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.Percentile))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InclusionProbability) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InclusionProbability) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InclusionProbability) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Probability != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Probability))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.GasPrice != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.GasPrice))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGasEstimator(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGasEstimator(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EstimateGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxPriority != 0 {
+		n += 1 + sovGasEstimator(uint64(m.TxPriority))
+	}
+	return n
+}
+
+func (m *EstimateGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EstimatedGasPrice != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *EstimateGasPriceAndUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxPriority != 0 {
+		n += 1 + sovGasEstimator(uint64(m.TxPriority))
+	}
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovGasEstimator(uint64(l))
+	}
+	return n
+}
+
+func (m *EstimateGasPriceAndUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EstimatedGasPrice != 0 {
+		n += 9
+	}
+	if m.EstimatedGasUsed != 0 {
+		n += 1 + sovGasEstimator(uint64(m.EstimatedGasUsed))
+	}
+	return n
+}
+
+func (m *GasPriceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxType != 0 {
+		n += 1 + sovGasEstimator(uint64(m.TxType))
+	}
+	return n
+}
+
+func (m *GasPriceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovGasEstimator(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovGasEstimator(uint64(m.EndHeight))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovGasEstimator(uint64(m.Blocks))
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovGasEstimator(uint64(m.TxCount))
+	}
+	if len(m.Percentiles) > 0 {
+		for _, e := range m.Percentiles {
+			l = e.Size()
+			n += 1 + l + sovGasEstimator(uint64(l))
+		}
+	}
+	if len(m.InclusionCurve) > 0 {
+		for _, e := range m.InclusionCurve {
+			l = e.Size()
+			n += 1 + l + sovGasEstimator(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GasPricePercentile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Percentile != 0 {
+		n += 1 + sovGasEstimator(uint64(m.Percentile))
+	}
+	if m.GasPrice != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *InclusionProbability) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasPrice != 0 {
+		n += 9
+	}
+	if m.Probability != 0 {
+		n += 9
+	}
+	return n
+}
+
+func sovGasEstimator(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozGasEstimator(x uint64) (n int) {
@@ -969,6 +1558,421 @@ func (m *EstimateGasPriceAndUsageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GasPriceHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+			}
+			m.TxType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxType |= TxType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPriceHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Percentiles = append(m.Percentiles, &GasPricePercentile{})
+			if err := m.Percentiles[len(m.Percentiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionCurve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InclusionCurve = append(m.InclusionCurve, &InclusionProbability{})
+			if err := m.InclusionCurve[len(m.InclusionCurve)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPricePercentile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPricePercentile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPricePercentile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentile", wireType)
+			}
+			m.Percentile = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Percentile |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.GasPrice = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InclusionProbability) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InclusionProbability: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InclusionProbability: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.GasPrice = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Probability", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Probability = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGasEstimator(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,235 @@
+package gasestimation
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// DefaultGasPriceHistoryBlocks is the number of recently committed blocks
+// that the gas price history keeps by default.
+const DefaultGasPriceHistoryBlocks = 100
+
+// historyPercentiles are the gas price percentiles returned by the gas price
+// history.
+var historyPercentiles = []uint32{10, 25, 50, 75, 90}
+
+var _ storetypes.ABCIListener = &GasPriceHistory{}
+
+// GasPriceHistory keeps the gas prices of the transactions committed in a
+// rolling window of recent blocks, split by transaction type. It implements
+// storetypes.ABCIListener so that it can be registered on the BaseApp
+// streaming manager.
+//
+// The history is kept in memory and is empty after a restart.
+type GasPriceHistory struct {
+	txDecoder sdk.TxDecoder
+	maxBlocks int
+
+	mu     sync.RWMutex
+	blocks []blockGasPrices
+}
+
+// blockGasPrices are the gas prices of the transactions of a single block.
+type blockGasPrices struct {
+	height int64
+	// size is the total size of the block transactions in bytes.
+	size uint64
+	// gasPrices are the sorted gas prices per transaction type. The
+	// TX_TYPE_UNSPECIFIED entry holds the gas prices of every transaction.
+	gasPrices map[TxType][]float64
+}
+
+// NewGasPriceHistory returns a GasPriceHistory that keeps the gas prices of
+// the last maxBlocks blocks.
+func NewGasPriceHistory(txDecoder sdk.TxDecoder, maxBlocks int) *GasPriceHistory {
+	return &GasPriceHistory{
+		txDecoder: txDecoder,
+		maxBlocks: maxBlocks,
+	}
+}
+
+// ListenFinalizeBlock records the gas prices of the finalized block.
+func (h *GasPriceHistory) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, _ abci.ResponseFinalizeBlock) error {
+	h.RecordBlock(req.Height, req.Txs)
+	return nil
+}
+
+// ListenCommit is a no-op as the history only depends on the block data.
+func (h *GasPriceHistory) ListenCommit(_ context.Context, _ abci.ResponseCommit, _ []*storetypes.StoreKVPair) error {
+	return nil
+}
+
+// RecordBlock records the gas prices of the provided block transactions.
+// Transactions that can't be decoded are not recorded but still count toward
+// the block size. Recording a height that is not higher than the last
+// recorded one replaces the blocks from that height onwards.
+func (h *GasPriceHistory) RecordBlock(height int64, txs [][]byte) {
+	block := blockGasPrices{
+		height:    height,
+		gasPrices: make(map[TxType][]float64),
+	}
+	for _, rawTx := range txs {
+		block.size += uint64(len(rawTx))
+		txType, gasPrice, ok := h.decodeGasPrice(rawTx)
+		if !ok {
+			continue
+		}
+		block.gasPrices[TxType_TX_TYPE_UNSPECIFIED] = append(block.gasPrices[TxType_TX_TYPE_UNSPECIFIED], gasPrice)
+		if txType != TxType_TX_TYPE_UNSPECIFIED {
+			block.gasPrices[txType] = append(block.gasPrices[txType], gasPrice)
+		}
+	}
+	for _, gasPrices := range block.gasPrices {
+		sort.Float64s(gasPrices)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for len(h.blocks) > 0 && h.blocks[len(h.blocks)-1].height >= height {
+		h.blocks = h.blocks[:len(h.blocks)-1]
+	}
+	h.blocks = append(h.blocks, block)
+	if len(h.blocks) > h.maxBlocks {
+		h.blocks = h.blocks[len(h.blocks)-h.maxBlocks:]
+	}
+}
+
+// decodeGasPrice returns the type and the gas price of the provided raw
+// transaction. It returns false if the transaction can't be decoded.
+func (h *GasPriceHistory) decodeGasPrice(rawTx []byte) (TxType, float64, bool) {
+	bTx, isBlob, err := blobtx.UnmarshalBlobTx(rawTx)
+	if isBlob {
+		if err != nil {
+			return 0, 0, false
+		}
+		rawTx = bTx.Tx
+	}
+	sdkTx, err := h.txDecoder(rawTx)
+	if err != nil {
+		return 0, 0, false
+	}
+	feeTx, ok := sdkTx.(sdk.FeeTx)
+	if !ok || feeTx.GetGas() == 0 {
+		return 0, 0, false
+	}
+	if isBlob {
+		return TxType_TX_TYPE_PAY_FOR_BLOBS, txGasPrice(feeTx), true
+	}
+	return txTypeOf(sdkTx), txGasPrice(feeTx), true
+}
+
+// Query returns the gas price history of the transactions of the provided
+// type. maxBlockBytes is the current max square size in bytes, it is used to
+// decide whether a block was congested.
+func (h *GasPriceHistory) Query(txType TxType, maxBlockBytes uint64) *GasPriceHistoryResponse {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	resp := &GasPriceHistoryResponse{
+		Blocks:         uint64(len(h.blocks)),
+		Percentiles:    make([]*GasPricePercentile, 0, len(historyPercentiles)),
+		InclusionCurve: make([]*InclusionProbability, 0),
+	}
+	if len(h.blocks) == 0 {
+		return resp
+	}
+	resp.StartHeight = h.blocks[0].height
+	resp.EndHeight = h.blocks[len(h.blocks)-1].height
+
+	gasPrices := make([]float64, 0)
+	for _, block := range h.blocks {
+		gasPrices = append(gasPrices, block.gasPrices[txType]...)
+	}
+	sort.Float64s(gasPrices)
+	resp.TxCount = uint64(len(gasPrices))
+	if len(gasPrices) > 0 {
+		for _, p := range historyPercentiles {
+			resp.Percentiles = append(resp.Percentiles, &GasPricePercentile{
+				Percentile: p,
+				GasPrice:   percentile(gasPrices, p),
+			})
+		}
+	}
+
+	clearingGasPrices := h.clearingGasPrices(txType, maxBlockBytes)
+	for i, gasPrice := range clearingGasPrices {
+		if i+1 < len(clearingGasPrices) && clearingGasPrices[i+1] == gasPrice {
+			continue
+		}
+		resp.InclusionCurve = append(resp.InclusionCurve, &InclusionProbability{
+			GasPrice:    gasPrice,
+			Probability: float64(i+1) / float64(len(clearingGasPrices)),
+		})
+	}
+	return resp
+}
+
+// ClearingGasPrices returns the sorted minimum gas prices that a transaction
+// of the provided type had to pay to be included in each of the recorded
+// blocks. See clearingGasPrices.
+func (h *GasPriceHistory) ClearingGasPrices(txType TxType, maxBlockBytes uint64) []float64 {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.clearingGasPrices(txType, maxBlockBytes)
+}
+
+// clearingGasPrices returns the sorted clearing gas prices of the recorded
+// blocks. The clearing gas price of a block is zero if the block was filled
+// below gasPriceEstimationThreshold, as any valid transaction would have been
+// included in it. Otherwise, it is the lowest gas price of the block
+// transactions of the provided type, or of all the block transactions if it
+// has none of that type.
+//
+// The caller must hold the lock.
+func (h *GasPriceHistory) clearingGasPrices(txType TxType, maxBlockBytes uint64) []float64 {
+	clearingGasPrices := make([]float64, len(h.blocks))
+	for i, block := range h.blocks {
+		if float64(block.size) < float64(maxBlockBytes)*gasPriceEstimationThreshold {
+			continue
+		}
+		gasPrices := block.gasPrices[txType]
+		if len(gasPrices) == 0 {
+			gasPrices = block.gasPrices[TxType_TX_TYPE_UNSPECIFIED]
+		}
+		if len(gasPrices) > 0 {
+			clearingGasPrices[i] = gasPrices[0]
+		}
+	}
+	sort.Float64s(clearingGasPrices)
+	return clearingGasPrices
+}
+
+// txTypeOf returns TX_TYPE_SEND if the transaction only contains bank send
+// messages and TX_TYPE_UNSPECIFIED otherwise.
+func txTypeOf(tx sdk.Tx) TxType {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return TxType_TX_TYPE_UNSPECIFIED
+	}
+	for _, msg := range msgs {
+		switch msg.(type) {
+		case *banktypes.MsgSend, *banktypes.MsgMultiSend:
+		default:
+			return TxType_TX_TYPE_UNSPECIFIED
+		}
+	}
+	return TxType_TX_TYPE_SEND
+}
+
+// percentile returns the nearest-rank percentile of the provided gas prices.
+// Expects a sorted non-empty slice.
+func percentile(gasPrices []float64, p uint32) float64 {
+	rank := int(math.Ceil(float64(p) / 100 * float64(len(gasPrices))))
+	if rank < 1 {
+		rank = 1
+	}
+	return gasPrices[rank-1]
+}
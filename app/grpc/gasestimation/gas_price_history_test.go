@@ -0,0 +1,205 @@
+package gasestimation_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v5/app"
+	"github.com/celestiaorg/celestia-app/v5/app/encoding"
+	"github.com/celestiaorg/celestia-app/v5/app/grpc/gasestimation"
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v5/pkg/user"
+	"github.com/celestiaorg/celestia-app/v5/test/util/testnode"
+	"github.com/celestiaorg/go-square/v2/share"
+	rpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testGasLimit = 100_000
+
+type txFactory struct {
+	t      *testing.T
+	signer *user.Signer
+}
+
+func newTxFactory(t *testing.T) txFactory {
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	return txFactory{t: t, signer: signer}
+}
+
+func (f txFactory) send(gasPrice float64) []byte {
+	addr := f.signer.Accounts()[0].Address()
+	msg := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1)))
+	rawTx, _, err := f.signer.CreateTx([]sdk.Msg{msg}, user.SetGasLimitAndGasPrice(testGasLimit, gasPrice))
+	require.NoError(f.t, err)
+	return rawTx
+}
+
+func (f txFactory) delegate(gasPrice float64) []byte {
+	addr := f.signer.Accounts()[0].Address()
+	msg := stakingtypes.NewMsgDelegate(addr.String(), sdk.ValAddress(addr).String(), sdk.NewInt64Coin(appconsts.BondDenom, 1))
+	rawTx, _, err := f.signer.CreateTx([]sdk.Msg{msg}, user.SetGasLimitAndGasPrice(testGasLimit, gasPrice))
+	require.NoError(f.t, err)
+	return rawTx
+}
+
+func (f txFactory) pfb(gasPrice float64) []byte {
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	blob, err := share.NewV0Blob(ns, []byte("data"))
+	require.NoError(f.t, err)
+	rawTx, _, err := f.signer.CreatePayForBlobs(f.signer.Accounts()[0].Name(), []*share.Blob{blob}, user.SetGasLimitAndGasPrice(testGasLimit, gasPrice))
+	require.NoError(f.t, err)
+	return rawTx
+}
+
+func newHistory(maxBlocks int) *gasestimation.GasPriceHistory {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	return gasestimation.NewGasPriceHistory(encCfg.TxConfig.TxDecoder(), maxBlocks)
+}
+
+func TestGasPriceHistoryQuery(t *testing.T) {
+	f := newTxFactory(t)
+	history := newHistory(3)
+
+	// the first block is dropped from the window
+	history.RecordBlock(1, [][]byte{f.send(100), f.pfb(100)})
+	history.RecordBlock(2, [][]byte{f.send(1), f.send(2), f.pfb(5), f.delegate(9), []byte("not a tx")})
+	history.RecordBlock(3, [][]byte{f.send(3), f.pfb(6), f.pfb(7)})
+	history.RecordBlock(4, [][]byte{f.send(4), f.pfb(8)})
+
+	// every block is congested
+	resp := history.Query(gasestimation.TxType_TX_TYPE_SEND, 1)
+	assert.EqualValues(t, 2, resp.StartHeight)
+	assert.EqualValues(t, 4, resp.EndHeight)
+	assert.EqualValues(t, 3, resp.Blocks)
+	assert.EqualValues(t, 4, resp.TxCount)
+	assert.Equal(t, []*gasestimation.GasPricePercentile{
+		{Percentile: 10, GasPrice: 1},
+		{Percentile: 25, GasPrice: 1},
+		{Percentile: 50, GasPrice: 2},
+		{Percentile: 75, GasPrice: 3},
+		{Percentile: 90, GasPrice: 4},
+	}, resp.Percentiles)
+	assert.Equal(t, []*gasestimation.InclusionProbability{
+		{GasPrice: 1, Probability: 1.0 / 3},
+		{GasPrice: 3, Probability: 2.0 / 3},
+		{GasPrice: 4, Probability: 1},
+	}, resp.InclusionCurve)
+
+	resp = history.Query(gasestimation.TxType_TX_TYPE_PAY_FOR_BLOBS, 1)
+	assert.EqualValues(t, 4, resp.TxCount)
+	assert.Equal(t, float64(6), resp.Percentiles[2].GasPrice)
+
+	resp = history.Query(gasestimation.TxType_TX_TYPE_UNSPECIFIED, 1)
+	assert.EqualValues(t, 9, resp.TxCount)
+	assert.Equal(t, float64(9), resp.Percentiles[4].GasPrice)
+
+	// no block is congested so any gas price would have been included
+	resp = history.Query(gasestimation.TxType_TX_TYPE_SEND, uint64(appconsts.DefaultUpperBoundMaxBytes))
+	assert.Equal(t, []*gasestimation.InclusionProbability{{GasPrice: 0, Probability: 1}}, resp.InclusionCurve)
+
+	// recording an earlier height replaces the following blocks
+	history.RecordBlock(3, [][]byte{f.send(10)})
+	resp = history.Query(gasestimation.TxType_TX_TYPE_SEND, 1)
+	assert.EqualValues(t, 2, resp.Blocks)
+	assert.EqualValues(t, 3, resp.EndHeight)
+	assert.EqualValues(t, 1, history.Query(gasestimation.TxType_TX_TYPE_PAY_FOR_BLOBS, 1).TxCount)
+}
+
+func TestGasPriceHistoryServer(t *testing.T) {
+	f := newTxFactory(t)
+	history := newHistory(gasestimation.DefaultGasPriceHistoryBlocks)
+	for height := int64(1); height <= 10; height++ {
+		history.RecordBlock(height, [][]byte{f.send(float64(height)), f.pfb(float64(height) * 10)})
+	}
+
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	newServerWithNetworkMinGasPrice := func(history *gasestimation.GasPriceHistory, networkMinGasPrice float64) gasestimation.GasEstimatorServer {
+		return gasestimation.NewGasEstimatorServer(
+			emptyMempool{},
+			encCfg.TxConfig.TxDecoder(),
+			// every recorded block is above the congestion threshold
+			func() (uint64, error) { return 1, nil },
+			func() (float64, error) { return networkMinGasPrice, nil },
+			func([]byte) (sdk.GasInfo, *sdk.Result, error) { return sdk.GasInfo{GasUsed: 100}, nil, nil },
+			history,
+		)
+	}
+	newServer := func(history *gasestimation.GasPriceHistory) gasestimation.GasEstimatorServer {
+		return newServerWithNetworkMinGasPrice(history, appconsts.DefaultNetworkMinGasPrice)
+	}
+	server := newServer(history)
+
+	t.Run("quiet mempool estimates from the history", func(t *testing.T) {
+		resp, err := server.EstimateGasPrice(context.Background(), &gasestimation.EstimateGasPriceRequest{TxPriority: gasestimation.TxPriority_TX_PRIORITY_HIGH})
+		require.NoError(t, err)
+		assert.Equal(t, float64(10), resp.EstimatedGasPrice)
+
+		usage, err := server.EstimateGasPriceAndUsage(context.Background(), &gasestimation.EstimateGasPriceAndUsageRequest{
+			TxPriority: gasestimation.TxPriority_TX_PRIORITY_HIGH,
+			TxBytes:    f.pfb(1),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, float64(100), usage.EstimatedGasPrice)
+	})
+
+	t.Run("quiet mempool without history returns the min gas price", func(t *testing.T) {
+		resp, err := newServer(nil).EstimateGasPrice(context.Background(), &gasestimation.EstimateGasPriceRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, appconsts.DefaultMinGasPrice, resp.EstimatedGasPrice)
+	})
+
+	t.Run("quiet mempool without history returns a higher network min gas price", func(t *testing.T) {
+		networkMinGasPrice := 0.5
+		resp, err := newServerWithNetworkMinGasPrice(nil, networkMinGasPrice).EstimateGasPrice(context.Background(), &gasestimation.EstimateGasPriceRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, networkMinGasPrice, resp.EstimatedGasPrice)
+	})
+
+	t.Run("gas price history", func(t *testing.T) {
+		resp, err := server.GasPriceHistory(context.Background(), &gasestimation.GasPriceHistoryRequest{TxType: gasestimation.TxType_TX_TYPE_PAY_FOR_BLOBS})
+		require.NoError(t, err)
+		assert.EqualValues(t, 10, resp.TxCount)
+		assert.Len(t, resp.InclusionCurve, 10)
+	})
+
+	testCases := []struct {
+		name   string
+		server gasestimation.GasEstimatorServer
+		req    *gasestimation.GasPriceHistoryRequest
+		code   codes.Code
+	}{
+		{"nil request", server, nil, codes.InvalidArgument},
+		{"unknown tx type", server, &gasestimation.GasPriceHistoryRequest{TxType: 100}, codes.InvalidArgument},
+		{"history not enabled", newServer(nil), &gasestimation.GasPriceHistoryRequest{}, codes.Unavailable},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.server.GasPriceHistory(context.Background(), tc.req)
+			assert.Equal(t, tc.code, status.Code(err))
+		})
+	}
+}
+
+type emptyMempool struct{}
+
+func (emptyMempool) UnconfirmedTxs(context.Context, *int) (*rpctypes.ResultUnconfirmedTxs, error) {
+	return &rpctypes.ResultUnconfirmedTxs{}, nil
+}
+
+func (emptyMempool) NumUnconfirmedTxs(context.Context) (*rpctypes.ResultUnconfirmedTxs, error) {
+	return &rpctypes.ResultUnconfirmedTxs{}, nil
+}
+
+func (emptyMempool) CheckTx(context.Context, types.Tx) (*rpctypes.ResultCheckTx, error) {
+	return nil, nil
+}
@@ -0,0 +1,120 @@
+package proposal
+
+import (
+	"context"
+	"sync"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultReportRetention is the number of most recent exclusion reports kept
+// by a ReportStore.
+const DefaultReportRetention = 1000
+
+// ReportStore keeps the exclusion reports of the most recent proposals that
+// this node prepared in memory. It is safe for concurrent use.
+type ReportStore struct {
+	mu        sync.RWMutex
+	retention int
+	reports   map[int64]*ExclusionReport
+	// heights holds the heights of the stored reports in insertion order.
+	heights []int64
+}
+
+// NewReportStore returns a ReportStore that keeps at most retention reports.
+func NewReportStore(retention int) *ReportStore {
+	if retention <= 0 {
+		retention = DefaultReportRetention
+	}
+	return &ReportStore{
+		retention: retention,
+		reports:   make(map[int64]*ExclusionReport),
+	}
+}
+
+// Set stores the report, replacing any report previously stored for the same
+// height, e.g. from an earlier round. The oldest report is pruned once the
+// retention is exceeded.
+func (s *ReportStore) Set(report *ExclusionReport) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.reports[report.Height]; !ok {
+		s.heights = append(s.heights, report.Height)
+	}
+	s.reports[report.Height] = report
+
+	for len(s.heights) > s.retention {
+		delete(s.reports, s.heights[0])
+		s.heights = s.heights[1:]
+	}
+}
+
+// Get returns the report for the provided height.
+func (s *ReportStore) Get(height int64) (*ExclusionReport, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	report, ok := s.reports[height]
+	return report, ok
+}
+
+// Latest returns the most recently stored report.
+func (s *ReportStore) Latest() (*ExclusionReport, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if len(s.heights) == 0 {
+		return nil, false
+	}
+	return s.reports[s.heights[len(s.heights)-1]], true
+}
+
+// RegisterProposalService registers the proposal service on the gRPC router.
+func RegisterProposalService(qrt gogogrpc.Server, store *ReportStore) {
+	RegisterProposalServer(qrt, NewProposalServer(store))
+}
+
+// RegisterGRPCGatewayRoutes mounts the proposal service's GRPC-gateway routes
+// on the given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	err := RegisterProposalHandlerClient(context.Background(), mux, NewProposalClient(clientConn))
+	if err != nil {
+		panic(err)
+	}
+}
+
+var _ ProposalServer = &proposalServer{}
+
+type proposalServer struct {
+	store *ReportStore
+}
+
+func NewProposalServer(store *ReportStore) ProposalServer {
+	return &proposalServer{store: store}
+}
+
+// ExclusionReport implements the ProposalServer.ExclusionReport method.
+func (s *proposalServer) ExclusionReport(_ context.Context, req *ExclusionReportRequest) (*ExclusionReportResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if req.Height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "height cannot be negative")
+	}
+
+	var (
+		report *ExclusionReport
+		ok     bool
+	)
+	if req.Height == 0 {
+		report, ok = s.store.Latest()
+	} else {
+		report, ok = s.store.Get(req.Height)
+	}
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no exclusion report for height %d: this node did not prepare a proposal at that height or the report was pruned", req.Height)
+	}
+	return &ExclusionReportResponse{Report: report}, nil
+}
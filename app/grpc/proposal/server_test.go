@@ -0,0 +1,71 @@
+package proposal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReportStore(t *testing.T) {
+	store := NewReportStore(2)
+
+	_, ok := store.Latest()
+	assert.False(t, ok)
+
+	store.Set(&ExclusionReport{Height: 1})
+	store.Set(&ExclusionReport{Height: 2})
+	// a later round at the same height replaces the earlier report
+	store.Set(&ExclusionReport{Height: 2, IncludedTxs: 5})
+
+	report, ok := store.Get(2)
+	require.True(t, ok)
+	assert.EqualValues(t, 5, report.IncludedTxs)
+
+	store.Set(&ExclusionReport{Height: 3})
+	_, ok = store.Get(1)
+	assert.False(t, ok, "oldest report should have been pruned")
+
+	latest, ok := store.Latest()
+	require.True(t, ok)
+	assert.EqualValues(t, 3, latest.Height)
+}
+
+func TestExclusionReport(t *testing.T) {
+	store := NewReportStore(DefaultReportRetention)
+	excluded := &ExcludedTx{
+		TxHash: "ABCD",
+		Reason: ExclusionReason_EXCLUSION_REASON_ANTE_ERROR,
+		Code:   32,
+	}
+	store.Set(&ExclusionReport{Height: 10, ExcludedTxs: []*ExcludedTx{excluded}})
+	server := NewProposalServer(store)
+
+	testCases := []struct {
+		name     string
+		req      *ExclusionReportRequest
+		wantCode codes.Code
+	}{
+		{name: "nil request", req: nil, wantCode: codes.InvalidArgument},
+		{name: "negative height", req: &ExclusionReportRequest{Height: -1}, wantCode: codes.InvalidArgument},
+		{name: "unknown height", req: &ExclusionReportRequest{Height: 11}, wantCode: codes.NotFound},
+		{name: "latest", req: &ExclusionReportRequest{}, wantCode: codes.OK},
+		{name: "by height", req: &ExclusionReportRequest{Height: 10}, wantCode: codes.OK},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := server.ExclusionReport(context.Background(), tc.req)
+			if tc.wantCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tc.wantCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.EqualValues(t, 10, resp.Report.Height)
+			assert.Equal(t, []*ExcludedTx{excluded}, resp.Report.ExcludedTxs)
+		})
+	}
+}
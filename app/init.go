@@ -23,6 +23,12 @@ const appDirectory = ".celestia-app"
 // This gets set as a side-effect of the init() function.
 var NodeHome string
 
+// FlagUpgradeHome is the app option that overrides NodeHome as the home
+// directory that the upgrade keeper keeps the upgrade info in. Only the
+// upgrade rehearsal sets it, so that it doesn't touch the upgrade info of the
+// node.
+const FlagUpgradeHome = "upgrade-home"
+
 func init() {
 	var err error
 	clienthelpers.EnvPrefix = EnvPrefix
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/celestiaorg/celestia-app/v5/app/ante"
+	"github.com/celestiaorg/celestia-app/v5/app/grpc/proposal"
 	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v5/pkg/da"
 	"github.com/celestiaorg/go-square/v2/share"
@@ -38,6 +39,7 @@ func (app *App) PrepareProposalHandler(ctx sdk.Context, req *abci.RequestPrepare
 		app.encodingConfig.TxConfig,
 		app.MaxEffectiveSquareSize(ctx),
 		appconsts.SubtreeRootThreshold,
+		WithTxOrdering(app.txOrdering),
 	)
 	if err != nil {
 		panic(err)
@@ -45,6 +47,12 @@ func (app *App) PrepareProposalHandler(ctx sdk.Context, req *abci.RequestPrepare
 
 	txs := fsb.Fill(ctx, req.Txs)
 
+	app.exclusionReports.Set(&proposal.ExclusionReport{
+		Height:      req.Height,
+		IncludedTxs: uint32(len(txs)),
+		ExcludedTxs: fsb.Excluded(),
+	})
+
 	// Build the square from the set of valid and prioritised transactions.
 	dataSquare, err := fsb.Build()
 	if err != nil {
@@ -162,6 +162,7 @@ func (app *App) ProcessProposalHandler(ctx sdk.Context, req *abci.RequestProcess
 		return reject(), nil
 	}
 
+	app.usedShares.set(req.Hash, usedShares(dataSquare))
 	return accept(), nil
 }
 
@@ -1,7 +1,12 @@
 package app
 
 import (
+	"sync"
+
+	"cosmossdk.io/math"
 	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	"github.com/celestiaorg/go-square/v2"
+	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -11,3 +16,84 @@ func (app *App) MaxEffectiveSquareSize(ctx sdk.Context) int {
 	hardMax := appconsts.GetSquareSizeUpperBound(ctx.ChainID())
 	return min(int(govMax), hardMax)
 }
+
+// SquareUtilization returns the fraction of the shares of the max effective
+// square that the square built from the provided block txs uses.
+func (app *App) SquareUtilization(ctx sdk.Context, txs [][]byte) (math.LegacyDec, error) {
+	maxSquareSize := app.MaxEffectiveSquareSize(ctx)
+	dataSquare, err := square.Construct(txs, maxSquareSize, appconsts.SubtreeRootThreshold)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	return squareUtilization(usedShares(dataSquare), maxSquareSize), nil
+}
+
+// squareUtilization returns the fraction of the shares of the max effective
+// square that the used shares occupy.
+func squareUtilization(usedShares, maxSquareSize int) math.LegacyDec {
+	return math.LegacyNewDec(int64(usedShares)).QuoInt64(int64(maxSquareSize * maxSquareSize))
+}
+
+// usedShares returns the number of shares of the square that aren't tail
+// padding. The square size is a power of two, so it would only change the
+// utilization in steps of a factor of four.
+func usedShares(dataSquare square.Square) int {
+	used := len(dataSquare)
+	for used > 0 && dataSquare[used-1].Namespace().IsTailPadding() {
+		used--
+	}
+	return used
+}
+
+// recordSquareUtilization records the utilization of the square of the block
+// being finalized so that the minfee EndBlocker can adjust the dynamic network
+// min gas price. It is a no-op unless the dynamic network min gas price is
+// enabled. The square is only rebuilt if this node didn't process the proposal
+// of the block, e.g. while it syncs.
+func (app *App) recordSquareUtilization(ctx sdk.Context, req *abci.RequestFinalizeBlock) {
+	used, processed := app.usedShares.take(req.Hash)
+	if !app.MinFeeKeeper.IsDynamicMinGasPriceEnabled(ctx) {
+		return
+	}
+	if processed {
+		app.MinFeeKeeper.SetSquareUtilization(ctx, squareUtilization(used, app.MaxEffectiveSquareSize(ctx)))
+		return
+	}
+	utilization, err := app.SquareUtilization(ctx, req.Txs)
+	if err != nil {
+		// the block was accepted by consensus so this is not expected. The
+		// price is then left unchanged for this block.
+		ctx.Logger().Error("failed to compute the square utilization", "error", err)
+		return
+	}
+	app.MinFeeKeeper.SetSquareUtilization(ctx, utilization)
+}
+
+// usedSharesCache keeps the number of used shares of the squares of the
+// proposals that were accepted by ProcessProposal, by block hash, until the
+// next block is finalized.
+type usedSharesCache struct {
+	mtx    sync.Mutex
+	shares map[string]int
+}
+
+func newUsedSharesCache() *usedSharesCache {
+	return &usedSharesCache{shares: make(map[string]int)}
+}
+
+// set stores the number of used shares of the proposal with the block hash.
+func (c *usedSharesCache) set(hash []byte, usedShares int) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.shares[string(hash)] = usedShares
+}
+
+// take returns the number of used shares of the block with the hash, if its
+// proposal was accepted, and forgets those of all the proposals.
+func (c *usedSharesCache) take(hash []byte) (int, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	usedShares, ok := c.shares[string(hash)]
+	clear(c.shares)
+	return usedShares, ok && len(hash) > 0
+}
@@ -0,0 +1,50 @@
+package app_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v5/app"
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	testutil "github.com/celestiaorg/celestia-app/v5/test/util"
+	"github.com/celestiaorg/celestia-app/v5/test/util/testfactory"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSquareUtilization(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	ctx := testApp.NewContext(false)
+
+	utilization, err := testApp.SquareUtilization(ctx, nil)
+	require.NoError(t, err)
+	assert.True(t, utilization.IsZero())
+
+	txs := testfactory.GenerateRandomTxs(10, 1000).ToSliceOfBytes()
+	utilization, err = testApp.SquareUtilization(ctx, txs)
+	require.NoError(t, err)
+	assert.True(t, utilization.IsPositive())
+	assert.True(t, utilization.LT(math.LegacyOneDec()))
+
+	// the utilization is the fraction of the shares of the max effective
+	// square that aren't tail padding.
+	maxSquareSize := testApp.MaxEffectiveSquareSize(ctx)
+	dataSquare, err := square.Construct(txs, maxSquareSize, appconsts.SubtreeRootThreshold)
+	require.NoError(t, err)
+	used := 0
+	for _, sh := range dataSquare {
+		if !sh.Namespace().IsTailPadding() {
+			used++
+		}
+	}
+	want := math.LegacyNewDec(int64(used)).QuoInt64(int64(maxSquareSize * maxSquareSize))
+	assert.Equal(t, want, utilization)
+
+	// the used shares fall between two square sizes, so the utilization is
+	// between their fractions of the max effective square.
+	size := dataSquare.Size()
+	maxShares := int64(maxSquareSize * maxSquareSize)
+	assert.True(t, utilization.GT(math.LegacyNewDec(int64(size*size/4)).QuoInt64(maxShares)), utilization)
+	assert.True(t, utilization.LT(math.LegacyNewDec(int64(size*size)).QuoInt64(maxShares)), utilization)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/celestiaorg/celestia-app/v5/app"
 	"github.com/celestiaorg/celestia-app/v5/app/encoding"
+	"github.com/celestiaorg/celestia-app/v5/app/grpc/proposal"
 	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v5/pkg/user"
 	testutil "github.com/celestiaorg/celestia-app/v5/test/util"
@@ -19,12 +20,14 @@ import (
 	"github.com/celestiaorg/go-square/v2/share"
 	blobtx "github.com/celestiaorg/go-square/v2/tx"
 	abci "github.com/cometbft/cometbft/abci/types"
+	tmbytes "github.com/cometbft/cometbft/libs/bytes"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cometbft/cometbft/proto/tendermint/version"
 	coretypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -476,3 +479,138 @@ func repeat[T any](n int, val T) []T {
 	}
 	return result
 }
+
+func TestFilteredSquareBuilderFeePriorityOrdering(t *testing.T) {
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := testfactory.GenerateAccounts(3)
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+	infos := queryAccountInfo(testApp, accounts, kr)
+
+	createBlobTx := func(account int, sequence uint64, gasPrice float64) []byte {
+		signer, err := user.NewSigner(kr, enc.TxConfig, testutil.ChainID, user.NewAccount(accounts[account], infos[account].AccountNum, sequence))
+		require.NoError(t, err)
+		blob, err := share.NewBlob(share.RandomBlobNamespace(), []byte{1}, appconsts.DefaultShareVersion, nil)
+		require.NoError(t, err)
+		tx, _, err := signer.CreatePayForBlobs(accounts[account], []*share.Blob{blob}, user.SetGasLimitAndGasPrice(1_000_000, gasPrice))
+		require.NoError(t, err)
+		return tx
+	}
+
+	// the second tx of the first account pays the most but must still be
+	// ordered after the first tx of the same account.
+	lowFirst := createBlobTx(0, infos[0].Sequence, 0.002)
+	highSecond := createBlobTx(0, infos[0].Sequence+1, 0.2)
+	medium := createBlobTx(1, infos[1].Sequence, 0.01)
+	high := createBlobTx(2, infos[2].Sequence, 0.1)
+	txs := [][]byte{lowFirst, highSecond, medium, high}
+
+	newBuilder := func(opts ...app.FilteredSquareBuilderOption) (*app.FilteredSquareBuilder, sdk.Context) {
+		ctx := testApp.NewProposalContext(cmtproto.Header{
+			ChainID: testutil.ChainID,
+			Height:  testApp.LastBlockHeight() + 1,
+			Time:    time.Now(),
+		})
+		handler := testApp.AnteHandler()
+		fsb, err := app.NewFilteredSquareBuilder(handler, enc.TxConfig, appconsts.DefaultGovMaxSquareSize, appconsts.SubtreeRootThreshold, opts...)
+		require.NoError(t, err)
+		return fsb, ctx
+	}
+
+	t.Run("fifo ordering keeps the mempool order", func(t *testing.T) {
+		fsb, ctx := newBuilder()
+		require.Equal(t, txs, fsb.Fill(ctx, txs))
+	})
+
+	t.Run("fee priority ordering sorts by gas price and respects sequences", func(t *testing.T) {
+		fsb, ctx := newBuilder(app.WithTxOrdering(app.FeePriorityOrdering))
+		require.Equal(t, [][]byte{high, medium, lowFirst, highSecond}, fsb.Fill(ctx, txs))
+	})
+
+	t.Run("fee priority ordering is deterministic", func(t *testing.T) {
+		fsb, ctx := newBuilder(app.WithTxOrdering(app.FeePriorityOrdering))
+		first := fsb.Fill(ctx, txs)
+		fsb, ctx = newBuilder(app.WithTxOrdering(app.FeePriorityOrdering))
+		require.Equal(t, first, fsb.Fill(ctx, txs))
+	})
+}
+
+func TestPrepareProposalExclusionReport(t *testing.T) {
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := testfactory.GenerateAccounts(3)
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+	infos := queryAccountInfo(testApp, accounts, kr)
+
+	validBlobTx := blobfactory.ManyMultiBlobTx(
+		t,
+		enc.TxConfig,
+		kr,
+		testutil.ChainID,
+		accounts[:1],
+		infos[:1],
+		blobfactory.NestedBlobs(t, testfactory.RandomBlobNamespaces(random.New(), 1), [][]int{{100}}),
+	)[0]
+
+	// signed with the same sequence as validBlobTx so it fails the ante handler
+	duplicateSeqBlobTx := blobfactory.ManyMultiBlobTx(
+		t,
+		enc.TxConfig,
+		kr,
+		testutil.ChainID,
+		accounts[:1],
+		infos[:1],
+		blobfactory.NestedBlobs(t, testfactory.RandomBlobNamespaces(random.New(), 1), [][]int{{200}}),
+	)[0]
+
+	// a blob tx that can't be included in a 64 x 64 square
+	tooManyShareBtx := blobfactory.ManyMultiBlobTx(
+		t,
+		enc.TxConfig,
+		kr,
+		testutil.ChainID,
+		accounts[1:2],
+		infos[1:2],
+		blobfactory.NestedBlobs(
+			t,
+			testfactory.RandomBlobNamespaces(random.New(), 4000),
+			[][]int{repeat(4000, 1)},
+		),
+	)[0]
+
+	height := testApp.LastBlockHeight() + 1
+	resp, err := testApp.PrepareProposal(&abci.RequestPrepareProposal{
+		Txs:    [][]byte{validBlobTx, duplicateSeqBlobTx, tooManyShareBtx},
+		Height: height,
+		Time:   time.Now(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{validBlobTx}, resp.Txs)
+
+	report, ok := testApp.ExclusionReports().Get(height)
+	require.True(t, ok)
+	assert.EqualValues(t, 1, report.IncludedTxs)
+	require.Len(t, report.ExcludedTxs, 2)
+
+	reasons := map[string]*proposal.ExcludedTx{}
+	for _, excluded := range report.ExcludedTxs {
+		assert.True(t, excluded.IsBlobTx)
+		reasons[excluded.TxHash] = excluded
+	}
+
+	hashOf := func(rawBlobTx []byte) string {
+		bTx, isBlob, err := blobtx.UnmarshalBlobTx(rawBlobTx)
+		require.True(t, isBlob)
+		require.NoError(t, err)
+		return tmbytes.HexBytes(coretypes.Tx(bTx.Tx).Hash()).String()
+	}
+
+	anteErr := reasons[hashOf(duplicateSeqBlobTx)]
+	require.NotNil(t, anteErr)
+	assert.Equal(t, proposal.ExclusionReason_EXCLUSION_REASON_ANTE_ERROR, anteErr.Reason)
+	assert.Equal(t, sdkerrors.ErrWrongSequence.Codespace(), anteErr.Codespace)
+	assert.Equal(t, sdkerrors.ErrWrongSequence.ABCICode(), anteErr.Code)
+
+	squareFull := reasons[hashOf(tooManyShareBtx)]
+	require.NotNil(t, squareFull)
+	assert.Equal(t, proposal.ExclusionReason_EXCLUSION_REASON_SQUARE_FULL, squareFull.Reason)
+	assert.Zero(t, squareFull.Code)
+}
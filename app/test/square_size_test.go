@@ -148,7 +148,7 @@ func (s *SquareSizeIntegrationTest) SetupBlockSizeParams(t *testing.T, squareSiz
 		Validator: updatedParams.Validator,
 	}
 
-	newParams := blobtypes.DefaultParams()
+	newParams := blobtypes.DefaultParamsV6()
 	newParams.GovMaxSquareSize = uint64(squareSize)
 	maxSquareSizeParamChange := blobtypes.NewMsgUpdateBlobParams(govAuthority, newParams)
 
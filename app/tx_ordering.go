@@ -0,0 +1,160 @@
+package app
+
+import (
+	"container/heap"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/celestiaorg/celestia-app/v5/app/ante"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// FlagTxOrdering is the app option used to select the TxOrdering used by the
+// FilteredSquareBuilder when this node prepares a proposal.
+const FlagTxOrdering = "tx-ordering"
+
+// TxOrdering determines the order in which the FilteredSquareBuilder considers
+// candidate transactions when filling the square.
+type TxOrdering int
+
+const (
+	// FIFOOrdering considers transactions in the order they were provided by
+	// the mempool.
+	FIFOOrdering TxOrdering = iota
+	// FeePriorityOrdering considers transactions with a higher effective gas
+	// price first while preserving the sequence order of each signer.
+	FeePriorityOrdering
+)
+
+// String implements fmt.Stringer.
+func (o TxOrdering) String() string {
+	switch o {
+	case FIFOOrdering:
+		return "fifo"
+	case FeePriorityOrdering:
+		return "priority"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(o))
+	}
+}
+
+// ParseTxOrdering parses the value of the FlagTxOrdering app option. An empty
+// string defaults to FIFOOrdering.
+func ParseTxOrdering(s string) (TxOrdering, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "fifo":
+		return FIFOOrdering, nil
+	case "priority":
+		return FeePriorityOrdering, nil
+	default:
+		return FIFOOrdering, fmt.Errorf("unknown tx ordering %q: expected one of fifo, priority", s)
+	}
+}
+
+// prioritizedTx holds the information required to order a candidate
+// transaction by fee priority.
+type prioritizedTx struct {
+	// index is the position of the transaction in the original input and is
+	// used to break ties deterministically.
+	index    int
+	priority int64
+	sequence uint64
+}
+
+// orderByFeePriority returns txs sorted by descending fee priority, as
+// computed by ante.TxPriority, such that the transactions of each signer remain
+// in ascending sequence order. Ties are broken by the original position of the
+// transaction so that the result only depends on the input. Transactions that
+// can not be decoded keep a priority of zero and are treated as their own
+// signer.
+//
+// NOTE: transactions are grouped by their first signer only. Transactions with
+// multiple signers may still be placed ahead of a lower sequence of one of
+// their other signers in which case the ante handler will filter them out.
+func orderByFeePriority[T any](dec sdk.TxDecoder, txs []T, rawTx func(T) []byte) []T {
+	queues := make(map[string][]prioritizedTx)
+	for i, tx := range txs {
+		ptx := prioritizedTx{index: i}
+		signer := fmt.Sprintf("undecodable/%d", i)
+
+		sdkTx, err := dec(rawTx(tx))
+		if err == nil {
+			if feeTx, ok := sdkTx.(sdk.FeeTx); ok {
+				ptx.priority = ante.TxPriority(feeTx)
+			}
+			if s, seq, ok := firstSigner(sdkTx); ok {
+				signer = s
+				ptx.sequence = seq
+			}
+		}
+		queues[signer] = append(queues[signer], ptx)
+	}
+
+	h := make(priorityHeap, 0, len(queues))
+	for _, queue := range queues {
+		sort.SliceStable(queue, func(i, j int) bool {
+			return queue[i].sequence < queue[j].sequence
+		})
+		h = append(h, queue)
+	}
+	heap.Init(&h)
+
+	ordered := make([]T, 0, len(txs))
+	for h.Len() > 0 {
+		queue := h[0]
+		ordered = append(ordered, txs[queue[0].index])
+		if len(queue) == 1 {
+			heap.Pop(&h)
+			continue
+		}
+		h[0] = queue[1:]
+		heap.Fix(&h, 0)
+	}
+	return ordered
+}
+
+// firstSigner returns the first signer of the transaction along with the
+// sequence it signed with.
+func firstSigner(sdkTx sdk.Tx) (string, uint64, bool) {
+	sigTx, ok := sdkTx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return "", 0, false
+	}
+	signers, err := sigTx.GetSigners()
+	if err != nil || len(signers) == 0 {
+		return "", 0, false
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil || len(sigs) == 0 {
+		return string(signers[0]), 0, true
+	}
+	return string(signers[0]), sigs[0].Sequence, true
+}
+
+// priorityHeap is a max heap of per signer queues ordered by the priority of
+// the transaction at the head of each queue.
+type priorityHeap [][]prioritizedTx
+
+func (h priorityHeap) Len() int { return len(h) }
+
+func (h priorityHeap) Less(i, j int) bool {
+	a, b := h[i][0], h[j][0]
+	if a.priority != b.priority {
+		return a.priority > b.priority
+	}
+	return a.index < b.index
+}
+
+func (h priorityHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *priorityHeap) Push(x any) { *h = append(*h, x.([]prioritizedTx)) }
+
+func (h *priorityHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
@@ -0,0 +1,40 @@
+package app_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v5/app"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTxOrdering(t *testing.T) {
+	testCases := []struct {
+		input   string
+		want    app.TxOrdering
+		wantErr bool
+	}{
+		{input: "", want: app.FIFOOrdering},
+		{input: "fifo", want: app.FIFOOrdering},
+		{input: "priority", want: app.FeePriorityOrdering},
+		{input: " Priority ", want: app.FeePriorityOrdering},
+		{input: "random", wantErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			got, err := app.ParseTxOrdering(tc.input)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+			require.Equal(t, got, mustParse(t, got.String()))
+		})
+	}
+}
+
+func mustParse(t *testing.T, s string) app.TxOrdering {
+	ordering, err := app.ParseTxOrdering(s)
+	require.NoError(t, err)
+	return ordering
+}
@@ -144,6 +144,8 @@ func (app App) RegisterUpgradeHandlers() {
 		},
 	)
 
+	app.registerUpgradeHandlerV6()
+
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
 		panic(err)
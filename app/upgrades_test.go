@@ -0,0 +1,86 @@
+package app_test
+
+import (
+	"testing"
+
+	coreheader "cosmossdk.io/core/header"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/celestiaorg/celestia-app/v5/app"
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v5/test/util"
+	blobtypes "github.com/celestiaorg/celestia-app/v5/x/blob/types"
+	minfeetypes "github.com/celestiaorg/celestia-app/v5/x/minfee/types"
+	signaltypes "github.com/celestiaorg/celestia-app/v5/x/signal/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestUpgradeToV6 tests that a chain running v5 runs the migrations of the
+// modules and ends at app version 6 once the EndBlocker applies the v6 upgrade
+// at the signalled upgrade height.
+func TestUpgradeToV6(t *testing.T) {
+	cparams := app.DefaultConsensusParams()
+	cparams.Version.App = appconsts.V6 - 1
+	testApp, _ := util.SetupTestAppWithGenesisValSet(cparams)
+
+	// The genesis of v5 sets the module versions of v5.
+	height := testApp.LastBlockHeight()
+	header := tmproto.Header{
+		ChainID: appconsts.TestChainID,
+		Height:  height,
+		Version: tmversion.Consensus{App: appconsts.V6 - 1},
+	}
+	ctx := testApp.NewUncachedContext(false, header).WithHeaderInfo(coreheader.Info{ChainID: appconsts.TestChainID, Height: height})
+	versionMap, err := testApp.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, versionMap[blobtypes.ModuleName])
+	require.EqualValues(t, 2, versionMap[minfeetypes.ModuleName])
+
+	// a transfer to the blob module address before v6 creates a base account.
+	blobAddr := authtypes.NewModuleAddress(blobtypes.ModuleName)
+	testApp.AccountKeeper.SetAccount(ctx, testApp.AccountKeeper.NewAccountWithAddress(ctx, blobAddr))
+
+	validators, err := testApp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	valAddr, err := sdk.ValAddressFromBech32(validators[0].OperatorAddress)
+	require.NoError(t, err)
+	_, err = testApp.SignalKeeper.SignalVersion(ctx, &signaltypes.MsgSignalVersion{
+		ValidatorAddress: valAddr.String(),
+		Version:          appconsts.V6,
+	})
+	require.NoError(t, err)
+	_, err = testApp.SignalKeeper.TryUpgrade(ctx, &signaltypes.MsgTryUpgrade{Signer: valAddr.String()})
+	require.NoError(t, err)
+
+	upgradeHeight := height + appconsts.GetUpgradeHeightDelay(appconsts.TestChainID)
+	header.Height = upgradeHeight
+	ctx = ctx.WithBlockHeader(header).WithHeaderInfo(coreheader.Info{ChainID: appconsts.TestChainID, Height: upgradeHeight})
+	_, err = testApp.EndBlocker(ctx)
+	require.NoError(t, err)
+
+	appVersion, err := testApp.AppVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, appconsts.V6, appVersion)
+
+	doneHeight, err := testApp.UpgradeKeeper.GetDoneHeight(ctx, app.UpgradeNameV6)
+	require.NoError(t, err)
+	require.Equal(t, upgradeHeight, doneHeight)
+	// the upgrade is applied at the upgrade height, so no plan is left for
+	// the upgrade module to apply in the next block.
+	_, err = testApp.UpgradeKeeper.GetUpgradePlan(ctx)
+	require.ErrorIs(t, err, upgradetypes.ErrNoUpgradePlanFound)
+
+	versionMap, err = testApp.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, versionMap[blobtypes.ModuleName])
+	require.EqualValues(t, 3, versionMap[minfeetypes.ModuleName])
+	require.Equal(t, minfeetypes.DefaultMaxChangeRate, testApp.MinFeeKeeper.GetParams(ctx).MaxChangeRate)
+	require.Equal(t, blobtypes.DefaultNamespaceClaimBond, testApp.BlobKeeper.GetParams(ctx).NamespaceClaimBond)
+	_, ok := testApp.AccountKeeper.GetAccount(ctx, blobAddr).(sdk.ModuleAccountI)
+	require.True(t, ok)
+	_, ok = testApp.AccountKeeper.GetAccount(ctx, authtypes.NewModuleAddress(minfeetypes.ModuleName)).(sdk.ModuleAccountI)
+	require.True(t, ok)
+}
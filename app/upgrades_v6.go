@@ -0,0 +1,81 @@
+package app
+
+import (
+	"context"
+	"maps"
+	"time"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v5/x/blob/types"
+	minfeetypes "github.com/celestiaorg/celestia-app/v5/x/minfee/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// UpgradeNameV6 defines the on-chain upgrade name from v5 to v6.
+const UpgradeNameV6 = "v6"
+
+// moduleVersionsV5 are the consensus versions before app version 6 of the
+// modules that the v6 upgrade migrates.
+var moduleVersionsV5 = module.VersionMap{
+	blobtypes.ModuleName:   3,
+	minfeetypes.ModuleName: 2,
+}
+
+// moduleVersionMap returns the consensus versions of the modules at the app
+// version. A chain that starts from the genesis of an older app version, e.g.
+// an exported one, must still run the migrations of the later upgrades.
+func (app *App) moduleVersionMap(appVersion uint64) module.VersionMap {
+	versionMap := app.ModuleManager.GetVersionMap()
+	if appVersion < appconsts.V6 {
+		maps.Copy(versionMap, moduleVersionsV5)
+	}
+	return versionMap
+}
+
+// registerUpgradeHandlerV6 registers the upgrade handler from v5 to v6. The
+// EndBlocker applies it at the signalled upgrade height and the upgrade keeper
+// increments the app version once it has run.
+func (app App) registerUpgradeHandlerV6() {
+	app.UpgradeKeeper.SetUpgradeHandler(
+		UpgradeNameV6,
+		func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+			start := time.Now()
+			sdkCtx.Logger().Info("running upgrade handler", "upgrade-name", UpgradeNameV6, "start", start)
+
+			vm, err := app.ModuleManager.RunMigrations(ctx, app.configurator, fromVM)
+			if err != nil {
+				return nil, err
+			}
+
+			app.createModuleAccount(sdkCtx, blobtypes.ModuleName)
+			app.createModuleAccount(sdkCtx, minfeetypes.ModuleName)
+
+			sdkCtx.Logger().Info("finished to upgrade", "upgrade-name", UpgradeNameV6, "duration-sec", time.Since(start).Seconds())
+
+			return vm, nil
+		},
+	)
+}
+
+// createModuleAccount creates the account of a module that only uses its
+// module account from app version 6 onwards. An account that was created at
+// the module address before, by a transfer to it, is replaced by the module
+// account with the same account number.
+func (app App) createModuleAccount(ctx sdk.Context, name string) {
+	addr := authtypes.NewModuleAddress(name)
+	acc := app.AccountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		app.AccountKeeper.GetModuleAccount(ctx, name)
+		return
+	}
+	if _, ok := acc.(sdk.ModuleAccountI); ok {
+		return
+	}
+	baseAcc := authtypes.NewBaseAccount(addr, nil, acc.GetAccountNumber(), acc.GetSequence())
+	app.AccountKeeper.SetModuleAccount(ctx, authtypes.NewModuleAccount(baseAcc, name, maccPerms[name]...))
+}
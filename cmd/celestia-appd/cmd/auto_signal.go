@@ -0,0 +1,333 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/celestiaorg/celestia-app/v5/app"
+	"github.com/celestiaorg/celestia-app/v5/app/encoding"
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v5/pkg/user"
+	signaltypes "github.com/celestiaorg/celestia-app/v5/x/signal/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// FlagAutoSignal enables signalling for the app version after the one the
+	// chain runs, as long as the binary supports it.
+	FlagAutoSignal = "auto-signal"
+	// FlagAutoSignalKey is the name of the key of the validator operator that
+	// signs the MsgSignalVersion.
+	FlagAutoSignalKey = "auto-signal.key"
+	// FlagAutoSignalDryRun logs the version that would be signalled for
+	// without submitting a MsgSignalVersion.
+	FlagAutoSignalDryRun = "auto-signal.dry-run"
+	// FlagAutoSignalInterval is the interval between two checks, which is
+	// also the minimum interval between two MsgSignalVersion.
+	FlagAutoSignalInterval = "auto-signal.interval"
+
+	defaultAutoSignalInterval = 10 * time.Minute
+	minAutoSignalInterval     = time.Minute
+	// autoSignalRetryInterval is the interval between the first checks until
+	// one succeeds. The signaller starts before the gRPC server of the node.
+	autoSignalRetryInterval = 5 * time.Second
+)
+
+// addAutoSignalFlags adds the flags of the automatic version signalling to the
+// start command.
+func addAutoSignalFlags(startCmd *cobra.Command) {
+	startCmd.Flags().Bool(FlagAutoSignal, false, "Signal for the app version after the one the chain runs once this binary supports it. Requires --auto-signal.key")
+	startCmd.Flags().String(FlagAutoSignalKey, "", "Name of the validator operator key in the keyring of client.toml that signs the MsgSignalVersion. The keyring backend must not prompt for a passphrase")
+	startCmd.Flags().Bool(FlagAutoSignalDryRun, false, "Only log the version that would be signalled for")
+	startCmd.Flags().Duration(FlagAutoSignalInterval, defaultAutoSignalInterval, "Interval between two checks of the signalled version. At most one MsgSignalVersion is submitted per interval")
+}
+
+// startAutoSignal is a pre-start hook that starts signalling for the next
+// supported app version in the background if --auto-signal is set.
+func startAutoSignal(cmd *cobra.Command, logger log.Logger) error {
+	enabled, err := cmd.Flags().GetBool(FlagAutoSignal)
+	if err != nil || !enabled {
+		return err
+	}
+	keyName, err := cmd.Flags().GetString(FlagAutoSignalKey)
+	if err != nil {
+		return err
+	}
+	dryRun, err := cmd.Flags().GetBool(FlagAutoSignalDryRun)
+	if err != nil {
+		return err
+	}
+	interval, err := cmd.Flags().GetDuration(FlagAutoSignalInterval)
+	if err != nil {
+		return err
+	}
+	if interval < minAutoSignalInterval {
+		return fmt.Errorf("--%s must be at least %s", FlagAutoSignalInterval, minAutoSignalInterval)
+	}
+	if keyName == "" {
+		return fmt.Errorf("--%s requires --%s", FlagAutoSignal, FlagAutoSignalKey)
+	}
+
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	if clientCtx.Keyring == nil {
+		return fmt.Errorf("--%s requires a keyring, set keyring-backend in client.toml", FlagAutoSignal)
+	}
+	record, err := clientCtx.Keyring.Key(keyName)
+	if err != nil {
+		return fmt.Errorf("retrieving the key of --%s: %w", FlagAutoSignalKey, err)
+	}
+	addr, err := record.GetAddress()
+	if err != nil {
+		return err
+	}
+
+	grpcAddress := server.GetServerContextFromCmd(cmd).Viper.GetString("grpc.address")
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	conn, err := grpc.NewClient(
+		grpcAddress,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(codec.NewProtoCodec(encCfg.InterfaceRegistry).GRPCCodec())),
+	)
+	if err != nil {
+		return fmt.Errorf("connecting to the gRPC server at %s: %w", grpcAddress, err)
+	}
+
+	signaller := &autoSignaller{
+		logger:           logger.With("module", "auto-signal"),
+		conn:             conn,
+		encCfg:           encCfg,
+		keys:             clientCtx.Keyring,
+		keyName:          keyName,
+		valAddress:       sdk.ValAddress(addr),
+		supportedVersion: supportedAppVersion(),
+		dryRun:           dryRun,
+		interval:         interval,
+		retryInterval:    autoSignalRetryInterval,
+	}
+	signaller.logger.Info("automatic version signalling enabled", "validator", signaller.valAddress.String(), "supported_version", signaller.supportedVersion, "dry_run", dryRun, "interval", interval)
+	go signaller.run(cmd.Context())
+	return nil
+}
+
+// autoSignaller signals for the next app version supported by the binary with
+// the key of the validator operator.
+type autoSignaller struct {
+	logger           log.Logger
+	conn             *grpc.ClientConn
+	encCfg           encoding.Config
+	keys             keyring.Keyring
+	keyName          string
+	valAddress       sdk.ValAddress
+	supportedVersion uint64
+	dryRun           bool
+	interval         time.Duration
+	retryInterval    time.Duration
+
+	txClient   *user.TxClient
+	lastSignal time.Time
+	// signalledVersion is the last version that this process signalled for.
+	// It is only used before app version 6, which can't be queried for the
+	// signal of the validator.
+	signalledVersion uint64
+}
+
+// run checks the signalled version every interval until the context is done.
+// The first check is retried every retry interval until it succeeds, as the
+// gRPC server of the node only starts after the signaller.
+func (s *autoSignaller) run(ctx context.Context) {
+	defer s.conn.Close()
+	started := false
+	for {
+		wait := s.interval
+		err := s.check(ctx, time.Now())
+		switch {
+		case err == nil:
+			started = true
+		case !started:
+			s.logger.Debug("waiting for the gRPC server of the node", "err", err)
+			wait = s.retryInterval
+		default:
+			s.logger.Error("failed to check the signalled version", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// check signals for the next version if the validator should.
+func (s *autoSignaller) check(ctx context.Context, now time.Time) error {
+	state, err := s.state(ctx, now)
+	if err != nil {
+		return err
+	}
+	version, reason := state.nextSignal()
+	if version == 0 {
+		s.logger.Debug("not signalling", "reason", reason)
+		return nil
+	}
+	if s.dryRun {
+		s.logger.Info("dry run: would signal", "version", version, "chain_version", state.chainVersion, "validator", s.valAddress.String())
+		return nil
+	}
+
+	if s.txClient == nil {
+		s.txClient, err = user.SetupTxClient(ctx, s.keys, s.conn, s.encCfg, user.WithDefaultAccount(s.keyName))
+		if err != nil {
+			return fmt.Errorf("setting up the tx client: %w", err)
+		}
+	}
+	s.lastSignal = now
+	msg := signaltypes.NewMsgSignalVersion(s.valAddress.String(), version)
+	resp, err := s.txClient.SubmitTx(ctx, []sdk.Msg{msg})
+	if err != nil {
+		return fmt.Errorf("signalling for version %d: %w", version, err)
+	}
+	s.signalledVersion = version
+	s.logger.Info("signalled", "version", version, "tx_hash", resp.TxHash, "height", resp.Height)
+	return nil
+}
+
+// state queries the state that the decision to signal is based on. The node
+// may run an older app version than the binary supports, so only the queries
+// that every app version serves are used. From app version 6, the version
+// that the validator signals for is queried with ValidatorSignals. Before, it
+// is the last version signalled for by this process as long as the tally of
+// that version is not zero, as the signals of all validators are cleared
+// after an upgrade or a cancellation.
+func (s *autoSignaller) state(ctx context.Context, now time.Time) (autoSignalState, error) {
+	cmtClient := cmtservice.NewServiceClient(s.conn)
+	syncing, err := cmtClient.GetSyncing(ctx, &cmtservice.GetSyncingRequest{})
+	if err != nil {
+		return autoSignalState{}, err
+	}
+	// the state of a syncing node is outdated.
+	if syncing.Syncing {
+		return autoSignalState{syncing: true}, nil
+	}
+	block, err := cmtClient.GetLatestBlock(ctx, &cmtservice.GetLatestBlockRequest{})
+	if err != nil {
+		return autoSignalState{}, err
+	}
+	signalClient := signaltypes.NewQueryClient(s.conn)
+	upgrade, err := signalClient.GetUpgrade(ctx, &signaltypes.QueryGetUpgradeRequest{})
+	if err != nil {
+		return autoSignalState{}, err
+	}
+	bonded, err := s.isBonded(ctx)
+	if err != nil {
+		return autoSignalState{}, err
+	}
+
+	state := autoSignalState{
+		chainVersion:     chainAppVersion(block),
+		supportedVersion: s.supportedVersion,
+		bonded:           bonded,
+		upgradePending:   upgrade.Upgrade != nil,
+		lastSignal:       s.lastSignal,
+		now:              now,
+		interval:         s.interval,
+	}
+	if state.chainVersion >= appconsts.V6 {
+		state.signalledVersion, err = s.queryValidatorSignal(ctx, signalClient)
+		if err != nil {
+			return autoSignalState{}, err
+		}
+	} else if s.signalledVersion != 0 {
+		tally, err := signalClient.VersionTally(ctx, &signaltypes.QueryVersionTallyRequest{Version: s.signalledVersion})
+		if err != nil {
+			return autoSignalState{}, err
+		}
+		if tally.VotingPower > 0 {
+			state.signalledVersion = s.signalledVersion
+		}
+	}
+	return state, nil
+}
+
+// queryValidatorSignal returns the version that the validator signals for, or
+// zero if it doesn't signal or isn't bonded.
+func (s *autoSignaller) queryValidatorSignal(ctx context.Context, signalClient signaltypes.QueryClient) (uint64, error) {
+	resp, err := signalClient.ValidatorSignals(ctx, &signaltypes.QueryValidatorSignalsRequest{})
+	if err != nil {
+		return 0, err
+	}
+	for _, signal := range resp.Signals {
+		if signal.ValidatorAddress == s.valAddress.String() {
+			return signal.Version, nil
+		}
+	}
+	return 0, nil
+}
+
+// isBonded returns true if the validator is in the bonded set.
+func (s *autoSignaller) isBonded(ctx context.Context) (bool, error) {
+	resp, err := stakingtypes.NewQueryClient(s.conn).Validator(ctx, &stakingtypes.QueryValidatorRequest{ValidatorAddr: s.valAddress.String()})
+	if status.Code(err) == codes.NotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return resp.Validator.IsBonded(), nil
+}
+
+// chainAppVersion returns the app version of the latest block. Nodes that run
+// app versions before v4 only return the deprecated cometbft block.
+func chainAppVersion(block *cmtservice.GetLatestBlockResponse) uint64 {
+	if block.SdkBlock != nil {
+		return block.SdkBlock.Header.Version.App
+	}
+	return block.Block.Header.Version.App //nolint:staticcheck // older app versions don't set the sdk block
+}
+
+// autoSignalState is what the decision to signal is based on.
+type autoSignalState struct {
+	syncing          bool
+	chainVersion     uint64
+	supportedVersion uint64
+	signalledVersion uint64
+	bonded           bool
+	upgradePending   bool
+	lastSignal       time.Time
+	now              time.Time
+	interval         time.Duration
+}
+
+// nextSignal returns the version to signal for, or zero and the reason not to
+// signal. The EndBlocker upgrades the chain to the version signalled for, so
+// the validator signals for the version after the one the chain runs even if
+// the binary supports higher versions, so that no upgrade is skipped.
+func (s autoSignalState) nextSignal() (uint64, string) {
+	next := min(s.supportedVersion, s.chainVersion+1)
+	switch {
+	case s.syncing:
+		return 0, "the node is syncing"
+	case s.supportedVersion <= s.chainVersion:
+		return 0, fmt.Sprintf("the chain runs app version %d and the binary supports up to %d", s.chainVersion, s.supportedVersion)
+	case s.upgradePending:
+		return 0, "an upgrade is pending"
+	case !s.bonded:
+		return 0, "the validator is not bonded"
+	case s.signalledVersion >= next:
+		return 0, fmt.Sprintf("the validator already signals for version %d", s.signalledVersion)
+	case !s.lastSignal.IsZero() && s.now.Sub(s.lastSignal) < s.interval:
+		return 0, fmt.Sprintf("the validator signalled less than %s ago", s.interval)
+	}
+	return next, ""
+}
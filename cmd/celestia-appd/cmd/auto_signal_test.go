@@ -0,0 +1,340 @@
+package cmd
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/celestiaorg/celestia-app/v5/app"
+	"github.com/celestiaorg/celestia-app/v5/app/encoding"
+	signaltypes "github.com/celestiaorg/celestia-app/v5/x/signal/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+func TestAutoSignalNextSignal(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	base := autoSignalState{
+		chainVersion:     4,
+		supportedVersion: 5,
+		bonded:           true,
+		now:              now,
+		interval:         10 * time.Minute,
+	}
+
+	testCases := []struct {
+		name    string
+		modify  func(*autoSignalState)
+		want    uint64
+		wantWhy string
+	}{
+		{
+			name:   "signals for the supported version",
+			modify: func(*autoSignalState) {},
+			want:   5,
+		},
+		{
+			name:   "replaces a signal for a lower version",
+			modify: func(s *autoSignalState) { s.signalledVersion = 4 },
+			want:   5,
+		},
+		{
+			name:    "the node is syncing",
+			modify:  func(s *autoSignalState) { s.syncing = true },
+			wantWhy: "the node is syncing",
+		},
+		{
+			name:    "the chain already runs the supported version",
+			modify:  func(s *autoSignalState) { s.chainVersion = 5 },
+			wantWhy: "the chain runs app version 5 and the binary supports up to 5",
+		},
+		{
+			name:    "an upgrade is pending",
+			modify:  func(s *autoSignalState) { s.upgradePending = true },
+			wantWhy: "an upgrade is pending",
+		},
+		{
+			name:    "the validator is not bonded",
+			modify:  func(s *autoSignalState) { s.bonded = false },
+			wantWhy: "the validator is not bonded",
+		},
+		{
+			name:    "the validator already signals for the supported version",
+			modify:  func(s *autoSignalState) { s.signalledVersion = 5 },
+			wantWhy: "the validator already signals for version 5",
+		},
+		{
+			name:    "the validator signals for a higher version",
+			modify:  func(s *autoSignalState) { s.signalledVersion = 6 },
+			wantWhy: "the validator already signals for version 6",
+		},
+		{
+			name:    "the last signal was less than an interval ago",
+			modify:  func(s *autoSignalState) { s.lastSignal = now.Add(-time.Minute) },
+			wantWhy: "the validator signalled less than 10m0s ago",
+		},
+		{
+			name:   "the last signal was more than an interval ago",
+			modify: func(s *autoSignalState) { s.lastSignal = now.Add(-time.Hour) },
+			want:   5,
+		},
+		{
+			name:   "signals for the next version if the binary supports several more",
+			modify: func(s *autoSignalState) { s.supportedVersion = 6 },
+			want:   5,
+		},
+		{
+			name: "the validator already signals for the next version",
+			modify: func(s *autoSignalState) {
+				s.supportedVersion = 6
+				s.signalledVersion = 5
+			},
+			wantWhy: "the validator already signals for version 5",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			state := base
+			tc.modify(&state)
+			got, why := state.nextSignal()
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.wantWhy, why)
+		})
+	}
+}
+
+func TestStartAutoSignalFlags(t *testing.T) {
+	testCases := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name: "disabled by default",
+		},
+		{
+			name:    "requires a key",
+			args:    []string{"--auto-signal"},
+			wantErr: "--auto-signal requires --auto-signal.key",
+		},
+		{
+			name:    "rejects a short interval",
+			args:    []string{"--auto-signal", "--auto-signal.key=validator", "--auto-signal.interval=10s"},
+			wantErr: "--auto-signal.interval must be at least 1m0s",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			addAutoSignalFlags(cmd)
+			require.NoError(t, cmd.ParseFlags(tc.args))
+
+			err := startAutoSignal(cmd, log.NewNopLogger())
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
+
+// TestAutoSignalStateOnOlderNode tests that the state is queried from a node
+// that runs an older app version, which neither serves the ValidatorSignals
+// query nor returns the sdk block.
+func TestAutoSignalStateOnOlderNode(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	node := &testNode{appVersion: 3}
+	conn := startTestNode(t, encCfg, node)
+	now := time.Unix(1_700_000_000, 0)
+	signaller := &autoSignaller{
+		logger:           log.NewNopLogger(),
+		conn:             conn,
+		encCfg:           encCfg,
+		valAddress:       sdk.ValAddress("validator"),
+		supportedVersion: 4,
+		dryRun:           true,
+		interval:         10 * time.Minute,
+	}
+
+	state, err := signaller.state(context.Background(), now)
+	require.NoError(t, err)
+	assert.Equal(t, autoSignalState{
+		chainVersion:     3,
+		supportedVersion: 4,
+		bonded:           true,
+		now:              now,
+		interval:         10 * time.Minute,
+	}, state)
+	require.NoError(t, signaller.check(context.Background(), now))
+
+	// the signal of this process counts as long as the version has a tally
+	signaller.signalledVersion = 4
+	node.tally = 10
+	state, err = signaller.state(context.Background(), now)
+	require.NoError(t, err)
+	assert.EqualValues(t, 4, state.signalledVersion)
+
+	node.tally = 0
+	state, err = signaller.state(context.Background(), now)
+	require.NoError(t, err)
+	assert.Zero(t, state.signalledVersion)
+
+	node.syncing = true
+	state, err = signaller.state(context.Background(), now)
+	require.NoError(t, err)
+	assert.Equal(t, autoSignalState{syncing: true}, state)
+}
+
+// TestAutoSignalStateQueriesSignal tests that the signal of the validator is
+// queried from app version 6 rather than remembered by the process.
+func TestAutoSignalStateQueriesSignal(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	valAddress := sdk.ValAddress("validator")
+	node := &testNode{appVersion: 6, signals: map[string]uint64{valAddress.String(): 7}}
+	conn := startTestNode(t, encCfg, node)
+	signaller := &autoSignaller{
+		logger:           log.NewNopLogger(),
+		conn:             conn,
+		encCfg:           encCfg,
+		valAddress:       valAddress,
+		supportedVersion: 7,
+		dryRun:           true,
+		interval:         10 * time.Minute,
+	}
+
+	// the signaller doesn't remember the signal, e.g. after a restart
+	state, err := signaller.state(context.Background(), time.Now())
+	require.NoError(t, err)
+	assert.EqualValues(t, 7, state.signalledVersion)
+
+	delete(node.signals, valAddress.String())
+	state, err = signaller.state(context.Background(), time.Now())
+	require.NoError(t, err)
+	assert.Zero(t, state.signalledVersion)
+}
+
+// TestAutoSignalStateSkipsNoVersion tests that a binary that supports more
+// than one version above the chain signals for the next version only.
+func TestAutoSignalStateSkipsNoVersion(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	valAddress := sdk.ValAddress("validator")
+	node := &testNode{appVersion: 6, signals: map[string]uint64{}}
+	conn := startTestNode(t, encCfg, node)
+	signaller := &autoSignaller{
+		logger:           log.NewNopLogger(),
+		conn:             conn,
+		encCfg:           encCfg,
+		valAddress:       valAddress,
+		supportedVersion: 8,
+		dryRun:           true,
+		interval:         10 * time.Minute,
+	}
+
+	state, err := signaller.state(context.Background(), time.Now())
+	require.NoError(t, err)
+	version, why := state.nextSignal()
+	assert.EqualValues(t, 7, version, why)
+
+	node.signals[valAddress.String()] = 7
+	state, err = signaller.state(context.Background(), time.Now())
+	require.NoError(t, err)
+	version, why = state.nextSignal()
+	assert.Zero(t, version)
+	assert.Equal(t, "the validator already signals for version 7", why)
+}
+
+// testNode serves the queries of a node that the auto signaller uses. Like a
+// node that runs an app version before v4, it doesn't return the sdk block,
+// and it only serves the ValidatorSignals query from app version 6.
+type testNode struct {
+	cmtservice.UnimplementedServiceServer
+
+	appVersion uint64
+	syncing    bool
+	tally      uint64
+	signals    map[string]uint64
+}
+
+// testSignalQuery serves the signal queries of a testNode.
+type testSignalQuery struct {
+	signaltypes.UnimplementedQueryServer
+	node *testNode
+}
+
+// testStakingQuery serves the staking queries of a testNode.
+type testStakingQuery struct {
+	stakingtypes.UnimplementedQueryServer
+}
+
+func (n *testNode) GetSyncing(context.Context, *cmtservice.GetSyncingRequest) (*cmtservice.GetSyncingResponse, error) {
+	return &cmtservice.GetSyncingResponse{Syncing: n.syncing}, nil
+}
+
+func (n *testNode) GetLatestBlock(context.Context, *cmtservice.GetLatestBlockRequest) (*cmtservice.GetLatestBlockResponse, error) {
+	return &cmtservice.GetLatestBlockResponse{
+		Block: &cmtproto.Block{Header: cmtproto.Header{Version: cmtversion.Consensus{App: n.appVersion}}},
+	}, nil
+}
+
+func (q testSignalQuery) GetUpgrade(context.Context, *signaltypes.QueryGetUpgradeRequest) (*signaltypes.QueryGetUpgradeResponse, error) {
+	return &signaltypes.QueryGetUpgradeResponse{}, nil
+}
+
+func (q testSignalQuery) VersionTally(context.Context, *signaltypes.QueryVersionTallyRequest) (*signaltypes.QueryVersionTallyResponse, error) {
+	return &signaltypes.QueryVersionTallyResponse{VotingPower: q.node.tally}, nil
+}
+
+func (q testSignalQuery) ValidatorSignals(context.Context, *signaltypes.QueryValidatorSignalsRequest) (*signaltypes.QueryValidatorSignalsResponse, error) {
+	if q.node.appVersion < 6 {
+		return nil, status.Error(codes.Unimplemented, "unknown method ValidatorSignals")
+	}
+	resp := &signaltypes.QueryValidatorSignalsResponse{}
+	for address, version := range q.node.signals {
+		resp.Signals = append(resp.Signals, signaltypes.ValidatorSignal{ValidatorAddress: address, Version: version})
+	}
+	return resp, nil
+}
+
+func (testStakingQuery) Validator(_ context.Context, req *stakingtypes.QueryValidatorRequest) (*stakingtypes.QueryValidatorResponse, error) {
+	return &stakingtypes.QueryValidatorResponse{
+		Validator: stakingtypes.Validator{OperatorAddress: req.ValidatorAddr, Status: stakingtypes.Bonded},
+	}, nil
+}
+
+func startTestNode(t *testing.T, encCfg encoding.Config, node *testNode) *grpc.ClientConn {
+	t.Helper()
+	grpcCodec := codec.NewProtoCodec(encCfg.InterfaceRegistry).GRPCCodec()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer(grpc.ForceServerCodec(grpcCodec))
+	cmtservice.RegisterServiceServer(server, node)
+	signaltypes.RegisterQueryServer(server, &testSignalQuery{node: node})
+	stakingtypes.RegisterQueryServer(server, &testStakingQuery{})
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(
+		lis.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(grpcCodec)),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
@@ -4,6 +4,7 @@ package cmd
 
 import (
 	"github.com/celestiaorg/celestia-app/v5/app"
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/spf13/cobra"
 )
@@ -12,3 +13,9 @@ import (
 func modifyRootCommand(rootCommand *cobra.Command) {
 	server.AddCommands(rootCommand, app.NodeHome, NewAppServer, appExporter, addStartFlags)
 }
+
+// supportedAppVersion returns the highest app version that the binary
+// supports, which is the version of the native app.
+func supportedAppVersion() uint64 {
+	return appconsts.Version
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/celestiaorg/celestia-app/v5/multiplexer/abci"
 	"github.com/celestiaorg/celestia-app/v5/multiplexer/appd"
 	multiplexer "github.com/celestiaorg/celestia-app/v5/multiplexer/cmd"
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/spf13/cobra"
 )
@@ -86,3 +87,10 @@ func modifyRootCommand(rootCommand *cobra.Command) {
 		},
 	)
 }
+
+// supportedAppVersion returns the highest app version that the binary
+// supports, which is the version of the native app. The embedded binaries
+// only run the app versions before it.
+func supportedAppVersion() uint64 {
+	return appconsts.Version
+}
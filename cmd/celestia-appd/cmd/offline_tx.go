@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v5/pkg/user"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+// offlineTxCommand returns the commands that sign and broadcast the offline tx
+// bundles exported by the pkg/user TxClient.
+func offlineTxCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:                        "offline",
+		Short:                      "Sign and broadcast offline tx bundles",
+		Long:                       "Sign offline tx bundles exported with the pkg/user TxClient on an air-gapped machine and broadcast them later.",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	command.AddCommand(
+		offlineSignCommand(),
+		offlineBroadcastCommand(),
+	)
+	return command
+}
+
+// offlineSignCommand returns a command that signs an offline tx bundle without
+// network access.
+func offlineSignCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "sign [bundle-file]",
+		Short: "Sign an offline tx bundle",
+		Long: `Sign the transaction of an offline tx bundle with a key of the keyring. The
+account number, sequence and chain ID are read from the bundle so no network
+access is needed. The key is the signer of the bundle unless --from is set.`,
+		Example: "celestia-appd tx offline sign bundle.json --output-document signed.json --keyring-backend file",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			bundle, err := user.ReadOfflineTxBundle(args[0])
+			if err != nil {
+				return err
+			}
+			keyName, err := cmd.Flags().GetString(flags.FlagFrom)
+			if err != nil {
+				return err
+			}
+			if err := user.SignOfflineTx(clientCtx.Keyring, clientCtx.TxConfig, bundle, keyName); err != nil {
+				return err
+			}
+
+			output, err := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if err != nil {
+				return err
+			}
+			if output == "" {
+				output = args[0]
+			}
+			if err := user.WriteOfflineTxBundle(output, bundle); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "signed offline tx bundle written to %s\n", output)
+			return err
+		},
+	}
+	command.Flags().String(flags.FlagFrom, "", "Name of the key to sign with, defaults to the signer of the bundle")
+	command.Flags().String(flags.FlagOutputDocument, "", "The document to write the signed bundle to, defaults to the bundle file")
+	command.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory)")
+	command.Flags().String(flags.FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
+	return command
+}
+
+// offlineBroadcastCommand returns a command that validates and broadcasts a
+// signed offline tx bundle.
+func offlineBroadcastCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "broadcast [bundle-file]",
+		Short: "Broadcast a signed offline tx bundle",
+		Long: `Validate the signed transaction of an offline tx bundle and broadcast it to the
+node. The BlobTx of PayForBlobs transactions is validated before it is
+broadcast.`,
+		Example: "celestia-appd tx offline broadcast signed.json --node tcp://localhost:26657",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			bundle, err := user.ReadOfflineTxBundle(args[0])
+			if err != nil {
+				return err
+			}
+			if clientCtx.ChainID != "" && clientCtx.ChainID != bundle.ChainID {
+				return fmt.Errorf("the offline tx bundle is for chain %s, not %s", bundle.ChainID, clientCtx.ChainID)
+			}
+			if err := user.ValidateOfflineTx(clientCtx.TxConfig, bundle); err != nil {
+				return err
+			}
+			resp, err := clientCtx.BroadcastTx(bundle.SignedTx)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddTxFlagsToCmd(command)
+	return command
+}
@@ -120,6 +120,7 @@ func initRootCommand(rootCommand *cobra.Command, capp *app.App) {
 		addrbookCommand(),
 		downloadGenesisCommand(),
 		addrConversionCmd(),
+		upgradeRehearsalCommand(),
 		server.StatusCommand(),
 		queryCommand(capp.BasicManager),
 		txCommand(capp.BasicManager),
@@ -130,7 +131,7 @@ func initRootCommand(rootCommand *cobra.Command, capp *app.App) {
 	modifyRootCommand(rootCommand)
 
 	// Add hooks run prior to the start command
-	if err := addPreStartHooks(rootCommand, checkAndUpdateMinGasPrices, checkBBR); err != nil {
+	if err := addPreStartHooks(rootCommand, checkAndUpdateMinGasPrices, checkBBR, startAutoSignal); err != nil {
 		panic(fmt.Errorf("failed to add pre-start hooks: %w", err))
 	}
 }
@@ -144,6 +145,10 @@ func addStartFlags(startCmd *cobra.Command) {
 
 	startCmd.Flags().Duration(TimeoutCommitFlag, 0, "Override the application configured timeout_commit. Note: only for testing purposes.")
 	startCmd.Flags().Bool(FlagForceNoBBR, false, "bypass the requirement to use bbr locally")
+	startCmd.Flags().String(app.FlagTxOrdering, app.FIFOOrdering.String(), "Order in which transactions are considered when proposing a block. One of fifo or priority (by effective gas price, preserving each signer's sequence order).")
+	startCmd.Flags().Bool(app.FlagBlobIndex, false, "Index the blobs of finalized blocks by namespace and share commitment so that their location can be queried over gRPC.")
+	startCmd.Flags().Bool(app.FlagGasPriceHistory, false, "Keep the gas prices of the recently committed blocks so that the gas estimation service can estimate gas prices from them and query their history over gRPC.")
+	addAutoSignalFlags(startCmd)
 }
 
 // replaceLogger optionally replaces the logger with a file logger if the flag
@@ -26,6 +26,7 @@ func txCommand(basicManager module.BasicManager) *cobra.Command {
 		authcmd.GetBroadcastCommand(),
 		authcmd.GetEncodeCommand(),
 		authcmd.GetDecodeCommand(),
+		offlineTxCommand(),
 	)
 
 	basicManager.AddTxCommands(command)
@@ -0,0 +1,484 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/celestiaorg/celestia-app/v5/app"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	flagRehearsalGenesis     = "genesis"
+	flagRehearsalUpgradeName = "upgrade-name"
+)
+
+// upgradeRehearsalCommand returns a command that applies an upgrade handler to
+// a temporary copy of the node's state.
+func upgradeRehearsalCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-rehearsal",
+		Short: "Rehearse an upgrade on a temporary copy of the node's state",
+		Long: `Rehearse an upgrade on a temporary copy of the node's state.
+
+The application database of the node in --home is copied into a temporary directory, or the state of an exported
+genesis with --genesis is loaded into one. The store upgrades and the upgrade handler registered under --upgrade-name,
+which runs the module migrations, are then applied on top of the last committed height, followed by the invariants of
+all modules. The command reports the duration of the upgrade, the module versions and stores that changed, and the
+invariant results.
+
+The migrations start from the module versions stored in the application database. An exported genesis doesn't hold
+them, so they are the module versions of the app version in its consensus params.
+
+The node's data is never written to. The node should be stopped while its application database is copied.`,
+		Example: `celestia-appd upgrade-rehearsal --home ~/.celestia-app
+celestia-appd upgrade-rehearsal --home ~/.celestia-app --upgrade-name v6
+celestia-appd upgrade-rehearsal --genesis exported-genesis.json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			genesisFile, err := cmd.Flags().GetString(flagRehearsalGenesis)
+			if err != nil {
+				return err
+			}
+			upgradeName, err := cmd.Flags().GetString(flagRehearsalUpgradeName)
+			if err != nil {
+				return err
+			}
+
+			home, err := os.MkdirTemp("", "celestia-upgrade-rehearsal")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(home)
+
+			var (
+				application *app.App
+				db          dbm.DB
+			)
+			if genesisFile != "" {
+				application, db, err = newRehearsalAppFromGenesis(serverCtx.Logger, home, genesisFile)
+			} else {
+				application, db, err = newRehearsalAppFromHome(serverCtx.Logger, home, serverCtx.Config.RootDir, server.GetAppDBBackend(serverCtx.Viper), upgradeName)
+			}
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			report, err := rehearseUpgrade(application, upgradeName)
+			if err != nil {
+				return err
+			}
+			report.print(cmd.OutOrStdout())
+
+			if broken := report.brokenInvariants(); broken > 0 {
+				return fmt.Errorf("%d invariants are broken after upgrade %s", broken, upgradeName)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagRehearsalGenesis, "", "Rehearse the upgrade on the state of this exported genesis instead of the application database in --home")
+	cmd.Flags().String(flagRehearsalUpgradeName, app.UpgradeNameV6, "Name of the registered upgrade handler to rehearse")
+	return cmd
+}
+
+// newRehearsalAppFromHome copies the application database of nodeHome into
+// home and loads it with the store upgrades of upgradeName applied.
+func newRehearsalAppFromHome(logger log.Logger, home, nodeHome string, backend dbm.BackendType, upgradeName string) (*app.App, dbm.DB, error) {
+	dataDir := filepath.Join(home, "data")
+	if err := copyDir(filepath.Join(nodeHome, "data", "application.db"), filepath.Join(dataDir, "application.db")); err != nil {
+		return nil, nil, fmt.Errorf("copying the application database: %w", err)
+	}
+	db, err := dbm.NewDB("application", backend, dataDir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	height := rootmulti.GetLatestVersion(db)
+	if height == 0 {
+		db.Close()
+		return nil, nil, fmt.Errorf("no committed state in %s", nodeHome)
+	}
+	// The store loader of an upgrade is only set if the upgrade info on disk
+	// names it for the height after the last committed one.
+	upgradeInfo, err := json.Marshal(upgradetypes.Plan{Name: upgradeName, Height: height + 1})
+	if err != nil {
+		db.Close()
+		return nil, nil, err
+	}
+	if err := os.WriteFile(filepath.Join(dataDir, upgradetypes.UpgradeInfoFilename), upgradeInfo, 0o600); err != nil {
+		db.Close()
+		return nil, nil, err
+	}
+
+	genesis, err := os.Open(filepath.Join(nodeHome, "config", "genesis.json"))
+	if err != nil {
+		db.Close()
+		return nil, nil, err
+	}
+	defer genesis.Close()
+	chainID, err := genutiltypes.ParseChainIDFromGenesis(genesis)
+	if err != nil {
+		db.Close()
+		return nil, nil, err
+	}
+
+	application, err := newRehearsalApp(logger, db, home, chainID)
+	if err != nil {
+		db.Close()
+		return nil, nil, fmt.Errorf("loading the application database with the store upgrades of %s: %w", upgradeName, err)
+	}
+	return application, db, nil
+}
+
+// newRehearsalAppFromGenesis initializes a chain in home from genesisFile and
+// commits its first block. InitChain stores the module versions of the app
+// version of the genesis, which the migrations of the upgrade start from.
+func newRehearsalAppFromGenesis(logger log.Logger, home, genesisFile string) (*app.App, dbm.DB, error) {
+	appGenesis, err := genutiltypes.AppGenesisFromFile(genesisFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	genDoc, err := appGenesis.ToGenesisDoc()
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := genDoc.ValidateAndComplete(); err != nil {
+		return nil, nil, err
+	}
+
+	db, err := dbm.NewGoLevelDB("application", filepath.Join(home, "data"), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	application, err := newRehearsalApp(logger, db, home, genDoc.ChainID)
+	if err != nil {
+		db.Close()
+		return nil, nil, err
+	}
+
+	validators := make([]*cmttypes.Validator, 0, len(genDoc.Validators))
+	for _, validator := range genDoc.Validators {
+		validators = append(validators, cmttypes.NewValidator(validator.PubKey, validator.Power))
+	}
+	valSet := cmttypes.NewValidatorSet(validators)
+	consensusParams := genDoc.ConsensusParams.ToProto()
+	if _, err := application.InitChain(&abci.RequestInitChain{
+		Time:            genDoc.GenesisTime,
+		ChainId:         genDoc.ChainID,
+		ConsensusParams: &consensusParams,
+		Validators:      cmttypes.TM2PB.ValidatorUpdates(valSet),
+		AppStateBytes:   genDoc.AppState,
+		InitialHeight:   genDoc.InitialHeight,
+	}); err != nil {
+		db.Close()
+		return nil, nil, fmt.Errorf("initializing the chain: %w", err)
+	}
+	if _, err := application.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Time:               genDoc.GenesisTime,
+		Height:             genDoc.InitialHeight,
+		NextValidatorsHash: valSet.Hash(),
+	}); err != nil {
+		db.Close()
+		return nil, nil, fmt.Errorf("finalizing the first block: %w", err)
+	}
+	if _, err := application.Commit(); err != nil {
+		db.Close()
+		return nil, nil, err
+	}
+	return application, db, nil
+}
+
+// newRehearsalApp creates the app with home as its upgrade home so that the
+// upgrade keeper reads the upgrade info of the rehearsal instead of the node's.
+// The app panics if it can't load the database, e.g. because the store upgrades
+// were already applied, which is returned as an error.
+func newRehearsalApp(logger log.Logger, db dbm.DB, home, chainID string) (application *app.App, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	appOpts := viper.New()
+	appOpts.Set(app.FlagUpgradeHome, home)
+	return app.New(logger, db, nil, 0, appOpts, baseapp.SetChainID(chainID)), nil
+}
+
+// rehearseUpgrade applies the upgrade handler registered under upgradeName on
+// top of the last committed height, runs the invariants of all modules and
+// commits the result to compare the stores.
+func rehearseUpgrade(application *app.App, upgradeName string) (*upgradeRehearsalReport, error) {
+	if !application.UpgradeKeeper.HasHandler(upgradeName) {
+		return nil, fmt.Errorf("no upgrade handler is registered for %q", upgradeName)
+	}
+	cms, ok := application.CommitMultiStore().(*rootmulti.Store)
+	if !ok {
+		return nil, errors.New("the commit multi store is not a root multi store")
+	}
+	height := application.LastBlockHeight()
+	before, err := cms.GetCommitInfo(height)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := application.NewUncachedContext(false, cmtproto.Header{
+		ChainID: application.ChainID(),
+		Height:  height + 1,
+		Time:    before.Timestamp,
+	}).WithHeaderInfo(header.Info{
+		ChainID: application.ChainID(),
+		Height:  height + 1,
+		Time:    before.Timestamp,
+	})
+	doneHeight, err := application.UpgradeKeeper.GetDoneHeight(ctx, upgradeName)
+	if err != nil {
+		return nil, err
+	}
+	if doneHeight != 0 {
+		return nil, fmt.Errorf("upgrade %s was already applied at height %d", upgradeName, doneHeight)
+	}
+	// Like the EndBlocker at the signalled upgrade height, the upgrade handler
+	// runs with the consensus params of the app version before the upgrade.
+	ctx = ctx.WithConsensusParams(application.GetConsensusParams(ctx))
+	fromVM, err := application.UpgradeKeeper.GetModuleVersionMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &upgradeRehearsalReport{
+		UpgradeName: upgradeName,
+		Height:      height + 1,
+	}
+	start := time.Now()
+	if err := application.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: upgradeName, Height: height + 1}); err != nil {
+		return nil, fmt.Errorf("applying upgrade %s: %w", upgradeName, err)
+	}
+	report.UpgradeDuration = time.Since(start)
+	// The app version may only be readable once the upgrade handler migrated
+	// the consensus params, so only the new one is reported.
+	report.AppVersion, err = application.AppVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	toVM, err := application.UpgradeKeeper.GetModuleVersionMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, module := range sortedKeys(toVM) {
+		if fromVM[module] != toVM[module] {
+			report.ModuleVersions = append(report.ModuleVersions, moduleVersionChange{Module: module, From: fromVM[module], To: toVM[module]})
+		}
+	}
+
+	start = time.Now()
+	report.Invariants = runInvariants(ctx, application)
+	report.InvariantsDuration = time.Since(start)
+
+	cms.Commit()
+	after, err := cms.GetCommitInfo(height + 1)
+	if err != nil {
+		return nil, err
+	}
+	report.Stores = diffStores(before, after)
+	return report, nil
+}
+
+// upgradeRehearsalReport is the outcome of an upgrade rehearsal.
+type upgradeRehearsalReport struct {
+	UpgradeName        string
+	Height             int64
+	UpgradeDuration    time.Duration
+	AppVersion         uint64
+	ModuleVersions     []moduleVersionChange
+	Stores             storeChanges
+	Invariants         []invariantResult
+	InvariantsDuration time.Duration
+}
+
+type moduleVersionChange struct {
+	Module   string
+	From, To uint64
+}
+
+type storeChanges struct {
+	Added, Deleted, Modified, Unchanged []string
+}
+
+type invariantResult struct {
+	Route   string
+	Broken  bool
+	Message string
+}
+
+func (r *upgradeRehearsalReport) brokenInvariants() int {
+	broken := 0
+	for _, result := range r.Invariants {
+		if result.Broken {
+			broken++
+		}
+	}
+	return broken
+}
+
+func (r *upgradeRehearsalReport) print(w io.Writer) {
+	fmt.Fprintf(w, "Applied upgrade %s at height %d in %s\n", r.UpgradeName, r.Height, r.UpgradeDuration)
+	fmt.Fprintf(w, "App version after the upgrade: %d\n", r.AppVersion)
+
+	fmt.Fprintln(w, "\nModule versions:")
+	if len(r.ModuleVersions) == 0 {
+		fmt.Fprintln(w, "  none changed")
+	}
+	for _, change := range r.ModuleVersions {
+		fmt.Fprintf(w, "  %s: %d -> %d\n", change.Module, change.From, change.To)
+	}
+
+	fmt.Fprintln(w, "\nStores:")
+	fmt.Fprintf(w, "  added: %s\n", formatStores(r.Stores.Added))
+	fmt.Fprintf(w, "  deleted: %s\n", formatStores(r.Stores.Deleted))
+	fmt.Fprintf(w, "  modified: %s\n", formatStores(r.Stores.Modified))
+	fmt.Fprintf(w, "  unchanged: %s\n", formatStores(r.Stores.Unchanged))
+
+	fmt.Fprintf(w, "\nInvariants (%d broken of %d, checked in %s):\n", r.brokenInvariants(), len(r.Invariants), r.InvariantsDuration)
+	for _, result := range r.Invariants {
+		if !result.Broken {
+			fmt.Fprintf(w, "  %s: ok\n", result.Route)
+			continue
+		}
+		fmt.Fprintf(w, "  %s: BROKEN\n    %s\n", result.Route, strings.ReplaceAll(strings.TrimSpace(result.Message), "\n", "\n    "))
+	}
+}
+
+func formatStores(stores []string) string {
+	if len(stores) == 0 {
+		return "none"
+	}
+	return strings.Join(stores, ", ")
+}
+
+// diffStores compares the store hashes of two commits.
+func diffStores(before, after *storetypes.CommitInfo) storeChanges {
+	hashes := make(map[string][]byte, len(before.StoreInfos))
+	for _, info := range before.StoreInfos {
+		hashes[info.Name] = info.CommitId.Hash
+	}
+
+	var changes storeChanges
+	for _, info := range after.StoreInfos {
+		hash, ok := hashes[info.Name]
+		switch {
+		case !ok:
+			changes.Added = append(changes.Added, info.Name)
+		case slices.Equal(hash, info.CommitId.Hash):
+			changes.Unchanged = append(changes.Unchanged, info.Name)
+		default:
+			changes.Modified = append(changes.Modified, info.Name)
+		}
+		delete(hashes, info.Name)
+	}
+	changes.Deleted = sortedKeys(hashes)
+
+	sort.Strings(changes.Added)
+	sort.Strings(changes.Modified)
+	sort.Strings(changes.Unchanged)
+	return changes
+}
+
+// invariantRegistry collects the invariants that the modules register.
+type invariantRegistry struct {
+	routes    []string
+	invariant map[string]sdk.Invariant
+}
+
+func (r *invariantRegistry) RegisterRoute(moduleName, route string, invariant sdk.Invariant) {
+	fullRoute := moduleName + "/" + route
+	r.routes = append(r.routes, fullRoute)
+	r.invariant[fullRoute] = invariant
+}
+
+// runInvariants runs the invariants of all modules of the app.
+func runInvariants(ctx sdk.Context, application *app.App) []invariantResult {
+	registry := &invariantRegistry{invariant: make(map[string]sdk.Invariant)}
+	application.ModuleManager.RegisterInvariants(registry)
+
+	results := make([]invariantResult, 0, len(registry.routes))
+	for _, route := range registry.routes {
+		message, broken := registry.invariant[route](ctx)
+		results = append(results, invariantResult{Route: route, Broken: broken, Message: message})
+	}
+	return results
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// copyDir copies the regular files and directories of src into dst.
+func copyDir(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if entry.IsDir() {
+			return os.MkdirAll(target, 0o700)
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		return copyFile(path, target)
+	})
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,186 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/rootmulti"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/celestiaorg/celestia-app/v5/app"
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	testutil "github.com/celestiaorg/celestia-app/v5/test/util"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgradeRehearsal(t *testing.T) {
+	nodeHome, height := initTestNode(t)
+
+	application, db, err := newRehearsalAppFromHome(log.NewNopLogger(), t.TempDir(), nodeHome, dbm.GoLevelDBBackend, app.UpgradeNameV6)
+	require.NoError(t, err)
+	defer db.Close()
+
+	report, err := rehearseUpgrade(application, app.UpgradeNameV6)
+	require.NoError(t, err)
+
+	assert.Equal(t, app.UpgradeNameV6, report.UpgradeName)
+	assert.Equal(t, height+1, report.Height)
+	assert.Equal(t, appconsts.V6, report.AppVersion)
+	assert.NotEmpty(t, report.Invariants)
+	assert.Zero(t, report.brokenInvariants())
+	assert.Equal(t, v6ModuleVersions, report.ModuleVersions)
+	assert.Empty(t, report.Stores.Added)
+	assert.Empty(t, report.Stores.Deleted)
+	assert.Subset(t, report.Stores.Modified, []string{"blob", "minfee", "upgrade"})
+	assert.NotEmpty(t, report.Stores.Unchanged)
+
+	var out bytes.Buffer
+	report.print(&out)
+	assert.Contains(t, out.String(), "Applied upgrade v6")
+	assert.Contains(t, out.String(), "Invariants (0 broken")
+
+	_, err = rehearseUpgrade(application, app.UpgradeNameV6)
+	assert.ErrorContains(t, err, "upgrade v6 was already applied")
+}
+
+func TestUpgradeRehearsalFromGenesis(t *testing.T) {
+	application, db, err := newRehearsalAppFromGenesis(log.NewNopLogger(), t.TempDir(), writeTestGenesis(t))
+	require.NoError(t, err)
+	defer db.Close()
+
+	report, err := rehearseUpgrade(application, app.UpgradeNameV6)
+	require.NoError(t, err)
+	assert.Equal(t, application.LastBlockHeight(), report.Height)
+	assert.Equal(t, appconsts.V6, report.AppVersion)
+	assert.Zero(t, report.brokenInvariants())
+	// The exported genesis doesn't hold the module versions, so the migrations
+	// start from the ones of its app version.
+	assert.Equal(t, v6ModuleVersions, report.ModuleVersions)
+	assert.Subset(t, report.Stores.Modified, []string{"blob", "minfee", "upgrade"})
+}
+
+func TestUpgradeRehearsalFromHome(t *testing.T) {
+	nodeHome, height := initTestNode(t)
+
+	t.Run("store upgrades that were already applied", func(t *testing.T) {
+		_, _, err := newRehearsalAppFromHome(log.NewNopLogger(), t.TempDir(), nodeHome, dbm.GoLevelDBBackend, app.UpgradeName)
+		assert.ErrorContains(t, err, "loading the application database with the store upgrades of v4")
+	})
+
+	t.Run("upgrade without store upgrades", func(t *testing.T) {
+		const upgradeName = "rehearsal"
+		application, db, err := newRehearsalAppFromHome(log.NewNopLogger(), t.TempDir(), nodeHome, dbm.GoLevelDBBackend, upgradeName)
+		require.NoError(t, err)
+		defer db.Close()
+		assert.Equal(t, testutil.ChainID, application.ChainID())
+		assert.Equal(t, height, application.LastBlockHeight())
+
+		application.UpgradeKeeper.SetUpgradeHandler(upgradeName, func(_ context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			return fromVM, nil
+		})
+		report, err := rehearseUpgrade(application, upgradeName)
+		require.NoError(t, err)
+		assert.Zero(t, report.brokenInvariants())
+		assert.Empty(t, report.ModuleVersions)
+		// Applying an upgrade increments the app version in the consensus
+		// params and marks the upgrade as done.
+		assert.Equal(t, []string{"consensus", "upgrade"}, report.Stores.Modified)
+		assert.Equal(t, appconsts.V6, report.AppVersion)
+	})
+
+	// The node's application database is left untouched.
+	nodeDB, err := dbm.NewGoLevelDB("application", filepath.Join(nodeHome, "data"), nil)
+	require.NoError(t, err)
+	defer nodeDB.Close()
+	assert.Equal(t, height, rootmulti.GetLatestVersion(nodeDB))
+}
+
+// TestUpgradeRehearsalAppVersion tests that the upgrade handler runs with the
+// app version before the upgrade in the consensus params of its context, like
+// when the EndBlocker applies it at the signalled upgrade height, and that the
+// upgrade increments the app version once.
+func TestUpgradeRehearsalAppVersion(t *testing.T) {
+	nodeHome, _ := initTestNode(t)
+
+	for _, upgradeName := range []string{app.UpgradeNameV6, "rehearsal"} {
+		t.Run(upgradeName, func(t *testing.T) {
+			application, db, err := newRehearsalAppFromHome(log.NewNopLogger(), t.TempDir(), nodeHome, dbm.GoLevelDBBackend, upgradeName)
+			require.NoError(t, err)
+			defer db.Close()
+
+			var got uint64
+			application.UpgradeKeeper.SetUpgradeHandler(upgradeName, func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+				got = sdk.UnwrapSDKContext(ctx).ConsensusParams().Version.GetApp()
+				return fromVM, nil
+			})
+			report, err := rehearseUpgrade(application, upgradeName)
+			require.NoError(t, err)
+			assert.Equal(t, appconsts.V6-1, got)
+			assert.Equal(t, appconsts.V6, report.AppVersion)
+		})
+	}
+}
+
+func TestUpgradeRehearsalUnknownUpgrade(t *testing.T) {
+	nodeHome, _ := initTestNode(t)
+	application, db, err := newRehearsalAppFromHome(log.NewNopLogger(), t.TempDir(), nodeHome, dbm.GoLevelDBBackend, "v100")
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = rehearseUpgrade(application, "v100")
+	assert.EqualError(t, err, `no upgrade handler is registered for "v100"`)
+}
+
+// initTestNode initializes a node home from the test genesis and commits the
+// first block to its application database. It returns the home and the last
+// committed height.
+func initTestNode(t *testing.T) (string, int64) {
+	t.Helper()
+	appGenesis, err := genutiltypes.AppGenesisFromFile(writeTestGenesis(t))
+	require.NoError(t, err)
+	home := t.TempDir()
+	genesisFile := filepath.Join(home, "config", "genesis.json")
+	require.NoError(t, os.MkdirAll(filepath.Dir(genesisFile), 0o700))
+	require.NoError(t, appGenesis.SaveAs(genesisFile))
+
+	application, db, err := newRehearsalAppFromGenesis(log.NewNopLogger(), home, genesisFile)
+	require.NoError(t, err)
+	defer db.Close()
+	return home, application.LastBlockHeight()
+}
+
+// v6ModuleVersions are the module versions that the v6 upgrade migrates.
+var v6ModuleVersions = []moduleVersionChange{
+	{Module: "blob", From: 3, To: 4},
+	{Module: "minfee", From: 2, To: 3},
+}
+
+// writeTestGenesis writes the genesis of a chain with a single validator to a
+// file.
+func writeTestGenesis(t *testing.T) string {
+	t.Helper()
+	genesisState, _, _ := testutil.GenesisStateWithSingleValidator(testutil.NewTestApp(), "genesisAcc")
+	appState, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+
+	appGenesis := genutiltypes.NewAppGenesisWithVersion(testutil.ChainID, appState)
+	appGenesis.GenesisTime = testutil.GenesisTime
+	// the node runs the app version before the v6 upgrade.
+	consensusParams := cmttypes.ConsensusParamsFromProto(*app.DefaultConsensusParams())
+	consensusParams.Version.App = appconsts.V6 - 1
+	appGenesis.Consensus.Params = &consensusParams
+
+	genesisFile := filepath.Join(t.TempDir(), "genesis.json")
+	require.NoError(t, appGenesis.SaveAs(genesisFile))
+	return genesisFile
+}
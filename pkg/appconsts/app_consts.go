@@ -3,7 +3,9 @@ package appconsts
 import "time"
 
 const (
-	Version uint64 = 5
+	Version uint64 = 6
+	// V6 is the app version that gates the state machine changes of v6.
+	V6 uint64 = 6
 	// SquareSizeUpperBound imposes an upper bound on the max effective square size.
 	SquareSizeUpperBound int = 128
 	// SubtreeRootThreshold works as a target upper bound for the number of subtree
@@ -0,0 +1,229 @@
+package proof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v5/pkg/da"
+	"github.com/celestiaorg/celestia-app/v5/pkg/wrapper"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/cometbft/cometbft/crypto/merkle"
+)
+
+// NewNamespaceAbsenceProof takes an ODS, extends it, then returns a proof that
+// the provided namespace has no shares in it.
+func NewNamespaceAbsenceProof(dataSquare square.Square, namespace share.Namespace) (NamespaceAbsenceProof, error) {
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	if err != nil {
+		return NamespaceAbsenceProof{}, err
+	}
+	return NewNamespaceAbsenceProofFromEDS(eds, namespace)
+}
+
+// NewNamespaceAbsenceProofFromEDS takes an extended data square and returns a
+// proof that the provided namespace has no shares in its original data square.
+// It returns an error if the namespace is present in the square.
+//
+// The proof covers the first row whose maximum namespace is not lower than the
+// namespace. If the namespace is within the namespace range of that row, an NMT
+// absence proof is added for it. Otherwise, the namespace falls between two
+// rows and the preceding row, if any, is added to the proof.
+func NewNamespaceAbsenceProofFromEDS(
+	eds *rsmt2d.ExtendedDataSquare,
+	namespace share.Namespace,
+) (NamespaceAbsenceProof, error) {
+	squareSize := int(eds.Width() / 2)
+	edsRowRoots, err := eds.RowRoots()
+	if err != nil {
+		return NamespaceAbsenceProof{}, err
+	}
+	edsColRoots, err := eds.ColRoots()
+	if err != nil {
+		return NamespaceAbsenceProof{}, err
+	}
+
+	// find the row that the namespace would be located in. If the namespace is
+	// higher than every namespace in the square, it would be after the last row.
+	row := squareSize - 1
+	for i := 0; i < squareSize; i++ {
+		if bytes.Compare(namespace.Bytes(), maxNamespace(edsRowRoots[i])) <= 0 {
+			row = i
+			break
+		}
+	}
+
+	startRow, endRow := row, row
+	var absenceProof *NMTProof
+	switch {
+	case bytes.Compare(namespace.Bytes(), maxNamespace(edsRowRoots[row])) > 0:
+		// the namespace is after the last row of the square.
+	case bytes.Compare(namespace.Bytes(), minNamespace(edsRowRoots[row])) < 0:
+		// the namespace is between the previous row and this one.
+		if row > 0 {
+			startRow = row - 1
+		}
+	default:
+		absenceProof, err = newRowAbsenceProof(eds, squareSize, row, edsRowRoots[row], namespace)
+		if err != nil {
+			return NamespaceAbsenceProof{}, err
+		}
+	}
+
+	// create the binary merkle inclusion proof for the rows to the data root
+	_, allProofs := merkle.ProofsFromByteSlices(append(edsRowRoots, edsColRoots...))
+	rowProofs := make([]*Proof, endRow-startRow+1)
+	rowRoots := make([][]byte, endRow-startRow+1)
+	for i := startRow; i <= endRow; i++ {
+		rowProofs[i-startRow] = &Proof{
+			Total:    allProofs[i].Total,
+			Index:    allProofs[i].Index,
+			LeafHash: allProofs[i].LeafHash,
+			Aunts:    allProofs[i].Aunts,
+		}
+		rowRoots[i-startRow] = edsRowRoots[i]
+	}
+
+	return NamespaceAbsenceProof{
+		NamespaceId:      namespace.ID(),
+		NamespaceVersion: uint32(namespace.Version()),
+		RowProof: &RowProof{
+			RowRoots: rowRoots,
+			Proofs:   rowProofs,
+			StartRow: uint32(startRow),
+			EndRow:   uint32(endRow),
+		},
+		AbsenceProof: absenceProof,
+	}, nil
+}
+
+// newRowAbsenceProof returns the NMT absence proof of the namespace in the
+// provided row of the extended data square.
+func newRowAbsenceProof(
+	eds *rsmt2d.ExtendedDataSquare,
+	squareSize int,
+	row int,
+	rowRoot []byte,
+	namespace share.Namespace,
+) (*NMTProof, error) {
+	// we have to re-create the tree as the eds one is not accessible.
+	tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(squareSize), uint(row))
+	for _, sh := range eds.Row(uint(row)) {
+		if err := tree.Push(sh); err != nil {
+			return nil, err
+		}
+	}
+	root, err := tree.Root()
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(rowRoot, root) {
+		return nil, errors.New("eds row root is different than tree root")
+	}
+
+	proof, err := tree.ProveNamespace(namespace.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	if !proof.IsOfAbsence() {
+		return nil, fmt.Errorf("namespace %x is present in row %d", namespace.Bytes(), row)
+	}
+	return &NMTProof{
+		Start:    int32(proof.Start()),
+		End:      int32(proof.End()),
+		Nodes:    proof.Nodes(),
+		LeafHash: proof.LeafHash(),
+	}, nil
+}
+
+// Validate runs basic validations on the proof then verifies if it is
+// consistent. It returns nil if the proof is valid. Otherwise, it returns a
+// sensible error. The `root` is the block data root that the namespace is
+// absent from.
+//
+// Note: the proof relies on the shares of the data square being ordered by
+// namespace, which is guaranteed for data roots that were accepted by
+// consensus.
+func (p NamespaceAbsenceProof) Validate(root []byte) error {
+	if p.RowProof == nil {
+		return errors.New("empty row proof")
+	}
+	if p.NamespaceVersion > share.NamespaceVersionMax {
+		return fmt.Errorf("unsupported namespace version %d", p.NamespaceVersion)
+	}
+	namespace, err := share.NewNamespace(uint8(p.NamespaceVersion), p.NamespaceId)
+	if err != nil {
+		return err
+	}
+
+	rowCount := len(p.RowProof.RowRoots)
+	if rowCount != 1 && rowCount != 2 {
+		return fmt.Errorf("the number of row roots %d must be one or two", rowCount)
+	}
+	if err := p.RowProof.Validate(root); err != nil {
+		return err
+	}
+
+	// the data root commits to the row and column roots of the extended data
+	// square so the original square size is a quarter of the leaves.
+	total := p.RowProof.Proofs[0].Total
+	if total <= 0 || total%4 != 0 {
+		return fmt.Errorf("invalid number of data root leaves %d", total)
+	}
+	squareSize := total / 4
+	for i, proof := range p.RowProof.Proofs {
+		if proof.Total != total || proof.Index != int64(p.RowProof.StartRow)+int64(i) {
+			return fmt.Errorf("row proof %d does not match row %d", i, int64(p.RowProof.StartRow)+int64(i))
+		}
+	}
+	if int64(p.RowProof.EndRow) >= squareSize {
+		return fmt.Errorf("row %d is outside of the original data square of size %d", p.RowProof.EndRow, squareSize)
+	}
+
+	lastRoot := p.RowProof.RowRoots[rowCount-1]
+	if p.AbsenceProof != nil {
+		if rowCount != 1 {
+			return errors.New("an absence proof must be accompanied by a single row")
+		}
+		nmtProof := nmt.NewAbsenceProof(
+			int(p.AbsenceProof.Start),
+			int(p.AbsenceProof.End),
+			p.AbsenceProof.Nodes,
+			p.AbsenceProof.LeafHash,
+			true,
+		)
+		if !nmtProof.IsOfAbsence() || !nmtProof.VerifyNamespace(appconsts.NewBaseHashFunc(), namespace.Bytes(), nil, lastRoot) {
+			return errors.New("namespace absence proof failed to verify")
+		}
+		return nil
+	}
+
+	if rowCount == 2 {
+		if bytes.Compare(maxNamespace(p.RowProof.RowRoots[0]), namespace.Bytes()) >= 0 ||
+			bytes.Compare(namespace.Bytes(), minNamespace(lastRoot)) >= 0 {
+			return errors.New("namespace is not between the proven rows")
+		}
+		return nil
+	}
+
+	isBeforeFirstRow := p.RowProof.StartRow == 0 && bytes.Compare(namespace.Bytes(), minNamespace(lastRoot)) < 0
+	isAfterLastRow := int64(p.RowProof.EndRow) == squareSize-1 && bytes.Compare(maxNamespace(lastRoot), namespace.Bytes()) < 0
+	if !isBeforeFirstRow && !isAfterLastRow {
+		return errors.New("namespace is not outside of the proven row")
+	}
+	return nil
+}
+
+// minNamespace returns the minimum namespace of an NMT root.
+func minNamespace(root []byte) []byte {
+	return nmt.MinNamespace(root, share.NamespaceSize)
+}
+
+// maxNamespace returns the maximum namespace of an NMT root.
+func maxNamespace(root []byte) []byte {
+	return nmt.MaxNamespace(root, share.NamespaceSize)
+}
@@ -0,0 +1,119 @@
+package proof_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v5/pkg/da"
+	"github.com/celestiaorg/celestia-app/v5/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v5/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v5/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v5/test/util/testnode"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNamespaceAbsenceProof(t *testing.T) {
+	present := []share.Namespace{
+		share.MustNewV0Namespace(bytes.Repeat([]byte{0x20}, share.NamespaceVersionZeroIDSize)),
+		share.MustNewV0Namespace(bytes.Repeat([]byte{0x40}, share.NamespaceVersionZeroIDSize)),
+		share.MustNewV0Namespace(bytes.Repeat([]byte{0x60}, share.NamespaceVersionZeroIDSize)),
+		share.MustNewV0Namespace(bytes.Repeat([]byte{0x80}, share.NamespaceVersionZeroIDSize)),
+	}
+
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	blobTxs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, present, []int{3000, 200, 9000, 500})
+	txs := testfactory.GenerateRandomTxs(20, 500)
+	txs = append(txs, blobTxs...)
+
+	dataSquare, err := square.Construct(txs.ToSliceOfBytes(), appconsts.SquareSizeUpperBound, appconsts.SubtreeRootThreshold)
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	dataRoot := dah.Hash()
+
+	t.Run("present namespaces can not be proven absent", func(t *testing.T) {
+		for _, ns := range append(present, share.TxNamespace, share.PayForBlobNamespace) {
+			_, err := proof.NewNamespaceAbsenceProofFromEDS(eds, ns)
+			assert.Error(t, err, ns.String())
+		}
+	})
+
+	t.Run("absent namespaces", func(t *testing.T) {
+		withAbsenceProof := 0
+		for b := 0x10; b <= 0x90; b += 0x08 {
+			if b%0x20 == 0 {
+				// skip the present namespaces
+				continue
+			}
+			ns := share.MustNewV0Namespace(bytes.Repeat([]byte{byte(b)}, share.NamespaceVersionZeroIDSize))
+			absenceProof, err := proof.NewNamespaceAbsenceProofFromEDS(eds, ns)
+			require.NoError(t, err, ns.String())
+			require.NoError(t, absenceProof.Validate(dataRoot), ns.String())
+			assert.Error(t, absenceProof.Validate(bytes.Repeat([]byte{1}, 32)), ns.String())
+
+			if absenceProof.AbsenceProof != nil {
+				withAbsenceProof++
+			}
+		}
+		assert.NotZero(t, withAbsenceProof)
+	})
+
+	t.Run("proof of a different namespace does not validate", func(t *testing.T) {
+		absent := share.MustNewV0Namespace(bytes.Repeat([]byte{0x30}, share.NamespaceVersionZeroIDSize))
+		absenceProof, err := proof.NewNamespaceAbsenceProofFromEDS(eds, absent)
+		require.NoError(t, err)
+		absenceProof.NamespaceId = present[1].ID()
+		assert.Error(t, absenceProof.Validate(dataRoot))
+	})
+
+	t.Run("namespace after every row", func(t *testing.T) {
+		absenceProof, err := proof.NewNamespaceAbsenceProofFromEDS(eds, share.ParitySharesNamespace)
+		require.NoError(t, err)
+		assert.Nil(t, absenceProof.AbsenceProof)
+		assert.Equal(t, uint32(eds.Width()/2-1), absenceProof.RowProof.EndRow)
+		assert.NoError(t, absenceProof.Validate(dataRoot))
+	})
+
+	t.Run("namespace before every row", func(t *testing.T) {
+		absenceProof, err := proof.NewNamespaceAbsenceProofFromEDS(eds, share.MustNewV0Namespace(bytes.Repeat([]byte{0}, share.NamespaceVersionZeroIDSize)))
+		require.NoError(t, err)
+		assert.Nil(t, absenceProof.AbsenceProof)
+		assert.Equal(t, uint32(0), absenceProof.RowProof.StartRow)
+		assert.NoError(t, absenceProof.Validate(dataRoot))
+	})
+}
+
+func TestQueryNamespaceAbsenceProof(t *testing.T) {
+	txs := testfactory.GenerateRandomTxs(5, 200)
+	block := tmproto.Block{
+		Data: tmproto.Data{Txs: txs.ToSliceOfBytes()},
+	}
+	rawBlock, err := block.Marshal()
+	require.NoError(t, err)
+	req := &abci.RequestQuery{Data: rawBlock}
+
+	absent := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	raw, err := proof.QueryNamespaceAbsenceProof(sdk.Context{}, []string{hex.EncodeToString(absent.Bytes())}, req)
+	require.NoError(t, err)
+	var absenceProof proof.NamespaceAbsenceProof
+	require.NoError(t, absenceProof.Unmarshal(raw))
+	assert.Equal(t, absent.ID(), absenceProof.NamespaceId)
+
+	_, err = proof.QueryNamespaceAbsenceProof(sdk.Context{}, []string{hex.EncodeToString(share.TxNamespace.Bytes())}, req)
+	assert.Error(t, err)
+	_, err = proof.QueryNamespaceAbsenceProof(sdk.Context{}, []string{"not hex"}, req)
+	assert.Error(t, err)
+	_, err = proof.QueryNamespaceAbsenceProof(sdk.Context{}, []string{}, req)
+	assert.Error(t, err)
+}
@@ -0,0 +1,135 @@
+package proof
+
+import (
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v5/pkg/da"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	"github.com/cometbft/cometbft/crypto/merkle"
+)
+
+// NewNamespaceBlobs rebuilds the data square from the provided block txs and
+// returns all the blobs published under the namespace, in the order they
+// appear in the square. Each blob is returned with a share inclusion proof to
+// the data root of the square, which is also returned. The app version is the
+// version of the block; the square of versions this binary doesn't know can't
+// be rebuilt.
+func NewNamespaceBlobs(txs [][]byte, namespace share.Namespace, appVersion uint64) ([]*NamespaceBlob, []byte, error) {
+	if appVersion > appconsts.Version {
+		return nil, nil, fmt.Errorf("unsupported app version %d: the latest supported app version is %d", appVersion, appconsts.Version)
+	}
+
+	dataSquare, shareRanges, err := BlobShareRanges(txs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blobs := make([]*NamespaceBlob, 0)
+	for txIndex, rawTx := range txs {
+		bTx, isBlobTx, err := blobtx.UnmarshalBlobTx(rawTx)
+		if !isBlobTx {
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("unmarshalling blob tx at index %d: %w", txIndex, err)
+		}
+
+		for blobIndex, blob := range bTx.Blobs {
+			if !blob.Namespace().Equals(namespace) {
+				continue
+			}
+
+			shareRange := shareRanges[txIndex][blobIndex]
+			shareProof, err := NewShareInclusionProofFromEDS(eds, namespace, shareRange)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			commitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			blobs = append(blobs, &NamespaceBlob{
+				Data:         blob.Data(),
+				ShareVersion: uint32(blob.ShareVersion()),
+				Signer:       blob.Signer(),
+				Commitment:   commitment,
+				TxIndex:      uint32(txIndex),
+				BlobIndex:    uint32(blobIndex),
+				StartShare:   uint32(shareRange.Start),
+				EndShare:     uint32(shareRange.End),
+				Proof:        &shareProof,
+			})
+		}
+	}
+
+	return blobs, dah.Hash(), nil
+}
+
+// BlobShareRanges constructs the data square of the block txs with
+// square.Construct, like PrepareProposal and ProcessProposal, and returns it
+// together with the range of shares that each blob occupies in it, indexed by
+// tx index and blob index. Txs that aren't blob txs have no ranges. The ranges
+// are read from the share indexes of the wrapped PFBs in the square.
+func BlobShareRanges(txs [][]byte) (square.Square, [][]share.Range, error) {
+	// As we don't have access to the application's state machine we use the
+	// upper bound square size instead of the square size dictated by
+	// governance. The upper bound and the subtree root threshold are the same
+	// for all the supported app versions.
+	dataSquare, err := square.Construct(txs, appconsts.SquareSizeUpperBound, appconsts.SubtreeRootThreshold)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pfbRange := share.GetShareRangeForNamespace(dataSquare, share.PayForBlobNamespace)
+	wrappedPFBs, err := share.ParseTxs(dataSquare[pfbRange.Start:pfbRange.End])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the wrapped PFBs are in the order of the blob txs.
+	shareRanges := make([][]share.Range, len(txs))
+	pfbIndex := 0
+	for txIndex, rawTx := range txs {
+		bTx, isBlobTx, err := blobtx.UnmarshalBlobTx(rawTx)
+		if !isBlobTx {
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("unmarshalling blob tx at index %d: %w", txIndex, err)
+		}
+		if pfbIndex >= len(wrappedPFBs) {
+			return nil, nil, fmt.Errorf("no wrapped PFB for the blob tx at index %d", txIndex)
+		}
+		wrappedPFB, isWrappedPFB := blobtx.UnmarshalIndexWrapper(wrappedPFBs[pfbIndex])
+		if !isWrappedPFB {
+			return nil, nil, fmt.Errorf("expected wrapped PFB at index %d", pfbIndex)
+		}
+		pfbIndex++
+		if len(wrappedPFB.ShareIndexes) != len(bTx.Blobs) {
+			return nil, nil, fmt.Errorf("wrapped PFB of the blob tx at index %d has %d share indexes for %d blobs", txIndex, len(wrappedPFB.ShareIndexes), len(bTx.Blobs))
+		}
+
+		shareRanges[txIndex] = make([]share.Range, len(bTx.Blobs))
+		for blobIndex, blob := range bTx.Blobs {
+			start := int(wrappedPFB.ShareIndexes[blobIndex])
+			shareRanges[txIndex][blobIndex] = share.NewRange(start, start+share.SparseSharesNeeded(uint32(blob.DataLen())))
+		}
+	}
+	return dataSquare, shareRanges, nil
+}
@@ -0,0 +1,132 @@
+package proof_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v5/pkg/da"
+	"github.com/celestiaorg/celestia-app/v5/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v5/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v5/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v5/test/util/testnode"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNewNamespaceBlobs(t *testing.T) {
+	ns1 := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	ns2 := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+	ns3 := share.MustNewV0Namespace(bytes.Repeat([]byte{3}, share.NamespaceVersionZeroIDSize))
+
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	blobTxs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []share.Namespace{ns1, ns2, ns1}, []int{500, 2000, 100})
+	txs := testfactory.GenerateRandomTxs(10, 500)
+	txs = append(txs, blobTxs...)
+	rawTxs := txs.ToSliceOfBytes()
+
+	dataSquare, err := square.Construct(rawTxs, appconsts.SquareSizeUpperBound, appconsts.SubtreeRootThreshold)
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	t.Run("returns every blob in the namespace with a valid proof", func(t *testing.T) {
+		blobs, dataRoot, err := proof.NewNamespaceBlobs(rawTxs, ns1, appconsts.Version)
+		require.NoError(t, err)
+		require.Equal(t, dah.Hash(), dataRoot)
+		require.Len(t, blobs, 2)
+
+		for _, blob := range blobs {
+			bTx, isBlobTx, err := blobtx.UnmarshalBlobTx(rawTxs[blob.TxIndex])
+			require.True(t, isBlobTx)
+			require.NoError(t, err)
+			assert.Equal(t, bTx.Blobs[blob.BlobIndex].Data(), blob.Data)
+
+			require.NoError(t, blob.Proof.Validate(dataRoot))
+			assert.Equal(t, ns1.ID(), blob.Proof.NamespaceId)
+			assert.Len(t, blob.Proof.Data, int(blob.EndShare-blob.StartShare))
+			for i, rawShare := range blob.Proof.Data {
+				assert.Equal(t, dataSquare[int(blob.StartShare)+i].ToBytes(), rawShare)
+			}
+		}
+		assert.Less(t, blobs[0].StartShare, blobs[1].StartShare)
+	})
+
+	t.Run("returns no blobs for a namespace without data", func(t *testing.T) {
+		blobs, dataRoot, err := proof.NewNamespaceBlobs(rawTxs, ns3, appconsts.Version)
+		require.NoError(t, err)
+		require.Equal(t, dah.Hash(), dataRoot)
+		assert.Empty(t, blobs)
+	})
+
+	t.Run("rejects an unknown app version", func(t *testing.T) {
+		_, _, err := proof.NewNamespaceBlobs(rawTxs, ns1, appconsts.Version+1)
+		require.Error(t, err)
+	})
+}
+
+func TestBlobShareRanges(t *testing.T) {
+	ns1 := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	ns2 := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	blobTxs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []share.Namespace{ns1, ns2, ns1}, []int{500, 2000, 100})
+	txs := testfactory.GenerateRandomTxs(5, 500)
+	txs = append(txs, blobTxs...)
+	rawTxs := txs.ToSliceOfBytes()
+
+	dataSquare, shareRanges, err := proof.BlobShareRanges(rawTxs)
+	require.NoError(t, err)
+	want, err := square.Construct(rawTxs, appconsts.SquareSizeUpperBound, appconsts.SubtreeRootThreshold)
+	require.NoError(t, err)
+	assert.Equal(t, want, dataSquare)
+
+	require.Len(t, shareRanges, len(rawTxs))
+	for txIndex, rawTx := range rawTxs {
+		bTx, isBlobTx, err := blobtx.UnmarshalBlobTx(rawTx)
+		if !isBlobTx {
+			assert.Empty(t, shareRanges[txIndex])
+			continue
+		}
+		require.NoError(t, err)
+		require.Len(t, shareRanges[txIndex], len(bTx.Blobs))
+		for blobIndex := range bTx.Blobs {
+			wantRange, err := square.BlobShareRange(rawTxs, txIndex, blobIndex, appconsts.SquareSizeUpperBound, appconsts.SubtreeRootThreshold)
+			require.NoError(t, err)
+			assert.Equal(t, wantRange, shareRanges[txIndex][blobIndex])
+		}
+	}
+}
+
+func TestQueryNamespaceBlobsValidatesRequest(t *testing.T) {
+	server := proof.NewQueryServer(client.Context{})
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+
+	testCases := []struct {
+		name string
+		req  *proof.QueryNamespaceBlobsRequest
+	}{
+		{name: "nil request", req: nil},
+		{name: "negative height", req: &proof.QueryNamespaceBlobsRequest{Height: -1, Namespace: ns.Bytes()}},
+		{name: "malformed namespace", req: &proof.QueryNamespaceBlobsRequest{Height: 1, Namespace: []byte{1, 2, 3}}},
+		{name: "reserved namespace", req: &proof.QueryNamespaceBlobsRequest{Height: 1, Namespace: share.TxNamespace.Bytes()}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := server.NamespaceBlobs(context.Background(), tc.req)
+			require.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
@@ -192,7 +192,7 @@ type NMTProof struct {
 	// and min namespaces along with the actual hash, resulting in each being 48
 	// bytes each
 	Nodes [][]byte `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
-	// leafHash are nil if the namespace is present in the NMT. In case the
+	// leafHash is nil if the namespace is present in the NMT. In case the
 	// namespace to be proved is in the min/max range of the tree but absent, this
 	// will contain the leaf hash necessary to verify the proof of absence. Leaf
 	// hashes should consist of the namespace along with the actual hash,
@@ -330,11 +330,91 @@ func (m *Proof) GetAunts() [][]byte {
 	return nil
 }
 
+// NamespaceAbsenceProof proves that a namespace has no shares in the original
+// data square committed to by a data root. Because the shares of a valid data
+// square are ordered by namespace, it is sufficient to prove the row roots
+// surrounding the position that the namespace would occupy along with, if the
+// namespace falls inside the namespace range of such a row, an NMT absence
+// proof for that row.
+type NamespaceAbsenceProof struct {
+	NamespaceId      []byte `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	NamespaceVersion uint32 `protobuf:"varint,2,opt,name=namespace_version,json=namespaceVersion,proto3" json:"namespace_version,omitempty"`
+	// RowProof proves either a single row or two adjacent rows of the original
+	// data square to the data root.
+	RowProof *RowProof `protobuf:"bytes,3,opt,name=row_proof,json=rowProof,proto3" json:"row_proof,omitempty"`
+	// AbsenceProof is the NMT absence proof of the namespace in the last row of
+	// the row proof. It is nil if the namespace is outside of the namespace
+	// range of every row in the row proof.
+	AbsenceProof *NMTProof `protobuf:"bytes,4,opt,name=absence_proof,json=absenceProof,proto3" json:"absence_proof,omitempty"`
+}
+
+func (m *NamespaceAbsenceProof) Reset()         { *m = NamespaceAbsenceProof{} }
+func (m *NamespaceAbsenceProof) String() string { return proto.CompactTextString(m) }
+func (*NamespaceAbsenceProof) ProtoMessage()    {}
+func (*NamespaceAbsenceProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e53d87d8fb5ec353, []int{4}
+}
+func (m *NamespaceAbsenceProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceAbsenceProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceAbsenceProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceAbsenceProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceAbsenceProof.Merge(m, src)
+}
+func (m *NamespaceAbsenceProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceAbsenceProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceAbsenceProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceAbsenceProof proto.InternalMessageInfo
+
+func (m *NamespaceAbsenceProof) GetNamespaceId() []byte {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return nil
+}
+
+func (m *NamespaceAbsenceProof) GetNamespaceVersion() uint32 {
+	if m != nil {
+		return m.NamespaceVersion
+	}
+	return 0
+}
+
+func (m *NamespaceAbsenceProof) GetRowProof() *RowProof {
+	if m != nil {
+		return m.RowProof
+	}
+	return nil
+}
+
+func (m *NamespaceAbsenceProof) GetAbsenceProof() *NMTProof {
+	if m != nil {
+		return m.AbsenceProof
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ShareProof)(nil), "celestia.core.v1.proof.ShareProof")
 	proto.RegisterType((*RowProof)(nil), "celestia.core.v1.proof.RowProof")
 	proto.RegisterType((*NMTProof)(nil), "celestia.core.v1.proof.NMTProof")
 	proto.RegisterType((*Proof)(nil), "celestia.core.v1.proof.Proof")
+	proto.RegisterType((*NamespaceAbsenceProof)(nil), "celestia.core.v1.proof.NamespaceAbsenceProof")
 }
 
 func init() {
@@ -342,35 +422,39 @@ func init() {
 }
 
 var fileDescriptor_e53d87d8fb5ec353 = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xc1, 0x8e, 0xd3, 0x30,
-	0x10, 0xad, 0x37, 0x6d, 0x09, 0x6e, 0x56, 0x5a, 0x2c, 0x04, 0x96, 0x10, 0x51, 0xc8, 0x29, 0x12,
-	0xda, 0x44, 0x0b, 0xe2, 0xc8, 0x05, 0x0e, 0xc0, 0x01, 0x84, 0x0c, 0xe2, 0xc0, 0xa5, 0xf2, 0x36,
-	0x6e, 0x13, 0xd1, 0xcd, 0x44, 0xb6, 0xb7, 0xe1, 0x33, 0xf8, 0x0c, 0x3e, 0x85, 0x63, 0x8f, 0x1c,
-	0x51, 0xfb, 0x0b, 0x7c, 0x00, 0xf2, 0xb8, 0x09, 0xaa, 0x04, 0x7b, 0xb1, 0xe6, 0xbd, 0x99, 0x79,
-	0x6f, 0x66, 0x64, 0x9a, 0x2e, 0xd4, 0x5a, 0x19, 0x5b, 0xcb, 0x62, 0x01, 0x5a, 0x15, 0x9b, 0x8b,
-	0xa2, 0xd5, 0x00, 0x4b, 0xff, 0xe6, 0xad, 0x06, 0x0b, 0xec, 0x5e, 0x5f, 0x93, 0xbb, 0x9a, 0x7c,
-	0x73, 0x91, 0x63, 0x36, 0xfd, 0x4d, 0x28, 0xfd, 0x50, 0x49, 0xad, 0xde, 0x3b, 0xc8, 0x18, 0x1d,
-	0x97, 0xd2, 0x4a, 0x4e, 0x92, 0x20, 0x8b, 0x04, 0xc6, 0xec, 0x25, 0x8d, 0x8c, 0xab, 0x98, 0x63,
-	0x87, 0xe1, 0x27, 0x49, 0x90, 0xcd, 0x9e, 0x24, 0xf9, 0xbf, 0x15, 0xf3, 0x77, 0x6f, 0x3f, 0xa2,
-	0x96, 0x98, 0x99, 0x41, 0xd7, 0xb0, 0x47, 0x34, 0x6a, 0xe4, 0x95, 0x32, 0xad, 0x5c, 0xa8, 0x79,
-	0x5d, 0xf2, 0x20, 0x21, 0x59, 0x24, 0x66, 0x03, 0xf7, 0xa6, 0x64, 0xcf, 0xe9, 0x6d, 0x0d, 0x9d,
-	0x77, 0xe1, 0xe3, 0x84, 0xdc, 0x64, 0x22, 0xa0, 0xf3, 0x26, 0xa1, 0x3e, 0x44, 0xec, 0x31, 0xbd,
-	0xf3, 0xd7, 0x61, 0xa3, 0xb4, 0xa9, 0xa1, 0xe1, 0x93, 0x84, 0x64, 0xa7, 0xe2, 0x6c, 0x48, 0x7c,
-	0xf2, 0x7c, 0xfa, 0x9d, 0xd0, 0xb0, 0xd7, 0x60, 0x0f, 0xbc, 0xb1, 0x06, 0xb0, 0xe6, 0xb0, 0xb9,
-	0x93, 0x15, 0x0e, 0xb3, 0x67, 0x74, 0x7a, 0xb4, 0xf7, 0xc3, 0xff, 0x8d, 0xe4, 0xe7, 0x39, 0x14,
-	0xbb, 0x43, 0x3a, 0xbd, 0xc3, 0x9e, 0x18, 0x3b, 0x1f, 0x63, 0xa5, 0xb6, 0x73, 0x0d, 0x1d, 0x2e,
-	0x78, 0x2a, 0x42, 0x24, 0x04, 0x74, 0xec, 0x3e, 0xbd, 0xa5, 0x9a, 0x12, 0x53, 0x7e, 0xe8, 0xa9,
-	0x6a, 0x4a, 0x01, 0x5d, 0xaa, 0x68, 0xd8, 0x9f, 0x94, 0xdd, 0xa5, 0x13, 0x6c, 0xe0, 0x24, 0x21,
-	0xd9, 0x44, 0x78, 0xc0, 0xce, 0x68, 0xa0, 0x9a, 0x92, 0x9f, 0x20, 0xe7, 0x42, 0x57, 0xd7, 0x40,
-	0xa9, 0x0c, 0x0f, 0x70, 0x1b, 0x0f, 0x9c, 0xff, 0x5a, 0xc9, 0xe5, 0xbc, 0x92, 0xa6, 0x42, 0xff,
-	0x48, 0x84, 0x8e, 0x78, 0x2d, 0x4d, 0x95, 0x2e, 0xe9, 0x64, 0xf0, 0xb0, 0x60, 0xe5, 0x1a, 0x3d,
-	0x02, 0xe1, 0x81, 0x63, 0xeb, 0xa6, 0x54, 0x5f, 0xd1, 0x25, 0x10, 0x1e, 0x1c, 0x2b, 0x06, 0xc7,
-	0x8a, 0xae, 0x45, 0x5e, 0x37, 0xd6, 0xf0, 0xb1, 0x1f, 0x02, 0xc1, 0x8b, 0x57, 0x3f, 0x76, 0x31,
-	0xd9, 0xee, 0x62, 0xf2, 0x6b, 0x17, 0x93, 0x6f, 0xfb, 0x78, 0xb4, 0xdd, 0xc7, 0xa3, 0x9f, 0xfb,
-	0x78, 0xf4, 0xf9, 0x7c, 0x55, 0xdb, 0xea, 0xfa, 0x32, 0x5f, 0xc0, 0x55, 0xd1, 0xdf, 0x18, 0xf4,
-	0x6a, 0x88, 0xcf, 0x65, 0xdb, 0x16, 0xed, 0x97, 0x95, 0xff, 0xd7, 0x97, 0x53, 0xfc, 0xd8, 0x4f,
-	0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x27, 0x8a, 0x77, 0xfe, 0x02, 0x00, 0x00,
+	// 497 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x8e, 0xd3, 0x30,
+	0x10, 0xae, 0x9b, 0xb6, 0x04, 0x37, 0x95, 0x16, 0x8b, 0x1f, 0x4b, 0x88, 0x28, 0xe4, 0x14, 0x09,
+	0x6d, 0xa2, 0x05, 0x71, 0xe4, 0x00, 0x08, 0x01, 0x07, 0x56, 0xc8, 0x20, 0x0e, 0x5c, 0x2a, 0x37,
+	0x71, 0x9b, 0x88, 0xae, 0x1d, 0xd9, 0xde, 0x86, 0xc7, 0xe0, 0x31, 0x78, 0x14, 0x8e, 0x7b, 0xe4,
+	0x88, 0xda, 0x33, 0x37, 0x1e, 0x00, 0xd9, 0x4e, 0x02, 0x85, 0x82, 0xe0, 0x12, 0xcd, 0x37, 0x1e,
+	0x7f, 0xdf, 0x4c, 0xe6, 0x33, 0x8c, 0x73, 0xb6, 0x66, 0x4a, 0x57, 0x34, 0xcb, 0x85, 0x64, 0xd9,
+	0xe6, 0x24, 0xab, 0xa5, 0x10, 0x4b, 0xf7, 0x4d, 0x6b, 0x29, 0xb4, 0x40, 0xd7, 0xbb, 0x9a, 0xd4,
+	0xd4, 0xa4, 0x9b, 0x93, 0xd4, 0x9e, 0xc6, 0xdf, 0x00, 0x84, 0xaf, 0x4a, 0x2a, 0xd9, 0x4b, 0x03,
+	0x11, 0x82, 0xa3, 0x82, 0x6a, 0x8a, 0x41, 0xe4, 0x25, 0x01, 0xb1, 0x31, 0x7a, 0x0c, 0x03, 0x65,
+	0x2a, 0xe6, 0xf6, 0x86, 0xc2, 0xc3, 0xc8, 0x4b, 0xa6, 0x77, 0xa3, 0xf4, 0x30, 0x63, 0x7a, 0xfa,
+	0xe2, 0xb5, 0xe5, 0x22, 0x53, 0xd5, 0xf3, 0x2a, 0x74, 0x1b, 0x06, 0x9c, 0x9e, 0x31, 0x55, 0xd3,
+	0x9c, 0xcd, 0xab, 0x02, 0x7b, 0x11, 0x48, 0x02, 0x32, 0xed, 0x73, 0xcf, 0x0b, 0xf4, 0x00, 0x5e,
+	0x96, 0xa2, 0x71, 0x2a, 0x78, 0x14, 0x81, 0xbf, 0x89, 0x10, 0xd1, 0x38, 0x11, 0x5f, 0xb6, 0x11,
+	0xba, 0x03, 0xaf, 0xfc, 0x50, 0xd8, 0x30, 0xa9, 0x2a, 0xc1, 0xf1, 0x38, 0x02, 0xc9, 0x8c, 0x1c,
+	0xf5, 0x07, 0x6f, 0x5c, 0x3e, 0xfe, 0x08, 0xa0, 0xdf, 0x71, 0xa0, 0x9b, 0x4e, 0x58, 0x0a, 0xa1,
+	0x55, 0x3b, 0xb9, 0xa1, 0x25, 0x06, 0xa3, 0xfb, 0x70, 0xb2, 0x37, 0xf7, 0xad, 0x3f, 0xb5, 0xe4,
+	0xfa, 0x69, 0x8b, 0xcd, 0x8f, 0x34, 0x7c, 0xed, 0x9c, 0x36, 0x36, 0x3a, 0x4a, 0x53, 0xa9, 0xe7,
+	0x52, 0x34, 0x76, 0xc0, 0x19, 0xf1, 0x6d, 0x82, 0x88, 0x06, 0xdd, 0x80, 0x97, 0x18, 0x2f, 0xec,
+	0x91, 0x6b, 0x7a, 0xc2, 0x78, 0x41, 0x44, 0x13, 0x33, 0xe8, 0x77, 0xbf, 0x14, 0x5d, 0x85, 0x63,
+	0x7b, 0x01, 0x83, 0x08, 0x24, 0x63, 0xe2, 0x00, 0x3a, 0x82, 0x1e, 0xe3, 0x05, 0x1e, 0xda, 0x9c,
+	0x09, 0x4d, 0x1d, 0x17, 0x05, 0x53, 0xd8, 0xb3, 0xd3, 0x38, 0x60, 0xf4, 0xd7, 0x8c, 0x2e, 0xe7,
+	0x25, 0x55, 0xa5, 0xd5, 0x0f, 0x88, 0x6f, 0x12, 0xcf, 0xa8, 0x2a, 0xe3, 0x25, 0x1c, 0xf7, 0x1a,
+	0x5a, 0x68, 0xba, 0xb6, 0x1a, 0x1e, 0x71, 0xc0, 0x64, 0x2b, 0x5e, 0xb0, 0xf7, 0x56, 0xc5, 0x23,
+	0x0e, 0xec, 0x33, 0x7a, 0xfb, 0x8c, 0xe6, 0x0a, 0x3d, 0xe7, 0x5a, 0xe1, 0x91, 0x6b, 0xc2, 0x82,
+	0xf8, 0x2b, 0x80, 0xd7, 0x4e, 0xbb, 0x75, 0x3c, 0x5c, 0x28, 0xc6, 0xf3, 0xd6, 0x7b, 0xbf, 0x5a,
+	0x04, 0xfc, 0x6e, 0x91, 0x83, 0x3b, 0x1e, 0x1e, 0xde, 0xf1, 0xbe, 0x9f, 0xbc, 0xff, 0xf6, 0xd3,
+	0x13, 0x38, 0xa3, 0xae, 0xbd, 0x7f, 0xb3, 0x64, 0xef, 0xfb, 0x80, 0xfe, 0x34, 0xd5, 0xa3, 0xa7,
+	0x9f, 0xb6, 0x21, 0xb8, 0xd8, 0x86, 0xe0, 0xcb, 0x36, 0x04, 0x1f, 0x76, 0xe1, 0xe0, 0x62, 0x17,
+	0x0e, 0x3e, 0xef, 0xc2, 0xc1, 0xdb, 0xe3, 0x55, 0xa5, 0xcb, 0xf3, 0x45, 0x9a, 0x8b, 0xb3, 0xac,
+	0xe3, 0x14, 0x72, 0xd5, 0xc7, 0xc7, 0xb4, 0xae, 0xb3, 0xfa, 0xdd, 0xca, 0xbd, 0xe3, 0xc5, 0xc4,
+	0x3e, 0xe4, 0x7b, 0xdf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x9f, 0x80, 0x73, 0x7a, 0xee, 0x03, 0x00,
+	0x00,
 }
 
 func (m *ShareProof) Marshal() (dAtA []byte, err error) {
@@ -604,6 +688,65 @@ func (m *Proof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NamespaceAbsenceProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceAbsenceProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceAbsenceProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AbsenceProof != nil {
+		{
+			size, err := m.AbsenceProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProof(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RowProof != nil {
+		{
+			size, err := m.RowProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProof(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NamespaceVersion != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.NamespaceVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintProof(dAtA []byte, offset int, v uint64) int {
 	offset -= sovProof(v)
 	base := offset
@@ -728,6 +871,30 @@ func (m *Proof) Size() (n int) {
 	return n
 }
 
+func (m *NamespaceAbsenceProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.NamespaceVersion != 0 {
+		n += 1 + sovProof(uint64(m.NamespaceVersion))
+	}
+	if m.RowProof != nil {
+		l = m.RowProof.Size()
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.AbsenceProof != nil {
+		l = m.AbsenceProof.Size()
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
 func sovProof(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1435,6 +1602,181 @@ func (m *Proof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *NamespaceAbsenceProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceAbsenceProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceAbsenceProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = append(m.NamespaceId[:0], dAtA[iNdEx:postIndex]...)
+			if m.NamespaceId == nil {
+				m.NamespaceId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceVersion", wireType)
+			}
+			m.NamespaceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NamespaceVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RowProof == nil {
+				m.RowProof = &RowProof{}
+			}
+			if err := m.RowProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbsenceProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AbsenceProof == nil {
+				m.AbsenceProof = &NMTProof{}
+			}
+			if err := m.AbsenceProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipProof(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
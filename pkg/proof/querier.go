@@ -2,6 +2,7 @@ package proof
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
 	"math"
 	"strconv"
@@ -126,6 +127,56 @@ func QueryShareInclusionProof(_ sdk.Context, path []string, req *abci.RequestQue
 	return rawShareProof, nil
 }
 
+const NamespaceAbsenceQueryPath = "namespaceAbsenceProof"
+
+// QueryNamespaceAbsenceProof defines the logic performed when querying for a
+// proof that a namespace has no data in the data square of a block. The
+// hex-encoded namespace should be appended to the path. Example path for
+// proving the absence of a namespace:
+// custom/namespaceAbsenceProof/0000000000000000000000000000000000000000000000000000000001
+func QueryNamespaceAbsenceProof(_ sdk.Context, path []string, req *abci.RequestQuery) ([]byte, error) {
+	// parse the namespace from the path
+	if len(path) != 1 {
+		return nil, fmt.Errorf("expected query path length: 1 actual: %d ", len(path))
+	}
+	rawNamespace, err := hex.DecodeString(path[0])
+	if err != nil {
+		return nil, fmt.Errorf("error decoding namespace: %w", err)
+	}
+	namespace, err := share.NewNamespaceFromBytes(rawNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// unmarshal the block data that is passed from the ABCI client
+	pbb := new(tmproto.Block)
+	err = pbb.Unmarshal(req.Data)
+	if err != nil {
+		return nil, fmt.Errorf("error reading block: %w", err)
+	}
+
+	// construct the data square from the block data. As we don't have
+	// access to the application's state machine we use the upper bound
+	// square size instead of the square size dictated from governance
+	dataSquare, err := square.Construct(pbb.Data.Txs, appconsts.SquareSizeUpperBound, appconsts.SubtreeRootThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	// create and marshal the namespace absence proof, which we return in the form of []byte
+	absenceProof, err := NewNamespaceAbsenceProof(dataSquare, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	rawAbsenceProof, err := absenceProof.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return rawAbsenceProof, nil
+}
+
 // ParseNamespace validates the share range, checks if it only contains one namespace and returns
 // that namespace ID.
 // The provided range, defined by startShare and endShare, is end-exclusive.
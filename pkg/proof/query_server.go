@@ -0,0 +1,92 @@
+package proof
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/celestiaorg/go-square/v2/share"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/cosmos/cosmos-sdk/client"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RegisterQueryService registers the proof query service on the gRPC router.
+func RegisterQueryService(qrt gogogrpc.Server, clientCtx client.Context) {
+	RegisterQueryServer(qrt, NewQueryServer(clientCtx))
+}
+
+// RegisterGRPCGatewayRoutes mounts the proof query service's GRPC-gateway
+// routes on the given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	err := RegisterQueryHandlerClient(context.Background(), mux, NewQueryClient(clientConn))
+	if err != nil {
+		panic(err)
+	}
+}
+
+var _ QueryServer = &queryServer{}
+
+type queryServer struct {
+	clientCtx client.Context
+}
+
+func NewQueryServer(clientCtx client.Context) QueryServer {
+	return &queryServer{clientCtx: clientCtx}
+}
+
+// NamespaceBlobs implements the QueryServer.NamespaceBlobs method. It fetches
+// the block from the underlying celestia-core RPC server and rebuilds its data
+// square to extract the blobs and their proofs. It returns an error if the data
+// root of the rebuilt square doesn't match the data hash of the block.
+func (s *queryServer) NamespaceBlobs(ctx context.Context, req *QueryNamespaceBlobsRequest) (*QueryNamespaceBlobsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if req.Height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "height cannot be negative")
+	}
+
+	namespace, err := share.NewNamespaceFromBytes(req.Namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid namespace: %s", err)
+	}
+	if err := namespace.ValidateForBlob(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid namespace: %s", err)
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	blockClient, ok := node.(rpcclient.SignClient)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "node does not support querying blocks")
+	}
+
+	var height *int64
+	if req.Height != 0 {
+		height = &req.Height
+	}
+	resBlock, err := blockClient.Block(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	blobs, dataRoot, err := NewNamespaceBlobs(resBlock.Block.Txs.ToSliceOfBytes(), namespace, resBlock.Block.Version.App)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "extracting blobs: %s", err)
+	}
+	if !bytes.Equal(dataRoot, resBlock.Block.DataHash) {
+		return nil, status.Errorf(codes.Internal, "the data root %X of the rebuilt square doesn't match the data hash %X of block %d", dataRoot, resBlock.Block.DataHash, resBlock.Block.Height)
+	}
+
+	return &QueryNamespaceBlobsResponse{
+		Height:   resBlock.Block.Height,
+		DataRoot: dataRoot,
+		Blobs:    blobs,
+	}, nil
+}
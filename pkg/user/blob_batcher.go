@@ -0,0 +1,210 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v5/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	"google.golang.org/grpc"
+)
+
+const (
+	// pfbTxOverhead is a conservative estimate of the bytes of a PayForBlobs
+	// transaction that don't depend on its blobs, i.e. the signer, the fee,
+	// the signature and a short memo.
+	pfbTxOverhead = 1024
+	// pfbBlobOverhead is a conservative estimate of the bytes that every blob
+	// adds to a PayForBlobs transaction besides its data, i.e. its namespace,
+	// size, share version and share commitment in the MsgPayForBlobs and its
+	// namespace, version and signer in the BlobTx.
+	pfbBlobOverhead = 256
+)
+
+// ErrBlobTooLarge is returned for a blob that doesn't fit in a PayForBlobs
+// transaction on its own.
+var ErrBlobTooLarge = errors.New("blob does not fit in a PayForBlobs transaction")
+
+// BlobLimits are the limits that the PayForBlobs transactions of a batch
+// submission respect.
+type BlobLimits struct {
+	// MaxTxSize is the max size in bytes of a BlobTx.
+	MaxTxSize int
+	// MaxBlobShares is the max number of shares that the blobs and the
+	// PayForBlobs transaction can occupy. See the BlobShareDecorator.
+	MaxBlobShares int
+	// MaxPFBsPerBlock is the max number of PayForBlobs messages that a block
+	// can contain.
+	MaxPFBsPerBlock int
+}
+
+// BlobResult is the result of a blob submitted with SubmitBlobBatch.
+type BlobResult struct {
+	// Index is the index of the blob in the submitted blobs.
+	Index int
+	// TxHash is the hash of the PayForBlobs transaction that paid for the
+	// blob. It is empty if the transaction was not broadcast.
+	TxHash string
+	// Height is the height at which the transaction was committed.
+	Height int64
+	// Err is the error that prevented the blob from being committed, if any.
+	Err error
+}
+
+// QueryBlobLimits queries the limits that a PayForBlobs transaction must
+// respect from the current x/blob and consensus params.
+func (client *TxClient) QueryBlobLimits(ctx context.Context) (BlobLimits, error) {
+	var blobParams *types.QueryParamsResponse
+	err := client.query(ctx, func(conn *grpc.ClientConn) (err error) {
+		blobParams, err = types.NewQueryClient(conn).Params(ctx, &types.QueryParamsRequest{})
+		return err
+	})
+	if err != nil {
+		return BlobLimits{}, fmt.Errorf("querying the blob params: %w", err)
+	}
+	squareSize := min(int(blobParams.Params.GovMaxSquareSize), appconsts.GetSquareSizeUpperBound(client.signer.ChainID()))
+
+	maxTxSize := appconsts.MaxTxSize
+	var consensusParams *consensustypes.QueryParamsResponse
+	err = client.query(ctx, func(conn *grpc.ClientConn) (err error) {
+		consensusParams, err = consensustypes.NewQueryClient(conn).Params(ctx, &consensustypes.QueryParamsRequest{})
+		return err
+	})
+	if err != nil {
+		return BlobLimits{}, fmt.Errorf("querying the consensus params: %w", err)
+	}
+	if block := consensusParams.Params.GetBlock(); block != nil && block.MaxBytes > 0 {
+		maxTxSize = min(maxTxSize, int(block.MaxBytes))
+	}
+
+	return BlobLimits{
+		MaxTxSize:       maxTxSize,
+		MaxBlobShares:   squareSize * squareSize,
+		MaxPFBsPerBlock: appconsts.MaxPFBMessages,
+	}, nil
+}
+
+// batch is a set of blobs that are paid for by a single PayForBlobs
+// transaction.
+type batch struct {
+	indices []int
+	txSize  int
+	shares  int
+}
+
+// fits returns true if the blob can be added to the batch without exceeding
+// the limits.
+func (b *batch) fits(blob *share.Blob, limits BlobLimits) bool {
+	txSize := b.txSize + len(blob.Data()) + pfbBlobOverhead
+	pfbSize := pfbTxOverhead + (len(b.indices)+1)*pfbBlobOverhead
+	shares := b.shares + share.SparseSharesNeeded(uint32(len(blob.Data())))
+	return txSize <= limits.MaxTxSize &&
+		shares+share.CompactSharesNeeded(uint32(pfbSize)) <= limits.MaxBlobShares
+}
+
+// add adds the blob to the batch.
+func (b *batch) add(index int, blob *share.Blob) {
+	b.indices = append(b.indices, index)
+	b.txSize += len(blob.Data()) + pfbBlobOverhead
+	b.shares += share.SparseSharesNeeded(uint32(len(blob.Data())))
+}
+
+// PackBlobs packs the blobs into as few PayForBlobs transactions as possible
+// without exceeding the limits, using a first fit decreasing strategy. It
+// returns the indices of the blobs of every transaction, in ascending order,
+// and the indices of the blobs that don't fit in a transaction on their own.
+func PackBlobs(blobs []*share.Blob, limits BlobLimits) (batches [][]int, tooLarge []int) {
+	order := make([]int, len(blobs))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return len(blobs[order[i]].Data()) > len(blobs[order[j]].Data())
+	})
+
+	packed := make([]*batch, 0)
+	for _, index := range order {
+		blob := blobs[index]
+		added := false
+		for _, b := range packed {
+			if b.fits(blob, limits) {
+				b.add(index, blob)
+				added = true
+				break
+			}
+		}
+		if added {
+			continue
+		}
+		b := &batch{txSize: pfbTxOverhead}
+		if !b.fits(blob, limits) {
+			tooLarge = append(tooLarge, index)
+			continue
+		}
+		b.add(index, blob)
+		packed = append(packed, b)
+	}
+
+	batches = make([][]int, len(packed))
+	for i, b := range packed {
+		sort.Ints(b.indices)
+		batches[i] = b.indices
+	}
+	sort.Ints(tooLarge)
+	return batches, tooLarge
+}
+
+// SubmitBlobBatch packs the blobs into as few PayForBlobs transactions as the
+// current limits allow (see QueryBlobLimits and PackBlobs), submits them with
+// SubmitPayForBlob and waits for them to be confirmed. Blobs may be paid for
+// in a different order than they are provided. At most MaxPFBsPerBlock
+// transactions are in flight at a time. The TxOptions are applied to every
+// transaction.
+//
+// It returns the result of every blob, in the order of the provided blobs. An
+// error is only returned if the limits can't be queried.
+func (client *TxClient) SubmitBlobBatch(ctx context.Context, blobs []*share.Blob, opts ...TxOption) ([]BlobResult, error) {
+	limits, err := client.QueryBlobLimits(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]BlobResult, len(blobs))
+	for i := range results {
+		results[i].Index = i
+	}
+	batches, tooLarge := PackBlobs(blobs, limits)
+	for _, index := range tooLarge {
+		results[index].Err = fmt.Errorf("%w: blob %d of %d bytes", ErrBlobTooLarge, index, len(blobs[index].Data()))
+	}
+
+	for start := 0; start < len(batches); start += limits.MaxPFBsPerBlock {
+		end := min(start+limits.MaxPFBsPerBlock, len(batches))
+		var wg sync.WaitGroup
+		for _, indices := range batches[start:end] {
+			wg.Add(1)
+			go func(indices []int) {
+				defer wg.Done()
+				batchBlobs := make([]*share.Blob, len(indices))
+				for i, index := range indices {
+					batchBlobs[i] = blobs[index]
+				}
+				resp, err := client.SubmitPayForBlob(ctx, batchBlobs, opts...)
+				for _, index := range indices {
+					results[index].Err = err
+					if resp != nil {
+						results[index].TxHash = resp.TxHash
+						results[index].Height = resp.Height
+					}
+				}
+			}(indices)
+		}
+		wg.Wait()
+	}
+	return results, nil
+}
@@ -0,0 +1,91 @@
+package user_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v5/pkg/user"
+	"github.com/celestiaorg/celestia-app/v5/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v5/test/util/random"
+	"github.com/celestiaorg/celestia-app/v5/test/util/testnode"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackBlobs(t *testing.T) {
+	limits := user.BlobLimits{MaxTxSize: 10_000, MaxBlobShares: 64, MaxPFBsPerBlock: 10}
+
+	testCases := []struct {
+		name         string
+		limits       user.BlobLimits
+		sizes        []int
+		wantBatches  [][]int
+		wantTooLarge []int
+	}{
+		{
+			name:        "small blobs share a single tx",
+			limits:      limits,
+			sizes:       []int{100, 200, 300},
+			wantBatches: [][]int{{0, 1, 2}},
+		},
+		{
+			name:        "blobs are packed first fit decreasing",
+			limits:      limits,
+			sizes:       []int{3000, 6000, 5000, 2000, 1000},
+			wantBatches: [][]int{{1, 3}, {0, 2}, {4}},
+		},
+		{
+			name:         "blobs exceeding the tx size are reported",
+			limits:       limits,
+			sizes:        []int{100, 20_000},
+			wantBatches:  [][]int{{0}},
+			wantTooLarge: []int{1},
+		},
+		{
+			name:         "blobs exceeding the square are reported",
+			limits:       user.BlobLimits{MaxTxSize: 1_000_000, MaxBlobShares: 64, MaxPFBsPerBlock: 10},
+			sizes:        []int{64 * share.ShareSize},
+			wantBatches:  [][]int{},
+			wantTooLarge: []int{0},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			blobs := blobfactory.ManyRandBlobs(random.New(), tc.sizes...)
+			batches, tooLarge := user.PackBlobs(blobs, tc.limits)
+			require.Equal(t, tc.wantBatches, batches)
+			require.Equal(t, tc.wantTooLarge, tooLarge)
+		})
+	}
+}
+
+func TestSubmitBlobBatch(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode.")
+	}
+	_, txClient, ctx := setupTxClient(t, testnode.DefaultTendermintConfig().Mempool.TTLDuration)
+	subCtx, cancel := context.WithTimeout(ctx.GoContext(), time.Minute)
+	defer cancel()
+
+	limits, err := txClient.QueryBlobLimits(subCtx)
+	require.NoError(t, err)
+	require.Equal(t, appconsts.MaxPFBMessages, limits.MaxPFBsPerBlock)
+	require.Positive(t, limits.MaxBlobShares)
+
+	blobs := blobfactory.ManyRandBlobs(random.New(), 1e3, 2e3, limits.MaxTxSize+1, 3e3)
+	results, err := txClient.SubmitBlobBatch(subCtx, blobs)
+	require.NoError(t, err)
+	require.Len(t, results, len(blobs))
+
+	require.ErrorIs(t, results[2].Err, user.ErrBlobTooLarge)
+	require.Empty(t, results[2].TxHash)
+	for _, i := range []int{0, 1, 3} {
+		require.Equal(t, i, results[i].Index)
+		require.NoError(t, results[i].Err)
+		require.NotZero(t, results[i].Height)
+		// the blobs that fit are paid for by a single tx
+		require.Equal(t, results[0].TxHash, results[i].TxHash)
+	}
+}
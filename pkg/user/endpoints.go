@@ -0,0 +1,285 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v5/app/grpc/tx"
+	"github.com/cometbft/cometbft/rpc/core"
+	tmservice "github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultHealthCheckInterval is the default interval with which the
+	// endpoints are health checked by StartHealthChecks.
+	DefaultHealthCheckInterval = 30 * time.Second
+	// latencySmoothing is the weight of a new latency sample in the moving
+	// average latency of an endpoint.
+	latencySmoothing = 0.3
+)
+
+// BroadcastMode defines which endpoints the transactions are broadcast to.
+type BroadcastMode int
+
+const (
+	// BroadcastToAll broadcasts every transaction to all the endpoints
+	// concurrently and uses the first successful response.
+	BroadcastToAll BroadcastMode = iota
+	// BroadcastToBest broadcasts every transaction to the healthy endpoint with
+	// the lowest latency, falling back to the next endpoint if it is
+	// unreachable.
+	BroadcastToBest
+)
+
+// WithBroadcastMode sets which endpoints the transactions are broadcast to.
+// The default is BroadcastToAll.
+func WithBroadcastMode(mode BroadcastMode) Option {
+	return func(c *TxClient) {
+		c.broadcastMode = mode
+	}
+}
+
+// EndpointStatus is the health of a core endpoint as observed by the TxClient.
+type EndpointStatus struct {
+	// Target is the target of the connection of the endpoint.
+	Target string
+	// Healthy is false if the last request to the endpoint failed to reach it
+	// or if the node is syncing.
+	Healthy bool
+	// Latency is the moving average latency of the requests to the endpoint.
+	Latency time.Duration
+	// Failures is the number of consecutive failed requests to the endpoint.
+	Failures int
+	// LastChecked is the time of the last request to the endpoint.
+	LastChecked time.Time
+}
+
+// endpoint is a core endpoint and its observed health.
+type endpoint struct {
+	conn   *grpc.ClientConn
+	status EndpointStatus
+}
+
+// endpoints tracks the health and latency of the core endpoints of the
+// TxClient. Requests are routed to the healthy endpoints with the lowest
+// latency first and fall back to the other endpoints if they are unreachable.
+type endpoints struct {
+	mtx  sync.Mutex
+	list []*endpoint
+}
+
+// newEndpoints returns the endpoints of the connections. All endpoints are
+// assumed to be healthy until a request to them fails.
+func newEndpoints(conns []*grpc.ClientConn) *endpoints {
+	list := make([]*endpoint, len(conns))
+	for i, conn := range conns {
+		list[i] = &endpoint{
+			conn:   conn,
+			status: EndpointStatus{Target: conn.Target(), Healthy: true},
+		}
+	}
+	return &endpoints{list: list}
+}
+
+// ordered returns the endpoints in the order that requests should be routed
+// to them: the healthy endpoints by ascending latency and then the unhealthy
+// ones, which are only used as a last resort. Ties keep the order in which
+// the endpoints were provided, so the primary endpoint comes first. If first
+// is the connection of one of the endpoints, that endpoint comes first
+// regardless of its health.
+func (e *endpoints) ordered(first *grpc.ClientConn) []*endpoint {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+	ordered := append([]*endpoint{}, e.list...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if first != nil && (ordered[i].conn == first) != (ordered[j].conn == first) {
+			return ordered[i].conn == first
+		}
+		if ordered[i].status.Healthy != ordered[j].status.Healthy {
+			return ordered[i].status.Healthy
+		}
+		return ordered[i].status.Latency < ordered[j].status.Latency
+	})
+	return ordered
+}
+
+// record updates the health of the endpoint with the outcome of a request.
+// Errors that were returned by the node, rather than caused by the endpoint
+// being unreachable, count as a success.
+func (e *endpoints) record(ep *endpoint, latency time.Duration, err error) {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+	ep.status.LastChecked = time.Now()
+	if isUnreachable(err) {
+		ep.status.Healthy = false
+		ep.status.Failures++
+		return
+	}
+	ep.status.Healthy = true
+	ep.status.Failures = 0
+	if ep.status.Latency == 0 {
+		ep.status.Latency = latency
+		return
+	}
+	ep.status.Latency = time.Duration(latencySmoothing*float64(latency) + (1-latencySmoothing)*float64(ep.status.Latency))
+}
+
+// markUnhealthy marks the endpoint as unhealthy, e.g. because the node is
+// syncing.
+func (e *endpoints) markUnhealthy(ep *endpoint) {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+	ep.status.Healthy = false
+	ep.status.Failures++
+	ep.status.LastChecked = time.Now()
+}
+
+// statuses returns the status of the endpoints in the order they were
+// provided.
+func (e *endpoints) statuses() []EndpointStatus {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+	statuses := make([]EndpointStatus, len(e.list))
+	for i, ep := range e.list {
+		statuses[i] = ep.status
+	}
+	return statuses
+}
+
+// do calls fn with the connections of the endpoints in routing order until
+// the endpoint of a call is reachable and returns the error of that call. The
+// health of the endpoints is updated with the outcome of every call.
+func (e *endpoints) do(ctx context.Context, fn func(conn *grpc.ClientConn) error) error {
+	var errs []error
+	for _, ep := range e.ordered(nil) {
+		start := time.Now()
+		err := fn(ep.conn)
+		if ctx.Err() != nil {
+			// the caller gave up, which says nothing about the endpoint
+			return errors.Join(append(errs, err)...)
+		}
+		e.record(ep, time.Since(start), err)
+		if !isUnreachable(err) {
+			return err
+		}
+		errs = append(errs, fmt.Errorf("endpoint %s: %w", ep.status.Target, err))
+	}
+	return errors.Join(errs...)
+}
+
+// check health checks the endpoint. The endpoint is unhealthy if it is
+// unreachable or if the node is syncing.
+func (e *endpoints) check(ctx context.Context, ep *endpoint) {
+	start := time.Now()
+	resp, err := tmservice.NewServiceClient(ep.conn).GetSyncing(ctx, &tmservice.GetSyncingRequest{})
+	if ctx.Err() != nil {
+		return
+	}
+	e.record(ep, time.Since(start), err)
+	if err != nil || resp.Syncing {
+		e.markUnhealthy(ep)
+	}
+}
+
+// isUnreachable returns true if the error was caused by the endpoint being
+// unreachable, in which case the request can be retried with another
+// endpoint.
+func isUnreachable(err error) bool {
+	if err == nil {
+		return false
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// query calls fn with the connection of the best endpoint, falling back to
+// the next endpoints if it is unreachable. It is used for all the queries of
+// the client except the status of transactions, see txStatus.
+func (client *TxClient) query(ctx context.Context, fn func(conn *grpc.ClientConn) error) error {
+	return client.endpoints.do(ctx, fn)
+}
+
+// txStatus queries the status of the transaction on the endpoint that
+// accepted it first, if it is known, then on the other endpoints in routing
+// order. A node only knows the transactions that reached its mempool so the
+// status is only UNKNOWN if none of the reachable endpoints knows the
+// transaction.
+func (client *TxClient) txStatus(ctx context.Context, txHash string, accepted *grpc.ClientConn) (*tx.TxStatusResponse, error) {
+	var (
+		unknown *tx.TxStatusResponse
+		errs    []error
+	)
+	for _, ep := range client.endpoints.ordered(accepted) {
+		start := time.Now()
+		resp, err := tx.NewTxClient(ep.conn).TxStatus(ctx, &tx.TxStatusRequest{TxId: txHash})
+		if ctx.Err() != nil {
+			return nil, errors.Join(append(errs, ctx.Err())...)
+		}
+		client.endpoints.record(ep, time.Since(start), err)
+		switch {
+		case isUnreachable(err):
+			errs = append(errs, fmt.Errorf("endpoint %s: %w", ep.status.Target, err))
+		case err != nil:
+			return nil, err
+		case resp.Status != core.TxStatusUnknown:
+			return resp, nil
+		default:
+			unknown = resp
+		}
+	}
+	if unknown != nil {
+		return unknown, nil
+	}
+	return nil, errors.Join(errs...)
+}
+
+// CheckEndpoints health checks all the core endpoints of the client and
+// returns their status. The requests are routed away from the unhealthy
+// endpoints.
+func (client *TxClient) CheckEndpoints(ctx context.Context) []EndpointStatus {
+	var wg sync.WaitGroup
+	for _, ep := range client.endpoints.list {
+		wg.Add(1)
+		go func(ep *endpoint) {
+			defer wg.Done()
+			client.endpoints.check(ctx, ep)
+		}(ep)
+	}
+	wg.Wait()
+	return client.endpoints.statuses()
+}
+
+// StartHealthChecks health checks the core endpoints of the client every
+// interval until the context is cancelled. Without health checks, the health
+// of the endpoints is only updated by the requests of the client.
+func (client *TxClient) StartHealthChecks(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			client.CheckEndpoints(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Endpoints returns the status of the core endpoints of the client, the
+// primary endpoint first.
+func (client *TxClient) Endpoints() []EndpointStatus {
+	return client.endpoints.statuses()
+}
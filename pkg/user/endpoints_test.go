@@ -0,0 +1,246 @@
+package user_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v5/app"
+	"github.com/celestiaorg/celestia-app/v5/app/encoding"
+	"github.com/celestiaorg/celestia-app/v5/app/grpc/gasestimation"
+	"github.com/celestiaorg/celestia-app/v5/app/grpc/tx"
+	"github.com/celestiaorg/celestia-app/v5/app/params"
+	"github.com/celestiaorg/celestia-app/v5/pkg/user"
+	"github.com/celestiaorg/celestia-app/v5/test/util/grpctest"
+	"github.com/celestiaorg/celestia-app/v5/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v5/test/util/testnode"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/rpc/core"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestEndpointRouting(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode.")
+	}
+	encCfg, txClient, ctx := setupTxClient(t, testnode.DefaultTendermintConfig().Mempool.TTLDuration)
+
+	// nothing listens on the port of the dead endpoint
+	port, err := testnode.GetFreePort()
+	require.NoError(t, err)
+	deadConn, err := grpc.NewClient(fmt.Sprintf("localhost:%d", port), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = deadConn.Close() })
+
+	newClient := func(t *testing.T, opts ...user.Option) *user.TxClient {
+		// the accounts are loaded through the endpoints
+		signer, err := user.NewSigner(ctx.Keyring, encCfg.TxConfig, txClient.Signer().ChainID())
+		require.NoError(t, err)
+		opts = append([]user.Option{user.WithAdditionalCoreEndpoints([]*grpc.ClientConn{ctx.GRPCClient})}, opts...)
+		client, err := user.NewTxClient(encCfg.Codec, signer, deadConn, encCfg.InterfaceRegistry, opts...)
+		require.NoError(t, err)
+		return client
+	}
+	submit := func(t *testing.T, client *user.TxClient) {
+		subCtx, cancel := context.WithTimeout(ctx.GoContext(), time.Minute)
+		defer cancel()
+		sender := client.DefaultAddress()
+		msg := bank.NewMsgSend(sender, testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(params.BondDenom, 10)))
+		resp, err := client.SubmitTx(subCtx, []sdk.Msg{msg}, user.SetGasLimit(1e6), user.SetFee(1e6))
+		require.NoError(t, err)
+		require.NotZero(t, resp.Height)
+		// the primary endpoint can't be reached so it is routed around
+		endpoints := client.Endpoints()
+		require.Len(t, endpoints, 2)
+		require.False(t, endpoints[0].Healthy)
+		require.Positive(t, endpoints[0].Failures)
+		require.True(t, endpoints[1].Healthy)
+		require.Positive(t, endpoints[1].Latency)
+	}
+
+	t.Run("broadcast to best", func(t *testing.T) {
+		submit(t, newClient(t, user.WithBroadcastMode(user.BroadcastToBest)))
+	})
+
+	t.Run("broadcast to all", func(t *testing.T) {
+		submit(t, newClient(t))
+	})
+
+	t.Run("health checks", func(t *testing.T) {
+		client := newClient(t)
+		subCtx, cancel := context.WithTimeout(ctx.GoContext(), 10*time.Second)
+		defer cancel()
+		endpoints := client.CheckEndpoints(subCtx)
+		require.False(t, endpoints[0].Healthy)
+		require.True(t, endpoints[1].Healthy)
+
+		// the gas estimation falls back to the healthy endpoint
+		_, err := client.EstimateGasPrice(subCtx, 0)
+		require.NoError(t, err)
+	})
+}
+
+// TestConfirmTxAcrossEndpoints tests that the status of a transaction that is
+// unknown to an endpoint is queried on the other endpoints before it is
+// treated as unknown.
+func TestConfirmTxAcrossEndpoints(t *testing.T) {
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	newEndpoint := func(t *testing.T, status func(txHash string) *tx.TxStatusResponse) *grpc.ClientConn {
+		return grpctest.StartMockServer(t, &grpctest.MockTxService{
+			BroadcastHandler: func(_ context.Context, req *sdktx.BroadcastTxRequest) (*sdktx.BroadcastTxResponse, error) {
+				hash := fmt.Sprintf("%X", sha256.Sum256(req.TxBytes))
+				return &sdktx.BroadcastTxResponse{TxResponse: &sdk.TxResponse{Code: abci.CodeTypeOK, TxHash: hash}}, nil
+			},
+			TxStatusHandler: func(_ context.Context, req *tx.TxStatusRequest) (*tx.TxStatusResponse, error) {
+				return status(req.TxId), nil
+			},
+		})
+	}
+	withStatus := func(status string) func(string) *tx.TxStatusResponse {
+		return func(string) *tx.TxStatusResponse {
+			return &tx.TxStatusResponse{Status: status, Height: 10}
+		}
+	}
+	broadcast := func(t *testing.T, conns ...*grpc.ClientConn) (*user.TxClient, string) {
+		kr := testfactory.TestKeyring(enc.Codec, "a")
+		signer, err := user.NewSigner(kr, enc.TxConfig, "chain", user.NewAccount("a", 1, 0))
+		require.NoError(t, err)
+		client, err := user.NewTxClient(enc.Codec, signer, conns[0], enc.InterfaceRegistry,
+			user.WithAdditionalCoreEndpoints(conns[1:]), user.WithPollTime(time.Millisecond))
+		require.NoError(t, err)
+		sender := client.Signer().Account("a")
+		msg := bank.NewMsgSend(sender.Address(), testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(params.BondDenom, 10)))
+		resp, err := client.BroadcastTx(context.Background(), []sdk.Msg{msg}, user.SetGasLimit(1e6), user.SetFee(1e6))
+		require.NoError(t, err)
+		return client, resp.TxHash
+	}
+
+	t.Run("committed on another endpoint", func(t *testing.T) {
+		client, hash := broadcast(t, newEndpoint(t, withStatus(core.TxStatusUnknown)), newEndpoint(t, withStatus(core.TxStatusCommitted)))
+		resp, err := client.ConfirmTx(context.Background(), hash)
+		require.NoError(t, err)
+		require.EqualValues(t, 10, resp.Height)
+		require.Empty(t, client.InFlightTxs())
+	})
+
+	t.Run("unknown on all endpoints", func(t *testing.T) {
+		client, hash := broadcast(t, newEndpoint(t, withStatus(core.TxStatusUnknown)), newEndpoint(t, withStatus(core.TxStatusUnknown)))
+		_, err := client.ConfirmTx(context.Background(), hash)
+		require.ErrorContains(t, err, "not found")
+		// the tx was likely rejected so it is no longer tracked
+		require.Empty(t, client.InFlightTxs())
+	})
+
+	t.Run("context cancelled after the status is returned", func(t *testing.T) {
+		var cancelled atomic.Bool
+		status := func(string) *tx.TxStatusResponse {
+			cancelled.Store(true)
+			return &tx.TxStatusResponse{Status: core.TxStatusPending}
+		}
+		client, hash := broadcast(t, newEndpoint(t, status))
+		_, err := client.ConfirmTx(cancelledCtx{Context: context.Background(), cancelled: &cancelled}, hash)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		client, hash := broadcast(t, newEndpoint(t, withStatus(core.TxStatusRejected)), newEndpoint(t, withStatus(core.TxStatusUnknown)))
+		_, err := client.ConfirmTx(context.Background(), hash)
+		require.ErrorContains(t, err, "rejected")
+		require.Empty(t, client.InFlightTxs())
+	})
+}
+
+// cancelledCtx reports that it is cancelled once cancelled is set without
+// closing its done channel, as if it was cancelled right after a request
+// returned.
+type cancelledCtx struct {
+	context.Context
+	cancelled *atomic.Bool
+}
+
+func (ctx cancelledCtx) Err() error {
+	if ctx.cancelled.Load() {
+		return context.Canceled
+	}
+	return ctx.Context.Err()
+}
+
+// TestGasEstimatorRouting tests that the gas is estimated with the gas
+// estimation service if one is set and with the core endpoints otherwise.
+func TestGasEstimatorRouting(t *testing.T) {
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	kr := testfactory.TestKeyring(enc.Codec, "a")
+
+	// nothing listens on the port of the dead endpoint
+	port, err := testnode.GetFreePort()
+	require.NoError(t, err)
+	deadConn, err := grpc.NewClient(fmt.Sprintf("localhost:%d", port), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = deadConn.Close() })
+
+	newClient := func(t *testing.T, conn *grpc.ClientConn, opts ...user.Option) *user.TxClient {
+		signer, err := user.NewSigner(kr, enc.TxConfig, "chain", user.NewAccount("a", 1, 0))
+		require.NoError(t, err)
+		client, err := user.NewTxClient(enc.Codec, signer, conn, enc.InterfaceRegistry, opts...)
+		require.NoError(t, err)
+		return client
+	}
+
+	t.Run("primary core endpoint", func(t *testing.T) {
+		client := newClient(t, startGasEstimator(t, 0.1))
+		price, err := client.EstimateGasPrice(context.Background(), 0)
+		require.NoError(t, err)
+		require.Equal(t, 0.1, price)
+	})
+
+	t.Run("falls back to an additional core endpoint", func(t *testing.T) {
+		client := newClient(t, deadConn, user.WithAdditionalCoreEndpoints([]*grpc.ClientConn{startGasEstimator(t, 0.2)}))
+		price, err := client.EstimateGasPrice(context.Background(), 0)
+		require.NoError(t, err)
+		require.Equal(t, 0.2, price)
+	})
+
+	t.Run("gas estimation service", func(t *testing.T) {
+		client := newClient(t, startGasEstimator(t, 0.1), user.WithEstimatorService(startGasEstimator(t, 0.3)))
+		price, err := client.EstimateGasPrice(context.Background(), 0)
+		require.NoError(t, err)
+		require.Equal(t, 0.3, price)
+	})
+}
+
+// mockGasEstimator estimates a fixed gas price.
+type mockGasEstimator struct {
+	gasestimation.UnimplementedGasEstimatorServer
+	price float64
+}
+
+func (m *mockGasEstimator) EstimateGasPrice(context.Context, *gasestimation.EstimateGasPriceRequest) (*gasestimation.EstimateGasPriceResponse, error) {
+	return &gasestimation.EstimateGasPriceResponse{EstimatedGasPrice: m.price}, nil
+}
+
+// startGasEstimator starts a gas estimation service that estimates the price.
+func startGasEstimator(t *testing.T, price float64) *grpc.ClientConn {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := grpc.NewServer()
+	gasestimation.RegisterGasEstimatorServer(s, &mockGasEstimator{price: price})
+	go func() { _ = s.Serve(lis) }()
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		s.Stop()
+		_ = conn.Close()
+	})
+	return conn
+}
@@ -0,0 +1,95 @@
+package user
+
+import (
+	"context"
+	"math"
+	"net"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v5/app/encoding"
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v5/x/blob/types"
+	minfeetypes "github.com/celestiaorg/celestia-app/v5/x/minfee/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// TestMinGasPrice tests that the fees that the client sets itself follow the
+// network min gas price, but are never below the default min gas price.
+func TestMinGasPrice(t *testing.T) {
+	testCases := []struct {
+		name               string
+		networkMinGasPrice string
+		want               float64
+	}{
+		{name: "network min gas price above the default", networkMinGasPrice: "0.01", want: 0.01},
+		{name: "network min gas price below the default", networkMinGasPrice: "0.000001", want: appconsts.DefaultMinGasPrice},
+		{name: "network min gas price unavailable", want: appconsts.DefaultMinGasPrice},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			enc := encoding.MakeConfig()
+			client := newMinFeeTestClient(t, enc, tc.networkMinGasPrice)
+
+			gasPrice := client.minGasPrice(context.Background())
+			require.Equal(t, tc.want, gasPrice)
+
+			blob, err := share.NewV0Blob(share.RandomBlobNamespace(), []byte("data"))
+			require.NoError(t, err)
+			builder := enc.TxConfig.NewTxBuilder()
+			for _, opt := range payForBlobOptions([]*share.Blob{blob}, types.DefaultParams(), gasPrice, nil) {
+				builder = opt(builder)
+			}
+			gasLimit := builder.GetTx().GetGas()
+			wantFee := int64(math.Ceil(gasPrice * float64(gasLimit)))
+			require.Equal(t, wantFee, builder.GetTx().GetFee().AmountOf(appconsts.BondDenom).Int64())
+		})
+	}
+}
+
+// mockMinFeeQuerier returns a fixed network min gas price. The query is
+// unimplemented if the price is empty.
+type mockMinFeeQuerier struct {
+	minfeetypes.UnimplementedQueryServer
+	networkMinGasPrice string
+}
+
+func (m *mockMinFeeQuerier) NetworkMinGasPrice(ctx context.Context, req *minfeetypes.QueryNetworkMinGasPrice) (*minfeetypes.QueryNetworkMinGasPriceResponse, error) {
+	if m.networkMinGasPrice == "" {
+		return m.UnimplementedQueryServer.NetworkMinGasPrice(ctx, req)
+	}
+	return &minfeetypes.QueryNetworkMinGasPriceResponse{NetworkMinGasPrice: sdkmath.LegacyMustNewDecFromStr(m.networkMinGasPrice)}, nil
+}
+
+// newMinFeeTestClient returns a client connected to a node that only serves
+// the network min gas price.
+func newMinFeeTestClient(t *testing.T, enc encoding.Config, networkMinGasPrice string) *TxClient {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcCodec := codec.NewProtoCodec(enc.InterfaceRegistry).GRPCCodec()
+	s := grpc.NewServer(grpc.ForceServerCodec(grpcCodec))
+	minfeetypes.RegisterQueryServer(s, &mockMinFeeQuerier{networkMinGasPrice: networkMinGasPrice})
+	go func() { _ = s.Serve(lis) }()
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		s.Stop()
+		_ = conn.Close()
+	})
+
+	kr := keyring.NewInMemory(enc.Codec)
+	_, _, err = kr.NewMnemonic("a", keyring.English, "", "", hd.Secp256k1)
+	require.NoError(t, err)
+	signer, err := NewSigner(kr, enc.TxConfig, "chain", NewAccount("a", 1, 0))
+	require.NoError(t, err)
+	client, err := NewTxClient(enc.Codec, signer, conn, enc.InterfaceRegistry)
+	require.NoError(t, err)
+	return client
+}
@@ -0,0 +1,368 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"google.golang.org/grpc"
+)
+
+// LaneFunding defines how the submission lanes pay for their transactions.
+type LaneFunding int
+
+const (
+	// LaneFundingFeeGrant grants every lane an allowance to pay its fees from
+	// the default account.
+	LaneFundingFeeGrant LaneFunding = iota
+	// LaneFundingTransfer transfers funds from the default account to every
+	// lane.
+	LaneFundingTransfer
+)
+
+// LanesConfig configures the submission lanes of a TxClient.
+type LanesConfig struct {
+	// Count is the number of lanes.
+	Count int
+	// Funding defines how the lanes pay for their transactions.
+	Funding LaneFunding
+	// SpendLimit is the amount of utia that every lane can spend from the fee
+	// allowance. Zero means no limit. It is only used with
+	// LaneFundingFeeGrant.
+	SpendLimit uint64
+	// TransferAmount is the balance in utia that every lane is topped up to.
+	// A lane is topped up again before it submits a transaction once its
+	// balance falls below half of it. It is only used with
+	// LaneFundingTransfer.
+	TransferAmount uint64
+	// OnKeyCreated is called with the mnemonic of every lane key that is
+	// added to the keyring. The mnemonic is not kept anywhere else so it must
+	// be backed up to recover the funds of the lane. If it is nil, the keys of
+	// the lanes must already exist in the keyring.
+	OnKeyCreated func(name, mnemonic string)
+}
+
+// Validate returns an error if the config is invalid.
+func (cfg LanesConfig) Validate() error {
+	if cfg.Count <= 0 {
+		return fmt.Errorf("lane count must be positive, got %d", cfg.Count)
+	}
+	switch cfg.Funding {
+	case LaneFundingFeeGrant:
+	case LaneFundingTransfer:
+		if cfg.TransferAmount == 0 {
+			return errors.New("transfer amount must be positive")
+		}
+	default:
+		return fmt.Errorf("unknown lane funding %d", cfg.Funding)
+	}
+	return nil
+}
+
+// lanes are the worker accounts that PayForBlobs transactions are spread
+// across. Each lane has at most one transaction in flight so that the
+// transactions of different lanes never conflict on their sequence.
+type lanes struct {
+	names []string
+	// idle holds the names of the lanes without a transaction in flight.
+	idle chan string
+	// feeGranter is the account that pays the fees of the lanes. It is nil if
+	// the lanes are funded by transfers.
+	feeGranter sdktypes.AccAddress
+	// transferAmount is the balance that the lanes are topped up to. It is
+	// zero if the lanes are funded by fee grants.
+	transferAmount uint64
+}
+
+// acquire blocks until a lane is idle and returns its name.
+func (l *lanes) acquire(ctx context.Context) (string, error) {
+	select {
+	case name := <-l.idle:
+		return name, nil
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// release marks the lane as idle.
+func (l *lanes) release(name string) {
+	l.idle <- name
+}
+
+// laneName returns the keyring name of a lane of the account.
+func laneName(account string, index int) string {
+	return fmt.Sprintf("%s-lane-%d", account, index)
+}
+
+// SetupLanes provisions the submission lanes of the client. The lanes are
+// worker accounts named after the default account that SubmitPayForBlob
+// spreads its transactions across concurrently. Each lane has a random key of
+// its own, which is added to the keyring if missing and cfg.OnKeyCreated is
+// set, and the lanes that are not funded yet are funded by the default account
+// in a single transaction. SetupLanes is idempotent.
+func (client *TxClient) SetupLanes(ctx context.Context, cfg LanesConfig) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	granter := client.defaultAddress
+	names := make([]string, cfg.Count)
+	msgs := make([]sdktypes.Msg, 0, cfg.Count)
+	for i := range names {
+		names[i] = laneName(client.defaultAccount, i)
+		addr, err := client.laneAddress(names[i], cfg.OnKeyCreated)
+		if err != nil {
+			return err
+		}
+		msg, err := client.laneFundingMsg(ctx, cfg, granter, addr)
+		if err != nil {
+			return fmt.Errorf("checking the funding of lane %s: %w", names[i], err)
+		}
+		if msg != nil {
+			msgs = append(msgs, msg)
+		}
+	}
+	if len(msgs) > 0 {
+		if _, err := client.SubmitTx(ctx, msgs); err != nil {
+			return fmt.Errorf("funding the submission lanes: %w", err)
+		}
+	}
+
+	// the accounts are queried before the lock is taken so that the
+	// submissions of the client are not blocked by the queries.
+	accounts := make([]*Account, len(names))
+	for i, name := range names {
+		acc, err := client.queryAccount(ctx, name)
+		if err != nil {
+			return err
+		}
+		accounts[i] = acc
+	}
+
+	l := &lanes{
+		names: names,
+		idle:  make(chan string, len(names)),
+	}
+	if cfg.Funding == LaneFundingFeeGrant {
+		l.feeGranter = granter
+	} else {
+		l.transferAmount = cfg.TransferAmount
+	}
+	for _, name := range names {
+		l.idle <- name
+	}
+
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	for _, acc := range accounts {
+		// the sequence of an account that is already loaded is kept as it may
+		// be ahead of the chain.
+		if _, exists := client.signer.accounts[acc.Name()]; exists {
+			continue
+		}
+		if err := client.signer.AddAccount(acc); err != nil {
+			return err
+		}
+	}
+	client.lanes = l
+	return nil
+}
+
+// Lanes returns the names of the submission lanes of the client. It is empty
+// if the lanes are not set up.
+func (client *TxClient) Lanes() []string {
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	if client.lanes == nil {
+		return nil
+	}
+	return append([]string{}, client.lanes.names...)
+}
+
+// laneAddress returns the address of the lane. If its key doesn't exist, it is
+// added to the keyring and its mnemonic is passed to onKeyCreated, unless
+// onKeyCreated is nil in which case an error is returned.
+func (client *TxClient) laneAddress(name string, onKeyCreated func(name, mnemonic string)) (sdktypes.AccAddress, error) {
+	record, err := client.signer.keys.Key(name)
+	if err == nil {
+		return record.GetAddress()
+	}
+	if onKeyCreated == nil {
+		return nil, fmt.Errorf("key for lane %s not found in the keyring: %w", name, err)
+	}
+	record, mnemonic, err := client.signer.keys.NewMnemonic(name, keyring.English, sdktypes.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	if err != nil {
+		return nil, fmt.Errorf("creating key for lane %s: %w", name, err)
+	}
+	onKeyCreated(name, mnemonic)
+	return record.GetAddress()
+}
+
+// laneFundingMsg returns the message that funds the lane or nil if the lane
+// is already funded.
+func (client *TxClient) laneFundingMsg(ctx context.Context, cfg LanesConfig, granter, lane sdktypes.AccAddress) (sdktypes.Msg, error) {
+	if cfg.Funding == LaneFundingTransfer {
+		return client.laneTransferMsg(ctx, granter, lane, cfg.TransferAmount, cfg.TransferAmount)
+	}
+
+	var resp *feegrant.QueryAllowancesResponse
+	err := client.query(ctx, func(conn *grpc.ClientConn) (err error) {
+		resp, err = feegrant.NewQueryClient(conn).Allowances(ctx, &feegrant.QueryAllowancesRequest{Grantee: lane.String()})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, grant := range resp.Allowances {
+		if grant.Granter == granter.String() {
+			return nil, nil
+		}
+	}
+	allowance := &feegrant.BasicAllowance{}
+	if cfg.SpendLimit > 0 {
+		allowance.SpendLimit = sdktypes.NewCoins(sdktypes.NewCoin(appconsts.BondDenom, sdkmath.NewIntFromUint64(cfg.SpendLimit)))
+	}
+	return feegrant.NewMsgGrantAllowance(allowance, granter, lane)
+}
+
+// laneTransferMsg returns the message that tops the balance of the lane up to
+// the transfer amount or nil if the balance isn't lower than the threshold.
+func (client *TxClient) laneTransferMsg(ctx context.Context, funder, lane sdktypes.AccAddress, transferAmount, threshold uint64) (sdktypes.Msg, error) {
+	var resp *banktypes.QueryBalanceResponse
+	err := client.query(ctx, func(conn *grpc.ClientConn) (err error) {
+		resp, err = banktypes.NewQueryClient(conn).Balance(ctx, banktypes.NewQueryBalanceRequest(lane, appconsts.BondDenom))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Balance.Amount.GTE(sdkmath.NewIntFromUint64(threshold)) {
+		return nil, nil
+	}
+	amount := sdktypes.NewCoins(sdktypes.NewCoin(appconsts.BondDenom, sdkmath.NewIntFromUint64(transferAmount).Sub(resp.Balance.Amount)))
+	return banktypes.NewMsgSend(funder, lane, amount), nil
+}
+
+// topUpLane tops the balance of a lane funded by transfers up to the transfer
+// amount once it falls below half of it. It is a no-op for lanes funded by fee
+// grants.
+func (client *TxClient) topUpLane(ctx context.Context, l *lanes, lane string) error {
+	if l.transferAmount == 0 {
+		return nil
+	}
+	addr, err := client.accountAddress(lane)
+	if err != nil {
+		return err
+	}
+	msg, err := client.laneTransferMsg(ctx, client.defaultAddress, addr, l.transferAmount, l.transferAmount/2)
+	if err != nil {
+		return fmt.Errorf("checking the balance of lane %s: %w", lane, err)
+	}
+	if msg == nil {
+		return nil
+	}
+	if _, err := client.SubmitTx(ctx, []sdktypes.Msg{msg}); err != nil {
+		return fmt.Errorf("topping up lane %s: %w", lane, err)
+	}
+	return nil
+}
+
+// accountAddress returns the address of the loaded account.
+func (client *TxClient) accountAddress(account string) (sdktypes.AccAddress, error) {
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	acc, exists := client.signer.accounts[account]
+	if !exists {
+		return nil, fmt.Errorf("account %s does not exist", account)
+	}
+	return acc.address, nil
+}
+
+// getLanes returns the submission lanes or nil if they are not set up.
+func (client *TxClient) getLanes() *lanes {
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	return client.lanes
+}
+
+// submitPayForBlobOnLane submits the blobs with the first idle lane and waits
+// for the transaction to be confirmed before releasing the lane. If the
+// broadcast fails with a sequence mismatch, the sequence of the lane is
+// recovered from the chain and the broadcast is retried once.
+func (client *TxClient) submitPayForBlobOnLane(ctx context.Context, l *lanes, blobs []*share.Blob, opts ...TxOption) (*TxResponse, error) {
+	lane, err := l.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer l.release(lane)
+
+	if err := client.topUpLane(ctx, l, lane); err != nil {
+		return nil, err
+	}
+	if l.feeGranter != nil {
+		// prepend the fee granter, so it can be overwritten in case the user has specified it.
+		opts = append([]TxOption{SetFeeGranter(l.feeGranter)}, opts...)
+	}
+	resp, err := client.broadcastPayForBlobOnLane(ctx, lane, blobs, opts...)
+	if isSequenceMismatch(err) {
+		if err := client.recoverSequence(ctx, lane); err != nil {
+			return nil, err
+		}
+		resp, err = client.broadcastPayForBlobOnLane(ctx, lane, blobs, opts...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return client.ConfirmTx(ctx, resp.TxHash)
+}
+
+// broadcastPayForBlobOnLane signs and broadcasts a transaction paying for the
+// blobs with the lane. The account, the x/blob params and the min gas price
+// are queried before the lock is taken and the lock is only held while the
+// transaction is signed, so that the broadcasts of the lanes are not
+// serialized.
+func (client *TxClient) broadcastPayForBlobOnLane(ctx context.Context, lane string, blobs []*share.Blob, opts ...TxOption) (*sdktypes.TxResponse, error) {
+	if err := client.loadAccount(ctx, lane); err != nil {
+		return nil, err
+	}
+	opts = payForBlobOptions(blobs, client.gasParams(ctx), client.minGasPrice(ctx), opts)
+
+	return client.signAndBroadcastWith(ctx, lane, func() ([]byte, *txRequest, error) {
+		txBytes, _, err := client.signer.CreatePayForBlobs(lane, blobs, opts...)
+		if err != nil || client.resubmissionPolicy == nil {
+			return txBytes, nil, err
+		}
+		req, err := client.payForBlobsRequest(txBytes, blobs, opts)
+		return txBytes, req, err
+	})
+}
+
+// recoverSequence sets the sequence of the account to its sequence on chain.
+// The account is queried before the lock is taken so that the submissions of
+// the other lanes are not blocked by the query.
+func (client *TxClient) recoverSequence(ctx context.Context, account string) error {
+	addr, err := client.accountAddress(account)
+	if err != nil {
+		return err
+	}
+	var sequence uint64
+	err = client.query(ctx, func(conn *grpc.ClientConn) (err error) {
+		_, sequence, err = QueryAccount(ctx, conn, client.registry, addr)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("querying account %s: %w", account, err)
+	}
+
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	return client.signer.SetSequence(account, sequence)
+}
@@ -0,0 +1,137 @@
+package user_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v5/app/params"
+	"github.com/celestiaorg/celestia-app/v5/pkg/user"
+	"github.com/celestiaorg/celestia-app/v5/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v5/test/util/random"
+	"github.com/celestiaorg/celestia-app/v5/test/util/testnode"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmissionLanes(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode.")
+	}
+	_, txClient, ctx := setupTxClient(t, testnode.DefaultTendermintConfig().Mempool.TTLDuration)
+	subCtx, cancel := context.WithTimeout(ctx.GoContext(), time.Minute)
+	defer cancel()
+
+	require.Error(t, txClient.SetupLanes(subCtx, user.LanesConfig{}))
+	// the lane keys must exist unless their mnemonics are handed out
+	require.Error(t, txClient.SetupLanes(subCtx, user.LanesConfig{Count: 3}))
+	mnemonics := make(map[string]string)
+	onKeyCreated := func(name, mnemonic string) { mnemonics[name] = mnemonic }
+	require.NoError(t, txClient.SetupLanes(subCtx, user.LanesConfig{Count: 3, OnKeyCreated: onKeyCreated}))
+	lanes := txClient.Lanes()
+	require.Len(t, lanes, 3)
+	require.Len(t, mnemonics, 3)
+	for _, lane := range lanes {
+		require.NotEmpty(t, mnemonics[lane])
+	}
+
+	// setting up the lanes again doesn't fund them again
+	defaultSequence := txClient.Signer().Account(txClient.DefaultAccountName()).Sequence()
+	require.NoError(t, txClient.SetupLanes(subCtx, user.LanesConfig{Count: 3}))
+	require.Equal(t, defaultSequence, txClient.Signer().Account(txClient.DefaultAccountName()).Sequence())
+
+	submitConcurrently := func(t *testing.T, count int) {
+		var wg sync.WaitGroup
+		errs := make(chan error, count)
+		for i := 0; i < count; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				blobs := blobfactory.ManyRandBlobs(random.New(), 1e3)
+				_, err := txClient.SubmitPayForBlob(subCtx, blobs)
+				errs <- err
+			}()
+		}
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			require.NoError(t, err)
+		}
+	}
+
+	t.Run("fee granted lanes", func(t *testing.T) {
+		submitConcurrently(t, 6)
+		submitted := uint64(0)
+		for _, lane := range lanes {
+			submitted += txClient.Signer().Account(lane).Sequence()
+		}
+		require.EqualValues(t, 6, submitted)
+		// the fees are paid by the default account
+		require.Equal(t, defaultSequence, txClient.Signer().Account(txClient.DefaultAccountName()).Sequence())
+	})
+
+	t.Run("transfer funded lanes", func(t *testing.T) {
+		require.NoError(t, txClient.SetupLanes(subCtx, user.LanesConfig{Count: 2, Funding: user.LaneFundingTransfer, TransferAmount: 1e9, OnKeyCreated: onKeyCreated}))
+		require.Len(t, txClient.Lanes(), 2)
+		for _, lane := range txClient.Lanes() {
+			resp, err := bank.NewQueryClient(ctx.GRPCClient).Balance(subCtx, bank.NewQueryBalanceRequest(txClient.Signer().Account(lane).Address(), params.BondDenom))
+			require.NoError(t, err)
+			require.EqualValues(t, 1e9, resp.Balance.Amount.Int64())
+		}
+		submitConcurrently(t, 4)
+	})
+
+	t.Run("transfer funded lane top up", func(t *testing.T) {
+		require.NoError(t, txClient.SetupLanes(subCtx, user.LanesConfig{Count: 1, Funding: user.LaneFundingTransfer, TransferAmount: 1e9}))
+		lane := txClient.Signer().Account(txClient.Lanes()[0])
+		balance := func() int64 {
+			resp, err := bank.NewQueryClient(ctx.GRPCClient).Balance(subCtx, bank.NewQueryBalanceRequest(lane.Address(), params.BondDenom))
+			require.NoError(t, err)
+			return resp.Balance.Amount.Int64()
+		}
+		// spend more than half of the balance of the lane
+		msg := bank.NewMsgSend(lane.Address(), testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(params.BondDenom, 6e8)))
+		_, err := txClient.SubmitTx(subCtx, []sdk.Msg{msg})
+		require.NoError(t, err)
+		require.Less(t, balance(), int64(5e8))
+
+		submitConcurrently(t, 1)
+		require.Greater(t, balance(), int64(9e8))
+	})
+
+	t.Run("lane sequence recovery", func(t *testing.T) {
+		// only a single lane is used to make sure the sequence is recovered
+		require.NoError(t, txClient.SetupLanes(subCtx, user.LanesConfig{Count: 1}))
+		lane := txClient.Lanes()[0]
+		sequence := txClient.Signer().Account(lane).Sequence()
+		require.NoError(t, txClient.Signer().SetSequence(lane, sequence+10))
+		submitConcurrently(t, 1)
+		require.Equal(t, sequence+1, txClient.Signer().Account(lane).Sequence())
+	})
+}
+
+func TestLanesConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		cfg     user.LanesConfig
+		wantErr bool
+	}{
+		{"fee granted lanes", user.LanesConfig{Count: 2}, false},
+		{"transfer funded lanes", user.LanesConfig{Count: 2, Funding: user.LaneFundingTransfer, TransferAmount: 1}, false},
+		{"no lanes", user.LanesConfig{}, true},
+		{"transfer without amount", user.LanesConfig{Count: 2, Funding: user.LaneFundingTransfer}, true},
+		{"unknown funding", user.LanesConfig{Count: 2, Funding: 5}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cfg.Validate()
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"google.golang.org/grpc"
+)
+
+// multisigSignMode is the sign mode of the partial signatures of multisig
+// transactions. Unlike SIGN_MODE_DIRECT, the sign bytes don't depend on the
+// keys that end up signing, so every key can sign the unsigned transaction
+// independently.
+var multisigSignMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+
+// MultisigAccount is an account controlled by a multisig key.
+type MultisigAccount struct {
+	// PubKey is the multisig key of the account, e.g. created with
+	// multisig.NewLegacyAminoPubKey.
+	PubKey *kmultisig.LegacyAminoPubKey
+	// AccountNumber is the account number of the account on chain.
+	AccountNumber uint64
+	// Sequence is the sequence of the transaction to sign.
+	Sequence uint64
+}
+
+// Address returns the address of the account.
+func (acc MultisigAccount) Address() sdktypes.AccAddress {
+	return sdktypes.AccAddress(acc.PubKey.Address())
+}
+
+// MultisigSignature is the signature of a multisig transaction by one of the
+// keys of the multisig key.
+type MultisigSignature struct {
+	PubKey    cryptotypes.PubKey
+	Signature []byte
+}
+
+// CreateUnsignedTx forms a transaction from the provided messages without
+// signing it. It is used to create the transactions of multisig accounts,
+// which are signed with SignMultisigTx and CombineMultisigTx. TxOptions may be
+// provided to set the fee and gas limit.
+func (s *Signer) CreateUnsignedTx(msgs []sdktypes.Msg, opts ...TxOption) ([]byte, error) {
+	builder, err := s.txBuilder(msgs, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return s.EncodeTx(builder.GetTx())
+}
+
+// SignMultisigTx signs the unsigned transaction of the multisig account with
+// the key of the account name, which must be one of the keys of the multisig
+// key. The account doesn't need to exist on chain.
+func (s *Signer) SignMultisigTx(accountName string, acc MultisigAccount, unsignedTx []byte) (MultisigSignature, error) {
+	record, err := s.keys.Key(accountName)
+	if err != nil {
+		return MultisigSignature{}, fmt.Errorf("retrieving key for account %s: %w", accountName, err)
+	}
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return MultisigSignature{}, fmt.Errorf("getting public key for account %s: %w", accountName, err)
+	}
+	if multisigKeyIndex(acc.PubKey, pubKey) < 0 {
+		return MultisigSignature{}, fmt.Errorf("account %s is not a key of the multisig account %s", accountName, acc.Address())
+	}
+
+	signBytes, err := s.multisigSignBytes(acc, unsignedTx)
+	if err != nil {
+		return MultisigSignature{}, err
+	}
+	signature, err := s.sign(accountName, multisigSignMode, signBytes)
+	if err != nil {
+		return MultisigSignature{}, err
+	}
+	return MultisigSignature{PubKey: pubKey, Signature: signature}, nil
+}
+
+// CombineMultisigTx verifies the signatures of the unsigned transaction of the
+// multisig account and combines them into the signed transaction. At least
+// the threshold of the multisig key must have signed.
+func (s *Signer) CombineMultisigTx(acc MultisigAccount, unsignedTx []byte, sigs ...MultisigSignature) ([]byte, error) {
+	signBytes, err := s.multisigSignBytes(acc, unsignedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKeys := acc.PubKey.GetPubKeys()
+	combined := multisig.NewMultisig(len(pubKeys))
+	signed := make(map[int]bool, len(sigs))
+	for _, sig := range sigs {
+		index := multisigKeyIndex(acc.PubKey, sig.PubKey)
+		if index < 0 {
+			return nil, fmt.Errorf("key %s is not a key of the multisig account %s", sig.PubKey.Address(), acc.Address())
+		}
+		if !sig.PubKey.VerifySignature(signBytes, sig.Signature) {
+			return nil, fmt.Errorf("invalid signature by key %s", sig.PubKey.Address())
+		}
+		if signed[index] {
+			continue
+		}
+		signed[index] = true
+		err := multisig.AddSignatureV2(combined, signing.SignatureV2{
+			PubKey:   sig.PubKey,
+			Data:     &signing.SingleSignatureData{SignMode: multisigSignMode, Signature: sig.Signature},
+			Sequence: acc.Sequence,
+		}, pubKeys)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(signed) < int(acc.PubKey.Threshold) {
+		return nil, fmt.Errorf("got %d signatures, the multisig account %s requires %d", len(signed), acc.Address(), acc.PubKey.Threshold)
+	}
+
+	tx, err := s.DecodeTx(unsignedTx)
+	if err != nil {
+		return nil, err
+	}
+	builder, err := s.enc.WrapTxBuilder(tx)
+	if err != nil {
+		return nil, err
+	}
+	err = builder.SetSignatures(signing.SignatureV2{
+		PubKey:   acc.PubKey,
+		Data:     combined,
+		Sequence: acc.Sequence,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error setting signatures: %w", err)
+	}
+	return s.EncodeTx(builder.GetTx())
+}
+
+// multisigSignBytes returns the bytes that the keys of the multisig account
+// sign.
+func (s *Signer) multisigSignBytes(acc MultisigAccount, unsignedTx []byte) ([]byte, error) {
+	if acc.PubKey == nil {
+		return nil, errors.New("multisig account has no public key")
+	}
+	tx, err := s.DecodeTx(unsignedTx)
+	if err != nil {
+		return nil, fmt.Errorf("decoding the unsigned tx: %w", err)
+	}
+	addr, err := s.addressCodec.BytesToString(acc.Address())
+	if err != nil {
+		return nil, err
+	}
+	signerData := authsigning.SignerData{
+		Address:       addr,
+		ChainID:       s.chainID,
+		AccountNumber: acc.AccountNumber,
+		Sequence:      acc.Sequence,
+		PubKey:        acc.PubKey,
+	}
+	signBytes, err := authsigning.GetSignBytesAdapter(context.Background(), s.enc.SignModeHandler(), multisigSignMode, signerData, tx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting sign bytes: %w", err)
+	}
+	return signBytes, nil
+}
+
+// multisigKeyIndex returns the index of the key in the multisig key or -1 if
+// it is not one of its keys.
+func multisigKeyIndex(multisigKey *kmultisig.LegacyAminoPubKey, pubKey cryptotypes.PubKey) int {
+	for i, key := range multisigKey.GetPubKeys() {
+		if key.Equals(pubKey) {
+			return i
+		}
+	}
+	return -1
+}
+
+// MultisigAccount queries the account number and sequence of the account of
+// the multisig key. The account must exist on chain, i.e. it must have
+// received funds.
+func (client *TxClient) MultisigAccount(ctx context.Context, pubKey *kmultisig.LegacyAminoPubKey) (MultisigAccount, error) {
+	acc := MultisigAccount{PubKey: pubKey}
+	err := client.query(ctx, func(conn *grpc.ClientConn) (err error) {
+		acc.AccountNumber, acc.Sequence, err = QueryAccount(ctx, conn, client.registry, acc.Address())
+		return err
+	})
+	if err != nil {
+		return MultisigAccount{}, fmt.Errorf("querying multisig account %s: %w", acc.Address(), err)
+	}
+	return acc, nil
+}
+
+// BroadcastMultisigTx broadcasts a transaction signed with CombineMultisigTx.
+// It does not confirm that the transaction has been committed on chain. The
+// transaction is not persisted by the tx store as its sequence is not managed
+// by the client.
+func (client *TxClient) BroadcastMultisigTx(ctx context.Context, txBytes []byte) (*sdktypes.TxResponse, error) {
+	var resp *sdktypes.TxResponse
+	err := client.query(ctx, func(conn *grpc.ClientConn) (err error) {
+		resp, err = broadcastTxBytes(ctx, conn, txBytes)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SubmitMultisigTx broadcasts a transaction signed with CombineMultisigTx and
+// waits for it to be committed.
+func (client *TxClient) SubmitMultisigTx(ctx context.Context, txBytes []byte) (*TxResponse, error) {
+	resp, err := client.BroadcastMultisigTx(ctx, txBytes)
+	if err != nil {
+		return nil, err
+	}
+	return client.ConfirmTx(ctx, resp.TxHash)
+}
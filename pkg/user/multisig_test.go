@@ -0,0 +1,103 @@
+package user_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v5/app/params"
+	"github.com/celestiaorg/celestia-app/v5/pkg/user"
+	"github.com/celestiaorg/celestia-app/v5/test/util/testnode"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultisigTx(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode.")
+	}
+	_, txClient, ctx := setupTxClient(t, testnode.DefaultTendermintConfig().Mempool.TTLDuration)
+	subCtx, cancel := context.WithTimeout(ctx.GoContext(), time.Minute)
+	defer cancel()
+
+	pubKeys := make([]cryptotypes.PubKey, 0, 3)
+	for _, name := range []string{"a", "b", "c"} {
+		record, err := ctx.Keyring.Key(name)
+		require.NoError(t, err)
+		pubKey, err := record.GetPubKey()
+		require.NoError(t, err)
+		pubKeys = append(pubKeys, pubKey)
+	}
+	multisigKey := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+	multisigAddr := sdk.AccAddress(multisigKey.Address())
+
+	// fund the multisig account so that it exists on chain
+	fund := bank.NewMsgSend(txClient.DefaultAddress(), multisigAddr, sdk.NewCoins(sdk.NewInt64Coin(params.BondDenom, 1e9)))
+	_, err := txClient.SubmitTx(subCtx, []sdk.Msg{fund}, user.SetGasLimit(1e6), user.SetFee(1e6))
+	require.NoError(t, err)
+
+	signer := txClient.Signer()
+	send := func(t *testing.T, signers ...string) ([]byte, error) {
+		acc, err := txClient.MultisigAccount(subCtx, multisigKey)
+		require.NoError(t, err)
+		require.Equal(t, multisigAddr, acc.Address())
+
+		msg := bank.NewMsgSend(multisigAddr, testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(params.BondDenom, 10)))
+		unsignedTx, err := signer.CreateUnsignedTx([]sdk.Msg{msg}, user.SetGasLimit(1e6), user.SetFee(1e6))
+		require.NoError(t, err)
+
+		sigs := make([]user.MultisigSignature, 0, len(signers))
+		for _, name := range signers {
+			sig, err := signer.SignMultisigTx(name, acc, unsignedTx)
+			require.NoError(t, err)
+			sigs = append(sigs, sig)
+		}
+		return signer.CombineMultisigTx(acc, unsignedTx, sigs...)
+	}
+
+	t.Run("a threshold of signatures is committed", func(t *testing.T) {
+		txBytes, err := send(t, "a", "c")
+		require.NoError(t, err)
+		resp, err := txClient.SubmitMultisigTx(subCtx, txBytes)
+		require.NoError(t, err)
+		require.NotZero(t, resp.Height)
+
+		acc, err := txClient.MultisigAccount(subCtx, multisigKey)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, acc.Sequence)
+	})
+
+	t.Run("all keys can sign", func(t *testing.T) {
+		txBytes, err := send(t, "c", "b", "a")
+		require.NoError(t, err)
+		_, err = txClient.SubmitMultisigTx(subCtx, txBytes)
+		require.NoError(t, err)
+	})
+
+	t.Run("less than a threshold of signatures is rejected", func(t *testing.T) {
+		_, err := send(t, "b")
+		require.Error(t, err)
+		// duplicate signatures are only counted once
+		_, err = send(t, "b", "b")
+		require.Error(t, err)
+	})
+
+	t.Run("keys outside of the multisig key can't sign", func(t *testing.T) {
+		acc, err := txClient.MultisigAccount(subCtx, multisigKey)
+		require.NoError(t, err)
+		unsignedTx, err := signer.CreateUnsignedTx([]sdk.Msg{fund})
+		require.NoError(t, err)
+		other := kmultisig.NewLegacyAminoPubKey(1, pubKeys[:1])
+		_, err = signer.SignMultisigTx("b", user.MultisigAccount{PubKey: other}, unsignedTx)
+		require.Error(t, err)
+
+		sig, err := signer.SignMultisigTx("a", user.MultisigAccount{PubKey: other}, unsignedTx)
+		require.NoError(t, err)
+		// the signature is for another account so it doesn't verify
+		_, err = signer.CombineMultisigTx(acc, unsignedTx, sig, sig)
+		require.Error(t, err)
+	})
+}
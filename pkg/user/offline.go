@@ -0,0 +1,321 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v5/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc"
+)
+
+// OfflineTxBundleVersion is the version of the offline tx bundle format.
+const OfflineTxBundleVersion = 1
+
+// OfflineTxBundle is a portable transaction together with the account
+// metadata needed to sign it on a machine without network access. It is
+// exported unsigned by TxClient.ExportTx or TxClient.ExportPayForBlobs, signed
+// by SignOfflineTx and broadcast by TxClient.BroadcastOfflineTx.
+type OfflineTxBundle struct {
+	Version       int    `json:"version"`
+	ChainID       string `json:"chain_id"`
+	Signer        string `json:"signer"`
+	Address       string `json:"address"`
+	AccountNumber uint64 `json:"account_number"`
+	Sequence      uint64 `json:"sequence"`
+	// Tx is the unsigned transaction.
+	Tx []byte `json:"tx"`
+	// Blobs are the blobs that the transaction pays for. It is empty if the
+	// transaction is not a PayForBlobs transaction.
+	Blobs []*share.Blob `json:"blobs,omitempty"`
+	// SignedTx is the signed transaction, wrapped in a BlobTx if the
+	// transaction pays for blobs. It is empty until the bundle is signed.
+	SignedTx []byte `json:"signed_tx,omitempty"`
+}
+
+// WriteOfflineTxBundle writes the bundle to the file.
+func WriteOfflineTxBundle(path string, bundle *OfflineTxBundle) error {
+	data, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// ReadOfflineTxBundle reads the bundle from the file.
+func ReadOfflineTxBundle(path string) (*OfflineTxBundle, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	bundle := &OfflineTxBundle{}
+	if err := json.Unmarshal(data, bundle); err != nil {
+		return nil, fmt.Errorf("decoding the offline tx bundle: %w", err)
+	}
+	if bundle.Version != OfflineTxBundleVersion {
+		return nil, fmt.Errorf("unsupported offline tx bundle version %d, expected %d", bundle.Version, OfflineTxBundleVersion)
+	}
+	return bundle, nil
+}
+
+// ExportTx forms an unsigned transaction from the provided messages and
+// returns it in a bundle with the account number and sequence of its signer,
+// so that it can be signed offline. The gas limit must be set with
+// SetGasLimit as it can't be estimated without signing the transaction. If
+// the fee is not set, it is derived from the network min gas price.
+//
+// The sequence of the bundle is the sequence of the signer on chain and the
+// sequence of the client is left untouched. To export several bundles before
+// broadcasting any of them, set the Sequence of the following bundles before
+// signing them.
+func (client *TxClient) ExportTx(ctx context.Context, msgs []sdktypes.Msg, opts ...TxOption) (*OfflineTxBundle, error) {
+	gasPrice := client.minGasPrice(ctx)
+	bundle, err := client.exportTx(nil, func() (string, sdktypes.Tx, error) {
+		return client.unsignedTx(ctx, msgs, gasPrice, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return client.withChainSequence(ctx, bundle)
+}
+
+// unsignedTx forms the unsigned transaction of ExportTx, with a fee at the gas
+// price if none is set, and returns it with the name of its signer. The caller
+// must hold the lock.
+func (client *TxClient) unsignedTx(ctx context.Context, msgs []sdktypes.Msg, gasPrice float64, opts ...TxOption) (string, sdktypes.Tx, error) {
+	account, err := client.getAccountNameFromMsgs(msgs)
+	if err != nil {
+		return "", nil, err
+	}
+	if err := client.checkAccountLoaded(ctx, account); err != nil {
+		return "", nil, err
+	}
+
+	txBuilder, err := client.signer.txBuilder(msgs, opts...)
+	if err != nil {
+		return "", nil, err
+	}
+	gasLimit := txBuilder.GetTx().GetGas()
+	if gasLimit == 0 {
+		return "", nil, errors.New("the gas limit of offline transactions must be set")
+	}
+	if txBuilder.GetTx().GetFee().AmountOf(appconsts.BondDenom).IsZero() {
+		fee := uint64(math.Ceil(gasPrice * float64(gasLimit)))
+		txBuilder = SetFee(fee)(txBuilder)
+	}
+	return account, txBuilder.GetTx(), nil
+}
+
+// ExportPayForBlobs forms an unsigned transaction that pays for the blobs with
+// the account and returns it in a bundle with the blobs and the account
+// metadata, so that it can be signed offline. See ExportTx.
+func (client *TxClient) ExportPayForBlobs(ctx context.Context, account string, blobs []*share.Blob, opts ...TxOption) (*OfflineTxBundle, error) {
+	params, gasPrice := client.gasParams(ctx), client.minGasPrice(ctx)
+	bundle, err := client.exportTx(blobs, func() (string, sdktypes.Tx, error) {
+		tx, err := client.unsignedPayForBlobs(ctx, account, blobs, params, gasPrice, opts...)
+		return account, tx, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return client.withChainSequence(ctx, bundle)
+}
+
+// unsignedPayForBlobs forms the unsigned transaction of ExportPayForBlobs with
+// the gas estimated from the provided x/blob params and a fee at the gas
+// price. The caller must hold the lock.
+func (client *TxClient) unsignedPayForBlobs(ctx context.Context, account string, blobs []*share.Blob, params blobtypes.Params, gasPrice float64, opts ...TxOption) (sdktypes.Tx, error) {
+	if err := client.checkAccountLoaded(ctx, account); err != nil {
+		return nil, err
+	}
+
+	opts = payForBlobOptions(blobs, params, gasPrice, opts)
+
+	addr, err := client.signer.addressCodec.BytesToString(client.signer.accounts[account].address)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := blobtypes.NewMsgPayForBlobs(addr, appconsts.Version, blobs...)
+	if err != nil {
+		return nil, err
+	}
+	txBuilder, err := client.signer.txBuilder([]sdktypes.Msg{msg}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return txBuilder.GetTx(), nil
+}
+
+// exportTx returns the bundle of the unsigned transaction that pays for the
+// blobs, if any, formed by build with the lock held. The sequence of the
+// bundle is not set.
+func (client *TxClient) exportTx(blobs []*share.Blob, build func() (string, sdktypes.Tx, error)) (*OfflineTxBundle, error) {
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+
+	account, tx, err := build()
+	if err != nil {
+		return nil, err
+	}
+	txBytes, err := client.signer.EncodeTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	acc := client.signer.accounts[account]
+	addr, err := client.signer.addressCodec.BytesToString(acc.address)
+	if err != nil {
+		return nil, err
+	}
+	return &OfflineTxBundle{
+		Version:       OfflineTxBundleVersion,
+		ChainID:       client.signer.ChainID(),
+		Signer:        account,
+		Address:       addr,
+		AccountNumber: acc.AccountNumber(),
+		Tx:            txBytes,
+		Blobs:         blobs,
+	}, nil
+}
+
+// withChainSequence sets the sequence of the bundle to the sequence of its
+// signer on chain. The caller must not hold the lock.
+func (client *TxClient) withChainSequence(ctx context.Context, bundle *OfflineTxBundle) (*OfflineTxBundle, error) {
+	addr, err := sdktypes.AccAddressFromBech32(bundle.Address)
+	if err != nil {
+		return nil, err
+	}
+	err = client.query(ctx, func(conn *grpc.ClientConn) (err error) {
+		_, bundle.Sequence, err = QueryAccount(ctx, conn, client.registry, addr)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("querying the sequence of account %s: %w", bundle.Signer, err)
+	}
+	return bundle, nil
+}
+
+// SignOfflineTx signs the transaction of the bundle with the key of the
+// keyring and sets the signed transaction of the bundle. It doesn't need
+// network access. The key is the signer of the bundle unless keyName is set,
+// in which case its address must match the address of the bundle.
+func SignOfflineTx(keys keyring.Keyring, txConfig client.TxConfig, bundle *OfflineTxBundle, keyName string) error {
+	if keyName == "" {
+		keyName = bundle.Signer
+	}
+	record, err := keys.Key(keyName)
+	if err != nil {
+		return fmt.Errorf("retrieving key %s: %w", keyName, err)
+	}
+	addr, err := record.GetAddress()
+	if err != nil {
+		return err
+	}
+	signer, err := NewSigner(keys, txConfig, bundle.ChainID, NewAccount(keyName, bundle.AccountNumber, bundle.Sequence))
+	if err != nil {
+		return err
+	}
+	if got, err := signer.addressCodec.BytesToString(addr); err != nil || got != bundle.Address {
+		return fmt.Errorf("key %s does not match the address %s of the bundle", keyName, bundle.Address)
+	}
+
+	tx, err := signer.DecodeTx(bundle.Tx)
+	if err != nil {
+		return fmt.Errorf("decoding the unsigned tx: %w", err)
+	}
+	txBuilder, err := txConfig.WrapTxBuilder(tx)
+	if err != nil {
+		return err
+	}
+	if _, _, err := signer.signTransaction(txBuilder); err != nil {
+		return err
+	}
+	txBytes, err := signer.EncodeTx(txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+	if len(bundle.Blobs) > 0 {
+		txBytes, err = blobtx.MarshalBlobTx(txBytes, bundle.Blobs...)
+		if err != nil {
+			return err
+		}
+	}
+
+	signed := *bundle
+	signed.SignedTx = txBytes
+	if err := ValidateOfflineTx(txConfig, &signed); err != nil {
+		return err
+	}
+	bundle.SignedTx = txBytes
+	return nil
+}
+
+// ValidateOfflineTx checks that the bundle is signed and that its transaction
+// decodes. The BlobTx of PayForBlobs transactions is validated with
+// ValidateBlobTx.
+func ValidateOfflineTx(txConfig client.TxConfig, bundle *OfflineTxBundle) error {
+	if len(bundle.SignedTx) == 0 {
+		return errors.New("the offline tx bundle is not signed")
+	}
+	btx, isBlob, err := blobtx.UnmarshalBlobTx(bundle.SignedTx)
+	if isBlob && err != nil {
+		return err
+	}
+	if len(bundle.Blobs) > 0 && !isBlob {
+		return errors.New("the signed tx of the offline tx bundle does not pay for its blobs")
+	}
+	if isBlob {
+		return blobtypes.ValidateBlobTx(txConfig, btx, appconsts.SubtreeRootThreshold, appconsts.Version)
+	}
+	_, err = txConfig.TxDecoder()(bundle.SignedTx)
+	return err
+}
+
+// BroadcastOfflineTx validates and broadcasts the signed transaction of the
+// bundle. It does not confirm that the transaction has been committed on
+// chain. The transaction is not tracked by the client, as its sequence is
+// managed by the bundle, but if the client also signs for the account of the
+// bundle its sequence is moved past the one of the bundle.
+func (client *TxClient) BroadcastOfflineTx(ctx context.Context, bundle *OfflineTxBundle) (*sdktypes.TxResponse, error) {
+	if bundle.ChainID != client.signer.ChainID() {
+		return nil, fmt.Errorf("the offline tx bundle is for chain %s, not %s", bundle.ChainID, client.signer.ChainID())
+	}
+	if err := ValidateOfflineTx(client.signer.enc, bundle); err != nil {
+		return nil, fmt.Errorf("validating the offline tx bundle: %w", err)
+	}
+	var resp *sdktypes.TxResponse
+	err := client.query(ctx, func(conn *grpc.ClientConn) (err error) {
+		resp, err = broadcastTxBytes(ctx, conn, bundle.SignedTx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	if acc, exists := client.signer.accounts[bundle.Signer]; exists && acc.Sequence() <= bundle.Sequence {
+		if err := client.signer.SetSequence(bundle.Signer, bundle.Sequence+1); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// SubmitOfflineTx broadcasts the signed transaction of the bundle and waits
+// for it to be committed.
+func (client *TxClient) SubmitOfflineTx(ctx context.Context, bundle *OfflineTxBundle) (*TxResponse, error) {
+	resp, err := client.BroadcastOfflineTx(ctx, bundle)
+	if err != nil {
+		return nil, err
+	}
+	return client.ConfirmTx(ctx, resp.TxHash)
+}
@@ -0,0 +1,92 @@
+package user_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v5/app/params"
+	"github.com/celestiaorg/celestia-app/v5/pkg/user"
+	"github.com/celestiaorg/celestia-app/v5/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v5/test/util/random"
+	"github.com/celestiaorg/celestia-app/v5/test/util/testnode"
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOfflineTx(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode.")
+	}
+	encCfg, txClient, ctx := setupTxClient(t, testnode.DefaultTendermintConfig().Mempool.TTLDuration)
+	subCtx, cancel := context.WithTimeout(ctx.GoContext(), time.Minute)
+	defer cancel()
+
+	account := txClient.DefaultAccountName()
+	msg := bank.NewMsgSend(txClient.DefaultAddress(), testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(params.BondDenom, 10)))
+	blobs := blobfactory.ManyRandBlobs(random.New(), 1e3, 2e3)
+
+	// the bundles go through files as they would between the online and the
+	// air-gapped machine
+	roundTrip := func(t *testing.T, bundle *user.OfflineTxBundle) *user.OfflineTxBundle {
+		path := filepath.Join(t.TempDir(), "bundle.json")
+		require.NoError(t, user.WriteOfflineTxBundle(path, bundle))
+		read, err := user.ReadOfflineTxBundle(path)
+		require.NoError(t, err)
+		return read
+	}
+
+	t.Run("export, sign and broadcast", func(t *testing.T) {
+		sequence := txClient.Signer().Account(account).Sequence()
+		_, err := txClient.ExportTx(subCtx, []sdk.Msg{msg})
+		require.Error(t, err, "the gas limit must be set")
+
+		txBundle, err := txClient.ExportTx(subCtx, []sdk.Msg{msg}, user.SetGasLimit(1e6))
+		require.NoError(t, err)
+		pfbBundle, err := txClient.ExportPayForBlobs(subCtx, account, blobs)
+		require.NoError(t, err)
+		// the bundles get the sequence on chain and the live sequence is untouched
+		require.Equal(t, sequence, txBundle.Sequence)
+		require.Equal(t, sequence, pfbBundle.Sequence)
+		require.Equal(t, sequence, txClient.Signer().Account(account).Sequence())
+		pfbBundle.Sequence++
+
+		for _, bundle := range []*user.OfflineTxBundle{txBundle, pfbBundle} {
+			bundle = roundTrip(t, bundle)
+			_, err := txClient.BroadcastOfflineTx(subCtx, bundle)
+			require.Error(t, err, "unsigned bundles can't be broadcast")
+
+			require.NoError(t, user.SignOfflineTx(ctx.Keyring, encCfg.TxConfig, bundle, ""))
+			resp, err := txClient.SubmitOfflineTx(subCtx, roundTrip(t, bundle))
+			require.NoError(t, err)
+			require.NotZero(t, resp.Height)
+		}
+		// the live sequence is moved past the broadcast bundles
+		require.Equal(t, sequence+2, txClient.Signer().Account(account).Sequence())
+	})
+
+	t.Run("invalid blob txs are not broadcast", func(t *testing.T) {
+		bundle, err := txClient.ExportPayForBlobs(subCtx, account, blobs)
+		require.NoError(t, err)
+		require.NoError(t, user.SignOfflineTx(ctx.Keyring, encCfg.TxConfig, bundle, ""))
+
+		// the blobs of the bundle no longer match the commitments of the PFB
+		tampered, err := share.NewV0Blob(blobs[0].Namespace(), random.Bytes(1e3))
+		require.NoError(t, err)
+		bundle.Blobs = []*share.Blob{tampered, blobs[1]}
+		require.Error(t, user.SignOfflineTx(ctx.Keyring, encCfg.TxConfig, bundle, ""))
+	})
+
+	t.Run("the key must match the bundle", func(t *testing.T) {
+		bundle, err := txClient.ExportTx(subCtx, []sdk.Msg{msg}, user.SetGasLimit(1e6))
+		require.NoError(t, err)
+		other := "b"
+		if account == other {
+			other = "c"
+		}
+		require.Error(t, user.SignOfflineTx(ctx.Keyring, encCfg.TxConfig, bundle, other))
+	})
+}
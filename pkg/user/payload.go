@@ -0,0 +1,257 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cometbft/cometbft/crypto/merkle"
+)
+
+// payloadManifestPrefix prefixes the data of the manifest blobs to tell them
+// apart from the chunk blobs of the same namespace.
+var payloadManifestPrefix = []byte("celestia-payload-manifest/v1\n")
+
+// PayloadChunk describes a chunk blob of a payload.
+type PayloadChunk struct {
+	// Size is the size of the chunk in bytes.
+	Size int `json:"size"`
+	// Hash is the sha256 hash of the chunk.
+	Hash []byte `json:"hash"`
+	// Height is the height at which the chunk was committed.
+	Height int64 `json:"height"`
+	// Commitment is the share commitment of the chunk blob, which it can be
+	// retrieved by along with its height and namespace.
+	Commitment []byte `json:"commitment"`
+}
+
+// PayloadManifest describes the ordered chunk blobs that a payload was split
+// into. It is posted as a blob in the namespace of the chunks once they are
+// all committed.
+type PayloadManifest struct {
+	// Size is the size of the payload in bytes.
+	Size int `json:"size"`
+	// Hash is the sha256 hash of the payload.
+	Hash []byte `json:"hash"`
+	// Chunks are the chunks of the payload, in order.
+	Chunks []PayloadChunk `json:"chunks"`
+
+	// Namespace is the namespace of the manifest blob.
+	Namespace share.Namespace `json:"-"`
+	// Signer is the signer of the manifest blob. If set, the chunks must be
+	// signed by the same signer.
+	Signer []byte `json:"-"`
+}
+
+// PayloadSubmission is the result of a payload submitted with SubmitPayload.
+type PayloadSubmission struct {
+	// Manifest is the manifest of the payload.
+	Manifest *PayloadManifest
+	// TxHash is the hash of the transaction that paid for the manifest blob.
+	TxHash string
+	// Height is the height at which the manifest blob was committed.
+	Height int64
+}
+
+// MaxChunkSize returns the size of the largest chunk blob that fits in a
+// PayForBlobs transaction on its own.
+func MaxChunkSize(limits BlobLimits) int {
+	txBound := limits.MaxTxSize - pfbTxOverhead - pfbBlobOverhead
+	// one share is kept for the signer of share version 1 blobs
+	shares := limits.MaxBlobShares - share.CompactSharesNeeded(uint32(pfbTxOverhead+pfbBlobOverhead)) - 1
+	return max(0, min(txBound, share.AvailableBytesFromSparseShares(shares)))
+}
+
+// SplitPayload splits the payload into ordered chunk blobs of at most
+// chunkSize bytes under the namespace. The chunks are share version 1 blobs
+// bound to the signer if it is set, and share version 0 blobs otherwise.
+func SplitPayload(namespace share.Namespace, payload []byte, chunkSize int, signer []byte) ([]*share.Blob, error) {
+	if chunkSize <= 0 {
+		return nil, fmt.Errorf("chunk size must be positive, got %d", chunkSize)
+	}
+	if len(payload) == 0 {
+		return nil, errors.New("payload is empty")
+	}
+	chunks := make([]*share.Blob, 0, (len(payload)+chunkSize-1)/chunkSize)
+	for start := 0; start < len(payload); start += chunkSize {
+		end := min(start+chunkSize, len(payload))
+		chunk, err := newPayloadBlob(namespace, payload[start:end], signer)
+		if err != nil {
+			return nil, err
+		}
+		chunks = append(chunks, chunk)
+	}
+	return chunks, nil
+}
+
+// NewPayloadManifest returns the manifest of the payload split into the
+// chunks, which were committed at the heights.
+func NewPayloadManifest(payload []byte, chunks []*share.Blob, heights []int64) (*PayloadManifest, error) {
+	if len(chunks) == 0 || len(chunks) != len(heights) {
+		return nil, fmt.Errorf("expected a height for each of the %d chunks, got %d", len(chunks), len(heights))
+	}
+	hash := sha256.Sum256(payload)
+	manifest := &PayloadManifest{
+		Size:      len(payload),
+		Hash:      hash[:],
+		Chunks:    make([]PayloadChunk, len(chunks)),
+		Namespace: chunks[0].Namespace(),
+		Signer:    chunks[0].Signer(),
+	}
+	for i, chunk := range chunks {
+		commitment, err := inclusion.CreateCommitment(chunk, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold)
+		if err != nil {
+			return nil, fmt.Errorf("creating the commitment of chunk %d: %w", i, err)
+		}
+		chunkHash := sha256.Sum256(chunk.Data())
+		manifest.Chunks[i] = PayloadChunk{
+			Size:       len(chunk.Data()),
+			Hash:       chunkHash[:],
+			Height:     heights[i],
+			Commitment: commitment,
+		}
+	}
+	return manifest, nil
+}
+
+// Blob returns the manifest blob. It is bound to the signer of the manifest
+// if it is set.
+func (m *PayloadManifest) Blob() (*share.Blob, error) {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return newPayloadBlob(m.Namespace, append(append([]byte{}, payloadManifestPrefix...), data...), m.Signer)
+}
+
+// IsPayloadManifest returns true if the blob is a payload manifest blob.
+func IsPayloadManifest(blob *share.Blob) bool {
+	return bytes.HasPrefix(blob.Data(), payloadManifestPrefix)
+}
+
+// ParsePayloadManifest parses the manifest from the manifest blob.
+func ParsePayloadManifest(blob *share.Blob) (*PayloadManifest, error) {
+	if !IsPayloadManifest(blob) {
+		return nil, errors.New("blob is not a payload manifest")
+	}
+	manifest := &PayloadManifest{}
+	if err := json.Unmarshal(blob.Data()[len(payloadManifestPrefix):], manifest); err != nil {
+		return nil, fmt.Errorf("decoding the payload manifest: %w", err)
+	}
+	manifest.Namespace = blob.Namespace()
+	manifest.Signer = blob.Signer()
+	return manifest, nil
+}
+
+// ReassemblePayload reassembles the payload from the blobs and verifies it
+// against the manifest. The blobs may contain other blobs than the chunks of
+// the payload and may be in any order.
+func ReassemblePayload(manifest *PayloadManifest, blobs []*share.Blob) ([]byte, error) {
+	chunks := make(map[[sha256.Size]byte][]byte, len(blobs))
+	for _, blob := range blobs {
+		if !blob.Namespace().Equals(manifest.Namespace) || !bytes.Equal(blob.Signer(), manifest.Signer) {
+			continue
+		}
+		chunks[sha256.Sum256(blob.Data())] = blob.Data()
+	}
+
+	payload := make([]byte, 0, manifest.Size)
+	for i, chunk := range manifest.Chunks {
+		var hash [sha256.Size]byte
+		copy(hash[:], chunk.Hash)
+		data, ok := chunks[hash]
+		if !ok {
+			return nil, fmt.Errorf("chunk %d committed at height %d is missing", i, chunk.Height)
+		}
+		payload = append(payload, data...)
+	}
+
+	hash := sha256.Sum256(payload)
+	if len(payload) != manifest.Size || !bytes.Equal(hash[:], manifest.Hash) {
+		return nil, errors.New("reassembled payload does not match the manifest")
+	}
+	return payload, nil
+}
+
+// ReadPayload reassembles the payload from the shares of the namespace of the
+// manifest at the heights of its chunks. See ReassemblePayload.
+func ReadPayload(manifest *PayloadManifest, shares []share.Share) ([]byte, error) {
+	blobs, err := share.ParseBlobs(shares)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the blobs from the shares: %w", err)
+	}
+	return ReassemblePayload(manifest, blobs)
+}
+
+// newPayloadBlob returns a share version 1 blob bound to the signer if it is
+// set, and a share version 0 blob otherwise.
+func newPayloadBlob(namespace share.Namespace, data, signer []byte) (*share.Blob, error) {
+	if len(signer) == 0 {
+		return share.NewV0Blob(namespace, data)
+	}
+	return share.NewV1Blob(namespace, data, signer)
+}
+
+// SubmitPayload splits the payload into chunk blobs under the namespace that
+// fit in the current blob limits, submits them with SubmitBlobBatch and, once
+// they are all committed, submits the manifest blob that describes them. The
+// payload can be read back with ReadPayload.
+//
+// The blobs are bound to the default account with share version 1 so that
+// readers can verify that all the chunks were posted by the same account. If
+// the submission lanes are set up, the blobs are signed by different lanes
+// and share version 0 is used instead.
+func (client *TxClient) SubmitPayload(ctx context.Context, namespace share.Namespace, payload []byte, opts ...TxOption) (*PayloadSubmission, error) {
+	limits, err := client.QueryBlobLimits(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var signer []byte
+	if client.getLanes() == nil {
+		signer = client.defaultAddress
+	}
+	chunks, err := SplitPayload(namespace, payload, MaxChunkSize(limits), signer)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := client.SubmitBlobBatch(ctx, chunks, opts...)
+	if err != nil {
+		return nil, err
+	}
+	heights := make([]int64, len(results))
+	errs := make([]error, 0)
+	for i, result := range results {
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("chunk %d: %w", i, result.Err))
+		}
+		heights[i] = result.Height
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("submitting the payload chunks: %w", errors.Join(errs...))
+	}
+
+	manifest, err := NewPayloadManifest(payload, chunks, heights)
+	if err != nil {
+		return nil, err
+	}
+	manifestBlob, err := manifest.Blob()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.SubmitPayForBlob(ctx, []*share.Blob{manifestBlob}, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("submitting the payload manifest: %w", err)
+	}
+	return &PayloadSubmission{
+		Manifest: manifest,
+		TxHash:   resp.TxHash,
+		Height:   resp.Height,
+	}, nil
+}
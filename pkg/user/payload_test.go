@@ -0,0 +1,134 @@
+package user_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v5/pkg/user"
+	"github.com/celestiaorg/celestia-app/v5/test/util/random"
+	"github.com/celestiaorg/celestia-app/v5/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v5/test/util/testnode"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayloadChunking(t *testing.T) {
+	namespace := testfactory.RandomBlobNamespace()
+	payload := random.Bytes(10_000)
+	signer := testnode.RandomAddress().Bytes()
+
+	for _, tc := range []struct {
+		name   string
+		signer []byte
+	}{
+		{"share version 0", nil},
+		{"share version 1", signer},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			chunks, err := user.SplitPayload(namespace, payload, 3_000, tc.signer)
+			require.NoError(t, err)
+			require.Len(t, chunks, 4)
+			require.Equal(t, 1_000, len(chunks[3].Data()))
+
+			manifest, err := user.NewPayloadManifest(payload, chunks, []int64{1, 1, 2, 3})
+			require.NoError(t, err)
+			manifestBlob, err := manifest.Blob()
+			require.NoError(t, err)
+			require.True(t, user.IsPayloadManifest(manifestBlob))
+			require.False(t, user.IsPayloadManifest(chunks[0]))
+			parsed, err := user.ParsePayloadManifest(manifestBlob)
+			require.NoError(t, err)
+			require.Equal(t, manifest, parsed)
+
+			// the chunks are reassembled from the shares regardless of their
+			// order and of the other blobs of the namespace
+			other, err := share.NewV0Blob(namespace, []byte("other"))
+			require.NoError(t, err)
+			shares := make([]share.Share, 0)
+			for _, blob := range []*share.Blob{chunks[2], other, chunks[0], chunks[3], manifestBlob, chunks[1]} {
+				blobShares, err := blob.ToShares()
+				require.NoError(t, err)
+				shares = append(shares, blobShares...)
+			}
+			got, err := user.ReadPayload(parsed, shares)
+			require.NoError(t, err)
+			require.Equal(t, payload, got)
+
+			_, err = user.ReassemblePayload(parsed, chunks[:3])
+			require.Error(t, err)
+		})
+	}
+
+	t.Run("chunks of another signer are ignored", func(t *testing.T) {
+		chunks, err := user.SplitPayload(namespace, payload, 3_000, signer)
+		require.NoError(t, err)
+		manifest, err := user.NewPayloadManifest(payload, chunks, []int64{1, 1, 1, 1})
+		require.NoError(t, err)
+		forged, err := user.SplitPayload(namespace, payload, 3_000, testnode.RandomAddress().Bytes())
+		require.NoError(t, err)
+		_, err = user.ReassemblePayload(manifest, forged)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		_, err := user.SplitPayload(namespace, payload, 0, nil)
+		require.Error(t, err)
+		_, err = user.SplitPayload(namespace, nil, 10, nil)
+		require.Error(t, err)
+		_, err = user.ParsePayloadManifest(mustNewV0Blob(t, namespace, payload))
+		require.Error(t, err)
+	})
+}
+
+func TestSubmitPayload(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode.")
+	}
+	_, txClient, ctx := setupTxClient(t, testnode.DefaultTendermintConfig().Mempool.TTLDuration)
+	subCtx, cancel := context.WithTimeout(ctx.GoContext(), time.Minute)
+	defer cancel()
+
+	limits, err := txClient.QueryBlobLimits(subCtx)
+	require.NoError(t, err)
+	require.Positive(t, user.MaxChunkSize(limits))
+
+	namespace := testfactory.RandomBlobNamespace()
+	payload := random.Bytes(5_000)
+	submission, err := txClient.SubmitPayload(subCtx, namespace, payload)
+	require.NoError(t, err)
+	require.Equal(t, txClient.DefaultAddress().Bytes(), submission.Manifest.Signer)
+	require.Len(t, submission.Manifest.Chunks, 1)
+
+	// read the blobs of the namespace back from the blocks
+	blobs := make([]*share.Blob, 0)
+	for _, height := range []int64{submission.Manifest.Chunks[0].Height, submission.Height} {
+		block, err := ctx.Client.Block(subCtx, &height)
+		require.NoError(t, err)
+		for _, tx := range block.Block.Txs {
+			btx, isBlob, err := blobtx.UnmarshalBlobTx(tx)
+			require.NoError(t, err)
+			if isBlob {
+				blobs = append(blobs, btx.Blobs...)
+			}
+		}
+	}
+	var manifest *user.PayloadManifest
+	for _, blob := range blobs {
+		if user.IsPayloadManifest(blob) {
+			manifest, err = user.ParsePayloadManifest(blob)
+			require.NoError(t, err)
+		}
+	}
+	require.NotNil(t, manifest)
+	got, err := user.ReassemblePayload(manifest, blobs)
+	require.NoError(t, err)
+	require.Equal(t, payload, got)
+}
+
+func mustNewV0Blob(t *testing.T, namespace share.Namespace, data []byte) *share.Blob {
+	blob, err := share.NewV0Blob(namespace, data)
+	require.NoError(t, err)
+	return blob
+}
@@ -0,0 +1,80 @@
+package remotesigner
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v5/pkg/user"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+)
+
+var _ user.SignModeSigningBackend = &Backend{}
+
+// Backend is a user.SigningBackend that signs with a remote signer over gRPC.
+type Backend struct {
+	client RemoteSignerClient
+	keys   keyring.Keyring
+}
+
+// NewBackend returns a signing backend that sends the sign bytes of the
+// transactions to the remote signer of the connection. The signatures of the
+// remote signer are verified against the public keys of the keyring, which is
+// typically the keyring of the user.Signer that the backend is set on.
+func NewBackend(conn gogogrpc.ClientConn, keys keyring.Keyring) *Backend {
+	return &Backend{client: NewRemoteSignerClient(conn), keys: keys}
+}
+
+// Sign implements user.SigningBackend.
+func (b *Backend) Sign(ctx context.Context, name, chainID string, signBytes []byte) ([]byte, error) {
+	return b.SignWithMode(ctx, name, chainID, signing.SignMode_SIGN_MODE_DIRECT, signBytes)
+}
+
+// SignWithMode implements user.SignModeSigningBackend.
+func (b *Backend) SignWithMode(ctx context.Context, name, chainID string, signMode signing.SignMode, signBytes []byte) ([]byte, error) {
+	record, err := b.keys.Key(name)
+	if err != nil {
+		return nil, fmt.Errorf("trying to find account %s on keyring: %w", name, err)
+	}
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the public key of %s: %w", name, err)
+	}
+	resp, err := b.client.Sign(ctx, &SignRequest{
+		Name:      name,
+		ChainId:   chainID,
+		SignBytes: signBytes,
+		SignMode:  signMode,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("signing with the remote signer: %w", err)
+	}
+	// a faulty or misconfigured remote signer must not produce a transaction
+	// that is only rejected once it is broadcast.
+	if !pubKey.VerifySignature(signBytes, resp.Signature) {
+		return nil, fmt.Errorf("the signature of the remote signer doesn't match the public key of %s", name)
+	}
+	return resp.Signature, nil
+}
+
+// ImportKeys saves the public keys of the remote signer that are missing from
+// the keyring as offline keys, so that a user.Signer using the keyring and the
+// remote signer Backend can look up the accounts of the remote signer.
+func ImportKeys(ctx context.Context, conn gogogrpc.ClientConn, keys keyring.Keyring) error {
+	resp, err := NewRemoteSignerClient(conn).Keys(ctx, &KeysRequest{})
+	if err != nil {
+		return fmt.Errorf("listing the keys of the remote signer: %w", err)
+	}
+	for _, key := range resp.Keys {
+		if _, err := keys.Key(key.Name); err == nil {
+			continue
+		}
+		pubKey := &secp256k1.PubKey{Key: key.PubKey}
+		if _, err := keys.SaveOfflineKey(key.Name, pubKey); err != nil {
+			return fmt.Errorf("saving the public key of %s: %w", key.Name, err)
+		}
+	}
+	return nil
+}
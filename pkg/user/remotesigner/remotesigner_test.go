@@ -0,0 +1,191 @@
+package remotesigner_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v5/app"
+	"github.com/celestiaorg/celestia-app/v5/app/encoding"
+	"github.com/celestiaorg/celestia-app/v5/app/params"
+	"github.com/celestiaorg/celestia-app/v5/pkg/user"
+	"github.com/celestiaorg/celestia-app/v5/pkg/user/remotesigner"
+	"github.com/celestiaorg/celestia-app/v5/test/util/testfactory"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const chainID = "test-chain"
+
+func startRemoteSigner(t *testing.T, keys keyring.Keyring) *grpc.ClientConn {
+	lis := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	remotesigner.RegisterRemoteSignerServer(server, remotesigner.NewKeyringServer(keys, chainID))
+	go func() { _ = server.Serve(lis) }()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+		server.Stop()
+	})
+	return conn
+}
+
+func TestRemoteSigner(t *testing.T) {
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	hotKeys := testfactory.TestKeyring(enc.Codec, "alice")
+	conn := startRemoteSigner(t, hotKeys)
+
+	// the keyring of the remote signer client only holds the public keys
+	coldKeys := keyring.NewInMemory(enc.Codec)
+	require.NoError(t, remotesigner.ImportKeys(context.Background(), conn, coldKeys))
+	record, err := coldKeys.Key("alice")
+	require.NoError(t, err)
+	require.Equal(t, keyring.TypeOffline, record.GetType())
+
+	localSigner, err := user.NewSigner(hotKeys, enc.TxConfig, chainID, user.NewAccount("alice", 1, 5))
+	require.NoError(t, err)
+	remoteSigner, err := user.NewSigner(coldKeys, enc.TxConfig, chainID, user.NewAccount("alice", 1, 5))
+	require.NoError(t, err)
+
+	sender := localSigner.Account("alice").Address()
+	msg := bank.NewMsgSend(sender, testfactory.GetAddress(hotKeys, "alice"), sdk.NewCoins(sdk.NewInt64Coin(params.BondDenom, 10)))
+	opts := []user.TxOption{user.SetGasLimit(1e6), user.SetFee(1e6)}
+
+	pubKey, err := record.GetPubKey()
+	require.NoError(t, err)
+	multisigAcc := user.MultisigAccount{PubKey: kmultisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{pubKey}), AccountNumber: 2}
+	multisigMsg := bank.NewMsgSend(multisigAcc.Address(), sender, sdk.NewCoins(sdk.NewInt64Coin(params.BondDenom, 10)))
+	unsignedTx, err := localSigner.CreateUnsignedTx([]sdk.Msg{multisigMsg}, opts...)
+	require.NoError(t, err)
+
+	t.Run("offline keys can't sign without a remote signer", func(t *testing.T) {
+		_, _, err := remoteSigner.CreateTx([]sdk.Msg{msg}, opts...)
+		require.Error(t, err)
+	})
+
+	t.Run("remote signatures match local signatures", func(t *testing.T) {
+		remoteSigner.SetSigningBackend(remotesigner.NewBackend(conn, coldKeys))
+		want, _, err := localSigner.CreateTx([]sdk.Msg{msg}, opts...)
+		require.NoError(t, err)
+		got, _, err := remoteSigner.CreateTx([]sdk.Msg{msg}, opts...)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	})
+
+	t.Run("remote multisig signatures match local signatures", func(t *testing.T) {
+		want, err := localSigner.SignMultisigTx("alice", multisigAcc, unsignedTx)
+		require.NoError(t, err)
+		got, err := remoteSigner.SignMultisigTx("alice", multisigAcc, unsignedTx)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+		_, err = remoteSigner.CombineMultisigTx(multisigAcc, unsignedTx, got)
+		require.NoError(t, err)
+	})
+
+	t.Run("the remote signer refuses other chains", func(t *testing.T) {
+		otherChain, err := user.NewSigner(coldKeys, enc.TxConfig, "other-chain", user.NewAccount("alice", 1, 5))
+		require.NoError(t, err)
+		otherChain.SetSigningBackend(remotesigner.NewBackend(conn, coldKeys))
+		_, _, err = otherChain.CreateTx([]sdk.Msg{msg}, opts...)
+		require.Error(t, err)
+		_, err = otherChain.SignMultisigTx("alice", multisigAcc, unsignedTx)
+		require.Error(t, err)
+	})
+
+	t.Run("the chain ID of the sign doc is checked", func(t *testing.T) {
+		signDoc := sdktx.SignDoc{ChainId: "other-chain"}
+		signBytes, err := signDoc.Marshal()
+		require.NoError(t, err)
+		_, err = remotesigner.NewRemoteSignerClient(conn).Sign(context.Background(), &remotesigner.SignRequest{
+			Name:      "alice",
+			ChainId:   chainID,
+			SignBytes: signBytes,
+			SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = remotesigner.NewRemoteSignerClient(conn).Sign(context.Background(), &remotesigner.SignRequest{
+			Name:      "alice",
+			ChainId:   chainID,
+			SignBytes: []byte(`{"chain_id":"other-chain"}`),
+			SignMode:  signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("requests without a sign mode are signed in direct mode", func(t *testing.T) {
+		signDoc := sdktx.SignDoc{ChainId: chainID}
+		signBytes, err := signDoc.Marshal()
+		require.NoError(t, err)
+		resp, err := remotesigner.NewRemoteSignerClient(conn).Sign(context.Background(), &remotesigner.SignRequest{
+			Name:      "alice",
+			ChainId:   chainID,
+			SignBytes: signBytes,
+		})
+		require.NoError(t, err)
+		want, _, err := hotKeys.Sign("alice", signBytes, signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		require.Equal(t, want, resp.Signature)
+	})
+
+	t.Run("signatures of other keys are rejected", func(t *testing.T) {
+		// the remote signer holds another key under the same name
+		misconfigured := startRemoteSigner(t, testfactory.TestKeyring(enc.Codec, "alice"))
+		faulty, err := user.NewSigner(coldKeys, enc.TxConfig, chainID, user.NewAccount("alice", 1, 5))
+		require.NoError(t, err)
+		faulty.SetSigningBackend(remotesigner.NewBackend(misconfigured, coldKeys))
+		_, _, err = faulty.CreateTx([]sdk.Msg{msg}, opts...)
+		require.ErrorContains(t, err, "doesn't match the public key")
+	})
+
+	t.Run("signing times out", func(t *testing.T) {
+		blocked, err := user.NewSigner(coldKeys, enc.TxConfig, chainID, user.NewAccount("alice", 1, 5))
+		require.NoError(t, err)
+		blocked.SetSigningBackend(blockingBackend{})
+		blocked.SetSigningTimeout(10 * time.Millisecond)
+		_, _, err = blocked.CreateTx([]sdk.Msg{msg}, opts...)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		_, err = blocked.SignMultisigTx("alice", multisigAcc, unsignedTx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+// blockingBackend is a signing backend that never signs.
+type blockingBackend struct{}
+
+func (blockingBackend) Sign(ctx context.Context, _, _ string, _ []byte) ([]byte, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (b blockingBackend) SignWithMode(ctx context.Context, name, chainID string, _ signing.SignMode, signBytes []byte) ([]byte, error) {
+	return b.Sign(ctx, name, chainID, signBytes)
+}
+
+func TestKeyringServerRejectsNilRequests(t *testing.T) {
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	server := remotesigner.NewKeyringServer(testfactory.TestKeyring(enc.Codec, "alice"), chainID)
+	_, err := server.Keys(context.Background(), nil)
+	require.Error(t, err)
+	_, err = server.Sign(context.Background(), nil)
+	require.Error(t, err)
+	_, err = server.Sign(context.Background(), &remotesigner.SignRequest{Name: "bob", ChainId: chainID})
+	require.Error(t, err)
+}
@@ -0,0 +1,110 @@
+package remotesigner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ RemoteSignerServer = &KeyringServer{}
+
+// KeyringServer is an in-process RemoteSignerServer that signs with a local
+// keyring. It stands in for an external signer in tests and local setups.
+type KeyringServer struct {
+	keys    keyring.Keyring
+	chainID string
+}
+
+// NewKeyringServer returns a remote signer that signs with the secp256k1 keys
+// of the keyring. If chainID is not empty, it refuses to sign transactions
+// whose sign doc is for other chains.
+func NewKeyringServer(keys keyring.Keyring, chainID string) *KeyringServer {
+	return &KeyringServer{keys: keys, chainID: chainID}
+}
+
+// Keys implements RemoteSignerServer.
+func (s *KeyringServer) Keys(_ context.Context, req *KeysRequest) (*KeysResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	records, err := s.keys.List()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	keys := make([]*Key, 0, len(records))
+	for _, record := range records {
+		pubKey, err := record.GetPubKey()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if _, ok := pubKey.(*secp256k1.PubKey); !ok {
+			continue
+		}
+		keys = append(keys, &Key{Name: record.Name, PubKey: pubKey.Bytes()})
+	}
+	return &KeysResponse{Keys: keys}, nil
+}
+
+// Sign implements RemoteSignerServer.
+func (s *KeyringServer) Sign(_ context.Context, req *SignRequest) (*SignResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	// clients that predate the sign mode of the request sign in direct mode.
+	signMode := req.SignMode
+	if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
+		signMode = signing.SignMode_SIGN_MODE_DIRECT
+	}
+	// the chain ID of the request is only a hint so the one of the sign doc,
+	// which is what gets signed, is checked.
+	chainID, err := signDocChainID(signMode, req.SignBytes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("decoding the sign doc: %v", err))
+	}
+	if s.chainID != "" && chainID != s.chainID {
+		return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("signing for chain %q is not allowed", chainID))
+	}
+	if _, err := s.keys.Key(req.Name); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	signature, _, err := s.keys.Sign(req.Name, req.SignBytes, signMode)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &SignResponse{Signature: signature}, nil
+}
+
+// aminoSignDoc is the part of the JSON of an amino StdSignDoc that the server
+// checks. legacytx.StdSignDoc can't decode it as the amino JSON encoder writes
+// the account number and sequence as strings.
+type aminoSignDoc struct {
+	ChainID string `json:"chain_id"`
+}
+
+// signDocChainID returns the chain ID of the sign doc of the sign bytes. Only
+// the sign modes that the user.Signer signs with are supported.
+func signDocChainID(signMode signing.SignMode, signBytes []byte) (string, error) {
+	switch signMode {
+	case signing.SignMode_SIGN_MODE_DIRECT:
+		var signDoc sdktx.SignDoc
+		if err := signDoc.Unmarshal(signBytes); err != nil {
+			return "", err
+		}
+		return signDoc.ChainId, nil
+	case signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
+		var signDoc aminoSignDoc
+		if err := json.Unmarshal(signBytes, &signDoc); err != nil {
+			return "", err
+		}
+		return signDoc.ChainID, nil
+	default:
+		return "", fmt.Errorf("unsupported sign mode %s", signMode)
+	}
+}
@@ -0,0 +1,306 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// DefaultMaxResubmissions is the default number of times an evicted
+	// transaction is resubmitted.
+	DefaultMaxResubmissions = 5
+	// DefaultGasPriceMultiplier is the default factor that the gas price of an
+	// evicted transaction is bumped by on resubmission.
+	DefaultGasPriceMultiplier = 1.25
+	// DefaultMaxResubmissionGasPrice is the default gas price cap of the
+	// resubmitted transactions.
+	DefaultMaxResubmissionGasPrice = 100 * appconsts.DefaultMinGasPrice
+)
+
+// ResubmissionPolicy defines how the TxClient resubmits the transactions that
+// were evicted from the mempool. Evicted transactions are signed again with
+// the same messages at the sequence of the evicted transaction, with a bumped
+// gas price, and broadcast again until they are committed or the policy gives
+// up.
+type ResubmissionPolicy struct {
+	// MaxAttempts is the maximum number of times a transaction is
+	// resubmitted.
+	MaxAttempts int
+	// GasPriceMultiplier is the factor that the gas price is multiplied by on
+	// every resubmission. It must not be lower than one.
+	GasPriceMultiplier float64
+	// MaxGasPrice caps the bumped gas price. Transactions that already pay
+	// more are resubmitted at their original gas price. Zero means no cap.
+	MaxGasPrice float64
+	// OnAttempt is called after every resubmission attempt, if set. It must
+	// not block as confirming the transaction waits for it to return.
+	OnAttempt func(ResubmissionAttempt)
+}
+
+// ResubmissionAttempt reports a single resubmission of an evicted
+// transaction.
+type ResubmissionAttempt struct {
+	// Attempt is the number of the attempt starting from one.
+	Attempt int
+	// EvictedTxHash is the hash of the transaction that was evicted.
+	EvictedTxHash string
+	// TxHash is the hash of the resubmitted transaction. It is empty if the
+	// resubmission failed.
+	TxHash string
+	// GasPrice is the gas price of the resubmitted transaction.
+	GasPrice float64
+	// Err is the error that the resubmission failed with, if any.
+	Err error
+}
+
+// DefaultResubmissionPolicy returns the default resubmission policy.
+func DefaultResubmissionPolicy() ResubmissionPolicy {
+	return ResubmissionPolicy{
+		MaxAttempts:        DefaultMaxResubmissions,
+		GasPriceMultiplier: DefaultGasPriceMultiplier,
+		MaxGasPrice:        DefaultMaxResubmissionGasPrice,
+	}
+}
+
+// Validate returns an error if the policy is invalid.
+func (p ResubmissionPolicy) Validate() error {
+	if p.MaxAttempts <= 0 {
+		return fmt.Errorf("max attempts must be positive, got %d", p.MaxAttempts)
+	}
+	if p.GasPriceMultiplier < 1 {
+		return fmt.Errorf("gas price multiplier must not be lower than one, got %v", p.GasPriceMultiplier)
+	}
+	if p.MaxGasPrice < 0 {
+		return fmt.Errorf("max gas price must not be negative, got %v", p.MaxGasPrice)
+	}
+	return nil
+}
+
+// nextGasPrice returns the gas price that a transaction paying the provided
+// gas price is resubmitted with.
+func (p ResubmissionPolicy) nextGasPrice(gasPrice float64) float64 {
+	next := gasPrice * p.GasPriceMultiplier
+	if p.MaxGasPrice == 0 || next <= p.MaxGasPrice {
+		return next
+	}
+	return math.Max(gasPrice, p.MaxGasPrice)
+}
+
+// WithResubmissionPolicy enables the resubmission of the transactions that
+// are evicted from the mempool while they are confirmed.
+func WithResubmissionPolicy(policy ResubmissionPolicy) Option {
+	return func(c *TxClient) {
+		if err := policy.Validate(); err != nil {
+			panic(err)
+		}
+		c.resubmissionPolicy = &policy
+	}
+}
+
+// txRequest holds what is needed to sign a broadcast transaction again.
+type txRequest struct {
+	msgs []sdktypes.Msg
+	// blobs are set if the transaction pays for blobs, in which case msgs is
+	// empty.
+	blobs    []*share.Blob
+	opts     []TxOption
+	gasLimit uint64
+	gasPrice float64
+	// attempt is the number of times the transaction was resubmitted.
+	attempt int
+}
+
+// newTxRequest returns the request of a transaction that was signed with the
+// provided messages or blobs and options. The gas limit and price are read
+// from the signed transaction.
+func newTxRequest(tx sdktypes.FeeTx, msgs []sdktypes.Msg, blobs []*share.Blob, opts []TxOption) *txRequest {
+	req := &txRequest{
+		msgs:     msgs,
+		blobs:    blobs,
+		opts:     opts,
+		gasLimit: tx.GetGas(),
+	}
+	if req.gasLimit > 0 {
+		req.gasPrice = float64(tx.GetFee().AmountOf(appconsts.BondDenom).Uint64()) / float64(req.gasLimit)
+	}
+	return req
+}
+
+// sign signs the request with the current sequence of the account and returns
+// the encoded transaction.
+func (client *TxClient) sign(account string, req *txRequest) ([]byte, error) {
+	opts := append(append([]TxOption{}, req.opts...), SetGasLimitAndGasPrice(req.gasLimit, req.gasPrice))
+	if len(req.blobs) > 0 {
+		txBytes, _, err := client.signer.CreatePayForBlobs(account, req.blobs, opts...)
+		return txBytes, err
+	}
+	tx, _, _, err := client.signer.SignTx(req.msgs, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return client.signer.EncodeTx(tx)
+}
+
+// trackRequest attaches the request to the tracked transaction so that it can
+// be resubmitted if it gets evicted. It is a no-op if no resubmission policy
+// is set. The caller must hold the lock.
+func (client *TxClient) trackRequest(txHash string, req *txRequest) {
+	if client.resubmissionPolicy == nil {
+		return
+	}
+	info, exists := client.txTracker[txHash]
+	if !exists {
+		return
+	}
+	info.request = req
+	client.txTracker[txHash] = info
+}
+
+// payForBlobsRequest returns the request of the provided signed blob
+// transaction.
+func (client *TxClient) payForBlobsRequest(blobTxBytes []byte, blobs []*share.Blob, opts []TxOption) (*txRequest, error) {
+	bTx, _, err := blobtx.UnmarshalBlobTx(blobTxBytes)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := client.signer.DecodeTx(bTx.Tx)
+	if err != nil {
+		return nil, err
+	}
+	return newTxRequest(tx, nil, blobs, opts), nil
+}
+
+// resubmitEvicted resubmits the evicted transaction following the
+// resubmission policy, reports the attempt and returns the hash of the
+// resubmitted transaction.
+func (client *TxClient) resubmitEvicted(ctx context.Context, txHash string) (string, error) {
+	attempt, err := client.resubmit(ctx, txHash)
+	if attempt != nil && client.resubmissionPolicy.OnAttempt != nil {
+		client.resubmissionPolicy.OnAttempt(*attempt)
+	}
+	if err != nil {
+		return "", err
+	}
+	return attempt.TxHash, nil
+}
+
+// resubmit rolls the sequence back to the one of the evicted transaction then
+// signs it again with a bumped gas price and broadcasts it. The returned
+// attempt is nil if no resubmission was attempted.
+//
+// The lock is only held while the sequence is read or written, not while the
+// transaction is broadcast or the broadcast is retried. Concurrent evictions of
+// transactions of the same signer roll the sequence back to the lowest evicted
+// sequence. The node keeps the sequence of the evicted transaction in its check
+// state until the next block is committed so the broadcast is retried after
+// the poll time if it fails with a sequence mismatch.
+func (client *TxClient) resubmit(ctx context.Context, txHash string) (*ResubmissionAttempt, error) {
+	client.mtx.Lock()
+	info, err := client.rollbackEvictedTx(txHash)
+	client.mtx.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if info.request == nil {
+		return nil, errors.New("tx was evicted from the mempool")
+	}
+	if info.request.attempt >= client.resubmissionPolicy.MaxAttempts {
+		return nil, fmt.Errorf("tx was evicted from the mempool after %d resubmissions", info.request.attempt)
+	}
+
+	req := *info.request
+	req.attempt++
+	req.gasPrice = client.resubmissionPolicy.nextGasPrice(req.gasPrice)
+	attempt := &ResubmissionAttempt{
+		Attempt:       req.attempt,
+		EvictedTxHash: txHash,
+		GasPrice:      req.gasPrice,
+	}
+
+	for retries := 0; ; retries++ {
+		attempt.TxHash, err = client.signAndBroadcast(ctx, info.signer, &req)
+		if err == nil {
+			return attempt, nil
+		}
+		if !isSequenceMismatch(err) || retries >= maxSequenceMismatchRetries {
+			attempt.Err = err
+			return attempt, fmt.Errorf("resubmitting evicted tx %s: %w", txHash, err)
+		}
+		select {
+		case <-ctx.Done():
+			attempt.Err = ctx.Err()
+			return attempt, ctx.Err()
+		case <-time.After(client.pollTime):
+		}
+	}
+}
+
+// maxSequenceMismatchRetries is the number of times the broadcast of a
+// resubmitted transaction is retried if it fails with a sequence mismatch.
+const maxSequenceMismatchRetries = 5
+
+// signAndBroadcast signs the request with the current sequence of the account,
+// broadcasts it and tracks it for resubmission. It returns the hash of the
+// broadcast transaction.
+func (client *TxClient) signAndBroadcast(ctx context.Context, account string, req *txRequest) (string, error) {
+	resp, err := client.signAndBroadcastWith(ctx, account, func() ([]byte, *txRequest, error) {
+		txBytes, err := client.sign(account, req)
+		return txBytes, req, err
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.TxHash, nil
+}
+
+// signFn signs a transaction with the current sequence of the account and
+// returns it with the request that it can be resubmitted from, which may be
+// nil.
+type signFn func() ([]byte, *txRequest, error)
+
+// signAndBroadcastWith signs a transaction with sign and broadcasts it. The
+// lock is only held while the transaction is signed, so the transactions of
+// other accounts can be signed and broadcast in the meantime.
+func (client *TxClient) signAndBroadcastWith(ctx context.Context, account string, sign signFn) (*sdktypes.TxResponse, error) {
+	client.mtx.Lock()
+	txBytes, req, err := sign()
+	if err != nil {
+		client.mtx.Unlock()
+		return nil, err
+	}
+	sequence := client.signer.Account(account).Sequence()
+	hash := txHash(txBytes)
+	if err := client.storeTx(hash, txBytes, account); err != nil {
+		client.mtx.Unlock()
+		return nil, fmt.Errorf("storing tx %s: %w", hash, err)
+	}
+	if err := client.signer.IncrementSequence(account); err != nil {
+		_ = client.unstoreTx(hash)
+		client.mtx.Unlock()
+		return nil, fmt.Errorf("increment sequencing: %w", err)
+	}
+	client.mtx.Unlock()
+
+	resp, err := client.broadcastSigned(ctx, txBytes, account, sequence, req)
+	if err != nil {
+		_ = client.unstoreTx(hash)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// isSequenceMismatch returns true if the error is a broadcast error caused by
+// an incorrect account sequence.
+func isSequenceMismatch(err error) bool {
+	var broadcastTxErr *BroadcastTxError
+	return errors.As(err, &broadcastTxErr) && broadcastTxErr.Code == sdkerrors.ErrWrongSequence.ABCICode()
+}
@@ -0,0 +1,59 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResubmissionPolicyValidate(t *testing.T) {
+	require.NoError(t, DefaultResubmissionPolicy().Validate())
+
+	testCases := []struct {
+		name   string
+		modify func(p *ResubmissionPolicy)
+	}{
+		{"zero max attempts", func(p *ResubmissionPolicy) { p.MaxAttempts = 0 }},
+		{"multiplier lower than one", func(p *ResubmissionPolicy) { p.GasPriceMultiplier = 0.9 }},
+		{"negative max gas price", func(p *ResubmissionPolicy) { p.MaxGasPrice = -1 }},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policy := DefaultResubmissionPolicy()
+			tc.modify(&policy)
+			assert.Error(t, policy.Validate())
+		})
+	}
+}
+
+func TestResubmissionPolicyNextGasPrice(t *testing.T) {
+	policy := ResubmissionPolicy{MaxAttempts: 1, GasPriceMultiplier: 2, MaxGasPrice: 10}
+	assert.Equal(t, float64(4), policy.nextGasPrice(2))
+	// the bumped gas price is capped
+	assert.Equal(t, float64(10), policy.nextGasPrice(6))
+	// gas prices above the cap are not lowered
+	assert.Equal(t, float64(12), policy.nextGasPrice(12))
+
+	policy.MaxGasPrice = 0
+	assert.Equal(t, float64(200), policy.nextGasPrice(100))
+}
+
+// TestRollbackEvictedTxs tests that evictions of transactions of the same
+// signer, in any order, roll the sequence back to the lowest evicted sequence.
+func TestRollbackEvictedTxs(t *testing.T) {
+	client := &TxClient{
+		signer: &Signer{accounts: map[string]*Account{"a": NewAccount("a", 1, 8)}},
+		txTracker: map[string]txInfo{
+			"5": {sequence: 5, signer: "a"},
+			"6": {sequence: 6, signer: "a"},
+			"7": {sequence: 7, signer: "a"},
+		},
+	}
+	for _, txHash := range []string{"7", "5", "6"} {
+		_, err := client.rollbackEvictedTx(txHash)
+		require.NoError(t, err)
+	}
+	assert.EqualValues(t, 5, client.signer.Account("a").Sequence())
+	assert.Empty(t, client.txTracker)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/core/address"
 	"github.com/celestiaorg/celestia-app/v5/app/grpc/gasestimation"
@@ -23,15 +24,23 @@ import (
 
 var defaultSignMode = signing.SignMode_SIGN_MODE_DIRECT
 
+// DefaultSigningTimeout is the default time that the signing backend has to
+// sign a transaction.
+const DefaultSigningTimeout = 30 * time.Second
+
 // Signer is struct for building and signing Celestia transactions
 // It supports multiple accounts wrapping a Keyring.
 // NOTE: All transactions may only have a single signer
 // Signer is not thread-safe.
 type Signer struct {
-	keys         keyring.Keyring
-	enc          client.TxConfig
-	addressCodec address.Codec
-	chainID      string
+	keys    keyring.Keyring
+	backend SigningBackend
+	// signingTimeout bounds every call to the backend, which may be remote,
+	// as the TxClient holds its lock while signing.
+	signingTimeout time.Duration
+	enc            client.TxConfig
+	addressCodec   address.Codec
+	chainID        string
 	// set of accounts that the signer can manage. Should match the keys on the keyring
 	accounts            map[string]*Account
 	addressToAccountMap map[string]string
@@ -43,6 +52,8 @@ type Signer struct {
 func NewSigner(keys keyring.Keyring, encCfg client.TxConfig, chainID string, accounts ...*Account) (*Signer, error) {
 	s := &Signer{
 		keys:                keys,
+		backend:             NewKeyringSigningBackend(keys),
+		signingTimeout:      DefaultSigningTimeout,
 		chainID:             chainID,
 		enc:                 encCfg,
 		addressCodec:        addresscodec.NewBech32Codec(params.Bech32PrefixAccAddr),
@@ -336,11 +347,30 @@ func (s *Signer) createSignature(builder client.TxBuilder, account *Account, seq
 	if err != nil {
 		return nil, fmt.Errorf("error getting sign bytes: %w", err)
 	}
-	signature, _, err := s.keys.Sign(account.name, bytesToSign, defaultSignMode)
+	return s.sign(account.name, defaultSignMode, bytesToSign)
+}
+
+// sign signs the sign bytes with the signing backend, which has the signing
+// timeout of the signer to do so. Sign modes other than SIGN_MODE_DIRECT
+// require a SignModeSigningBackend.
+func (s *Signer) sign(name string, signMode signing.SignMode, signBytes []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.signingTimeout)
+	defer cancel()
+	var (
+		signature []byte
+		err       error
+	)
+	switch backend, ok := s.backend.(SignModeSigningBackend); {
+	case signMode == defaultSignMode:
+		signature, err = s.backend.Sign(ctx, name, s.chainID, signBytes)
+	case ok:
+		signature, err = backend.SignWithMode(ctx, name, s.chainID, signMode, signBytes)
+	default:
+		return nil, fmt.Errorf("the signing backend doesn't support sign mode %s", signMode)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error signing bytes: %w", err)
 	}
-
 	return signature, nil
 }
 
@@ -0,0 +1,67 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// SigningBackend signs the transactions of a Signer. The Signer builds the
+// transactions and handles the accounts and their sequences, the backend only
+// signs their sign bytes. The default backend signs with the keyring of the
+// Signer.
+type SigningBackend interface {
+	// Sign returns the signature of the sign bytes by the key of the account.
+	Sign(ctx context.Context, name, chainID string, signBytes []byte) ([]byte, error)
+}
+
+// SignModeSigningBackend is a SigningBackend that can also sign the sign bytes
+// of other sign modes than SIGN_MODE_DIRECT. The Signer needs it to sign the
+// partial signatures of multisig transactions, which use
+// SIGN_MODE_LEGACY_AMINO_JSON.
+type SignModeSigningBackend interface {
+	SigningBackend
+	// SignWithMode returns the signature of the sign bytes, which were built
+	// with the sign mode, by the key of the account.
+	SignWithMode(ctx context.Context, name, chainID string, signMode signing.SignMode, signBytes []byte) ([]byte, error)
+}
+
+var _ SignModeSigningBackend = &KeyringSigningBackend{}
+
+// KeyringSigningBackend is a SigningBackend that signs with a local keyring.
+type KeyringSigningBackend struct {
+	keys keyring.Keyring
+}
+
+// NewKeyringSigningBackend returns a SigningBackend that signs with the keys of
+// the keyring.
+func NewKeyringSigningBackend(keys keyring.Keyring) *KeyringSigningBackend {
+	return &KeyringSigningBackend{keys: keys}
+}
+
+// Sign implements SigningBackend.
+func (b *KeyringSigningBackend) Sign(ctx context.Context, name, chainID string, signBytes []byte) ([]byte, error) {
+	return b.SignWithMode(ctx, name, chainID, defaultSignMode, signBytes)
+}
+
+// SignWithMode implements SignModeSigningBackend.
+func (b *KeyringSigningBackend) SignWithMode(_ context.Context, name, _ string, signMode signing.SignMode, signBytes []byte) ([]byte, error) {
+	signature, _, err := b.keys.Sign(name, signBytes, signMode)
+	return signature, err
+}
+
+// SetSigningBackend sets the backend that signs the transactions of the
+// signer. The keyring of the signer is still used to look up the addresses and
+// public keys of the accounts, so with a remote backend it only needs to hold
+// the public keys, e.g. saved with keyring.SaveOfflineKey.
+func (s *Signer) SetSigningBackend(backend SigningBackend) {
+	s.backend = backend
+}
+
+// SetSigningTimeout sets the time that the signing backend has to sign a
+// transaction. The default is DefaultSigningTimeout.
+func (s *Signer) SetSigningTimeout(timeout time.Duration) {
+	s.signingTimeout = timeout
+}
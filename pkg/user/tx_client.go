@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -14,7 +15,6 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/celestiaorg/celestia-app/v5/app/encoding"
 	"github.com/celestiaorg/celestia-app/v5/app/grpc/gasestimation"
-	"github.com/celestiaorg/celestia-app/v5/app/grpc/tx"
 	"github.com/celestiaorg/celestia-app/v5/app/params"
 	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v5/x/blob/types"
@@ -32,11 +32,16 @@ import (
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
 	DefaultPollTime          = 3 * time.Second
 	txTrackerPruningInterval = 10 * time.Minute
+	// blobParamsRefreshInterval is how long the x/blob params used to
+	// estimate the gas of PFBs are cached before they are queried again.
+	blobParamsRefreshInterval = 10 * time.Minute
 )
 
 type Option func(client *TxClient)
@@ -47,6 +52,12 @@ type txInfo struct {
 	sequence  uint64
 	signer    string
 	timestamp time.Time
+	// request is used to resubmit the transaction if it gets evicted. It is
+	// only set if the TxClient has a resubmission policy.
+	request *txRequest
+	// conn is the connection of the endpoint that accepted the transaction.
+	// It is nil for the transactions that were reconciled from the tx store.
+	conn *grpc.ClientConn
 }
 
 // TxResponse is a response from the chain after
@@ -125,8 +136,10 @@ func WithEstimatorService(conn *grpc.ClientConn) Option {
 }
 
 // WithAdditionalCoreEndpoints adds additional core endpoints to the TxClient.
-// For transaction submission, the client will attempt to use the primary endpoint
-// and the first two additional endpoints provided via this option.
+// The client uses the primary endpoint and the first two additional endpoints
+// provided via this option. Queries and confirmations are routed to the healthy
+// endpoint with the lowest latency and fall back to the other endpoints if it is
+// unreachable. See WithBroadcastMode for how transactions are broadcast.
 func WithAdditionalCoreEndpoints(conns []*grpc.ClientConn) Option {
 	return func(c *TxClient) {
 		c.conns = append(c.conns, conns...)
@@ -144,6 +157,10 @@ type TxClient struct {
 	registry codectypes.InterfaceRegistry
 	// list of core endpoints for tx submission (primary + additionals)
 	conns []*grpc.ClientConn
+	// endpoints tracks the health of the conns and routes the requests.
+	endpoints *endpoints
+	// broadcastMode defines which endpoints the transactions are broadcast to.
+	broadcastMode BroadcastMode
 	// how often to poll the network for confirmation of a transaction
 	pollTime time.Duration
 	// sets the default account with which to submit transactions
@@ -151,8 +168,27 @@ type TxClient struct {
 	defaultAddress sdktypes.AccAddress
 	// txTracker maps the tx hash to the Sequence and signer of the transaction
 	// that was submitted to the chain
-	txTracker           map[string]txInfo
+	txTracker map[string]txInfo
+	// gasEstimationClient is the gas estimation service set with
+	// WithEstimatorService. If it is nil, the core endpoints are used.
 	gasEstimationClient gasestimation.GasEstimatorClient
+	// resubmissionPolicy defines how evicted transactions are resubmitted.
+	// Evicted transactions are not resubmitted if it is nil.
+	resubmissionPolicy *ResubmissionPolicy
+	// lanes are the worker accounts that SubmitPayForBlob spreads its
+	// transactions across. It is nil if the lanes are not set up.
+	lanes *lanes
+	// txStore persists the in-flight transactions. It is nil if the
+	// transactions are only tracked in memory.
+	txStore TxStore
+	// confirmer resolves the transactions from the committed tx events. It is
+	// nil if the transactions are confirmed by polling their status.
+	confirmer *txConfirmer
+	// blobParams caches the x/blob params that gasParams returns. It is nil
+	// until they are queried successfully.
+	blobParams *types.Params
+	// blobParamsQueried is when blobParams was last queried.
+	blobParamsQueried time.Time
 }
 
 // NewTxClient returns a new signer using the provided keyring
@@ -177,15 +213,14 @@ func NewTxClient(
 		return nil, err
 	}
 	txClient := &TxClient{
-		signer:              signer,
-		registry:            registry,
-		conns:               []*grpc.ClientConn{conn},
-		pollTime:            DefaultPollTime,
-		defaultAccount:      records[0].Name,
-		defaultAddress:      addr,
-		txTracker:           make(map[string]txInfo),
-		cdc:                 cdc,
-		gasEstimationClient: gasestimation.NewGasEstimatorClient(conn),
+		signer:         signer,
+		registry:       registry,
+		conns:          []*grpc.ClientConn{conn},
+		pollTime:       DefaultPollTime,
+		defaultAccount: records[0].Name,
+		defaultAddress: addr,
+		txTracker:      make(map[string]txInfo),
+		cdc:            cdc,
 	}
 
 	for _, opt := range options {
@@ -196,12 +231,17 @@ func NewTxClient(
 	if len(txClient.conns) > 3 {
 		txClient.conns = txClient.conns[:3]
 	}
+	txClient.endpoints = newEndpoints(txClient.conns)
 
 	return txClient, nil
 }
 
 // SetupTxClient uses the underlying grpc connection to populate the chainID, accountNumber and sequence number of all
 // the accounts in the keyring.
+//
+// If the client has a tx store, the stored transactions are reconciled with ReconcileTxs. The transactions that are
+// still in flight are tracked again, so right after setup InFlightTxs returns exactly their hashes and they can be
+// confirmed with ConfirmTx.
 func SetupTxClient(
 	ctx context.Context,
 	keys keyring.Keyring,
@@ -217,7 +257,12 @@ func SetupTxClient(
 		return nil, err
 	}
 
-	chainID := resp.SdkBlock.Header.ChainID
+	// nodes that run app versions before v4 only return the deprecated
+	// cometbft block.
+	chainID := resp.Block.GetHeader().ChainID //nolint:staticcheck
+	if resp.SdkBlock != nil {
+		chainID = resp.SdkBlock.Header.ChainID
+	}
 
 	records, err := keys.List()
 	if err != nil {
@@ -244,12 +289,24 @@ func SetupTxClient(
 		return nil, fmt.Errorf("failed to create signer: %w", err)
 	}
 
-	return NewTxClient(encCfg.Codec, signer, conn, encCfg.InterfaceRegistry, options...)
+	txClient, err := NewTxClient(encCfg.Codec, signer, conn, encCfg.InterfaceRegistry, options...)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := txClient.ReconcileTxs(ctx); err != nil {
+		return nil, fmt.Errorf("reconciling the stored txs: %w", err)
+	}
+	return txClient, nil
 }
 
 // SubmitPayForBlob forms a transaction from the provided blobs, signs it, and submits it to the chain.
 // TxOptions may be provided to set the fee and gas limit.
+// If the submission lanes are set up, the transaction is signed by the first idle lane instead
+// of the default account. See SetupLanes.
 func (client *TxClient) SubmitPayForBlob(ctx context.Context, blobs []*share.Blob, opts ...TxOption) (*TxResponse, error) {
+	if l := client.getLanes(); l != nil {
+		return client.submitPayForBlobOnLane(ctx, l, blobs, opts...)
+	}
 	resp, err := client.BroadcastPayForBlob(ctx, blobs, opts...)
 	if err != nil {
 		return nil, err
@@ -278,31 +335,95 @@ func (client *TxClient) BroadcastPayForBlob(ctx context.Context, blobs []*share.
 }
 
 func (client *TxClient) BroadcastPayForBlobWithAccount(ctx context.Context, account string, blobs []*share.Blob, opts ...TxOption) (*sdktypes.TxResponse, error) {
+	params, gasPrice := client.gasParams(ctx), client.minGasPrice(ctx)
+
 	client.mtx.Lock()
 	defer client.mtx.Unlock()
 	if err := client.checkAccountLoaded(ctx, account); err != nil {
 		return nil, err
 	}
 
-	blobSizes := make([]uint32, len(blobs))
-	for i, blob := range blobs {
-		blobSizes[i] = uint32(len(blob.Data()))
+	opts = payForBlobOptions(blobs, params, gasPrice, opts)
+	txBytes, _, err := client.signer.CreatePayForBlobs(account, blobs, opts...)
+	if err != nil {
+		return nil, err
 	}
 
-	gasLimit := uint64(float64(types.DefaultEstimateGas(blobSizes)))
-	fee := uint64(math.Ceil(appconsts.DefaultMinGasPrice * float64(gasLimit)))
-	// prepend calculated params, so it can be overwritten in case the user has specified it.
-	opts = append([]TxOption{SetGasLimit(gasLimit), SetFee(fee)}, opts...)
-
-	txBytes, _, err := client.signer.CreatePayForBlobs(account, blobs, opts...)
+	resp, err := client.broadcast(ctx, txBytes, account)
 	if err != nil {
 		return nil, err
 	}
+	if client.resubmissionPolicy != nil {
+		req, err := client.payForBlobsRequest(txBytes, blobs, opts)
+		if err != nil {
+			return nil, err
+		}
+		client.trackRequest(resp.TxHash, req)
+	}
+	return resp, nil
+}
+
+// gasParams returns the x/blob params that the gas of PFBs is estimated with.
+// The namespace gas overrides of the params are taken into account if the
+// params can be queried. Otherwise, the system defaults are used. The params
+// are cached for blobParamsRefreshInterval and queried without holding the
+// lock, so the caller must not hold client.mtx.
+func (client *TxClient) gasParams(ctx context.Context) types.Params {
+	client.mtx.Lock()
+	cached, queried := client.blobParams, client.blobParamsQueried
+	client.mtx.Unlock()
+	if cached != nil && time.Since(queried) <= blobParamsRefreshInterval {
+		return *cached
+	}
+
+	var resp *types.QueryParamsResponse
+	err := client.query(ctx, func(conn *grpc.ClientConn) (err error) {
+		resp, err = types.NewQueryClient(conn).Params(ctx, &types.QueryParamsRequest{})
+		return err
+	})
+	if err != nil {
+		if cached != nil {
+			return *cached
+		}
+		return types.DefaultParams()
+	}
 
-	if len(client.conns) > 1 {
-		return client.broadcastMulti(ctx, txBytes, account)
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	client.blobParams = &resp.Params
+	client.blobParamsQueried = time.Now()
+	return resp.Params
+}
+
+// minGasPrice returns the gas price of the fees that the client sets itself:
+// the network min gas price, which follows the dynamic network min gas price
+// if it is enabled, but no less than the default min gas price. The default
+// min gas price is used if the network min gas price can't be queried.
+func (client *TxClient) minGasPrice(ctx context.Context) float64 {
+	var networkMinGasPrice float64
+	err := client.query(ctx, func(conn *grpc.ClientConn) (err error) {
+		networkMinGasPrice, err = QueryNetworkMinGasPrice(ctx, conn)
+		return err
+	})
+	if err != nil {
+		return appconsts.DefaultMinGasPrice
+	}
+	return max(networkMinGasPrice, appconsts.DefaultMinGasPrice)
+}
+
+// payForBlobOptions prepends the estimated gas limit of a PFB paying for the
+// blobs and its fee at the gas price to the options, so that they can be
+// overwritten in case the user has specified them.
+func payForBlobOptions(blobs []*share.Blob, params types.Params, gasPrice float64, opts []TxOption) []TxOption {
+	namespaces := make([][]byte, len(blobs))
+	blobSizes := make([]uint32, len(blobs))
+	for i, blob := range blobs {
+		namespaces[i] = blob.Namespace().Bytes()
+		blobSizes[i] = uint32(len(blob.Data()))
 	}
-	return client.broadcastTx(ctx, client.conns[0], txBytes, account)
+	gasLimit := types.DefaultEstimateGasForNamespaces(namespaces, blobSizes, params)
+	fee := uint64(math.Ceil(gasPrice * float64(gasLimit)))
+	return append([]TxOption{SetGasLimit(gasLimit), SetFee(fee)}, opts...)
 }
 
 // SubmitTx forms a transaction from the provided messages, signs it, and submits it to the chain. TxOptions
@@ -375,13 +496,104 @@ func (client *TxClient) BroadcastTx(ctx context.Context, msgs []sdktypes.Msg, op
 		return nil, err
 	}
 
-	if len(client.conns) > 1 {
-		return client.broadcastMulti(ctx, txBytes, account)
+	resp, err := client.broadcast(ctx, txBytes, account)
+	if err != nil {
+		return nil, err
+	}
+	client.trackRequest(resp.TxHash, newTxRequest(txBuilder.GetTx(), msgs, nil, opts))
+	return resp, nil
+}
+
+// broadcast broadcasts the transaction according to the broadcast mode of the
+// client. The transaction is persisted before it is broadcast if the client
+// has a store.
+func (client *TxClient) broadcast(ctx context.Context, txBytes []byte, signer string) (*sdktypes.TxResponse, error) {
+	hash := txHash(txBytes)
+	if err := client.storeTx(hash, txBytes, signer); err != nil {
+		return nil, fmt.Errorf("storing tx %s: %w", hash, err)
+	}
+
+	resp, err := client.broadcastWith(ctx, func(ctx context.Context, conn *grpc.ClientConn) (*sdktypes.TxResponse, error) {
+		return client.broadcastTx(ctx, conn, txBytes, signer)
+	})
+	if err != nil {
+		_ = client.unstoreTx(hash)
+		return nil, err
 	}
-	return client.broadcastTx(ctx, client.conns[0], txBytes, account)
+	return resp, nil
+}
+
+// sendFn sends a transaction to the connection.
+type sendFn func(ctx context.Context, conn *grpc.ClientConn) (*sdktypes.TxResponse, error)
+
+// broadcastSigned broadcasts the transaction that was signed with the sequence
+// of the signer without holding the lock and tracks it. The caller must have
+// moved the sequence of the signer past the sequence of the transaction so that
+// other transactions can be signed in the meantime. If the broadcast fails, the
+// sequence is rolled back.
+func (client *TxClient) broadcastSigned(ctx context.Context, txBytes []byte, signer string, sequence uint64, req *txRequest) (*sdktypes.TxResponse, error) {
+	resp, err := client.broadcastWith(ctx, func(ctx context.Context, conn *grpc.ClientConn) (*sdktypes.TxResponse, error) {
+		resp, err := broadcastTxBytes(ctx, conn, txBytes)
+		if err != nil {
+			return nil, err
+		}
+		client.mtx.Lock()
+		defer client.mtx.Unlock()
+		client.txTracker[resp.TxHash] = txInfo{
+			sequence:  sequence,
+			signer:    signer,
+			timestamp: time.Now(),
+			request:   req,
+			conn:      conn,
+		}
+		return resp, nil
+	})
+	if err != nil {
+		client.mtx.Lock()
+		defer client.mtx.Unlock()
+		if rollbackErr := client.rollbackSequence(signer, sequence); rollbackErr != nil {
+			return nil, errors.Join(err, rollbackErr)
+		}
+		return nil, err
+	}
+	return resp, nil
+}
+
+// broadcastWith sends a transaction to the endpoints according to the
+// broadcast mode of the client.
+func (client *TxClient) broadcastWith(ctx context.Context, send sendFn) (*sdktypes.TxResponse, error) {
+	if client.broadcastMode == BroadcastToAll && len(client.conns) > 1 {
+		return client.broadcastMulti(ctx, send)
+	}
+	return client.broadcastToBest(ctx, send)
 }
 
 func (client *TxClient) broadcastTx(ctx context.Context, conn *grpc.ClientConn, txBytes []byte, signer string) (*sdktypes.TxResponse, error) {
+	resp, err := broadcastTxBytes(ctx, conn, txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// save the sequence and signer of the transaction in the local txTracker
+	// before the sequence is incremented
+	client.txTracker[resp.TxHash] = txInfo{
+		sequence:  client.signer.accounts[signer].Sequence(),
+		signer:    signer,
+		timestamp: time.Now(),
+		conn:      conn,
+	}
+
+	// after the transaction has been submitted, we can increment the
+	// sequence of the signer
+	if err := client.signer.IncrementSequence(signer); err != nil {
+		return nil, fmt.Errorf("increment sequencing: %w", err)
+	}
+	return resp, nil
+}
+
+// broadcastTxBytes broadcasts the transaction to the connection and returns a
+// BroadcastTxError if it is rejected by the node.
+func broadcastTxBytes(ctx context.Context, conn *grpc.ClientConn, txBytes []byte) (*sdktypes.TxResponse, error) {
 	txClient := sdktx.NewServiceClient(conn)
 	resp, err := txClient.BroadcastTx(
 		ctx,
@@ -401,26 +613,12 @@ func (client *TxClient) broadcastTx(ctx context.Context, conn *grpc.ClientConn,
 		}
 		return nil, broadcastTxErr
 	}
-
-	// save the sequence and signer of the transaction in the local txTracker
-	// before the sequence is incremented
-	client.txTracker[resp.TxResponse.TxHash] = txInfo{
-		sequence:  client.signer.accounts[signer].Sequence(),
-		signer:    signer,
-		timestamp: time.Now(),
-	}
-
-	// after the transaction has been submitted, we can increment the
-	// sequence of the signer
-	if err := client.signer.IncrementSequence(signer); err != nil {
-		return nil, fmt.Errorf("increment sequencing: %w", err)
-	}
 	return resp.TxResponse, nil
 }
 
 // broadcastMulti broadcasts the transaction to multiple connections concurrently
 // and returns the response from the first successful broadcast.
-func (client *TxClient) broadcastMulti(ctx context.Context, txBytes []byte, signer string) (*sdktypes.TxResponse, error) {
+func (client *TxClient) broadcastMulti(ctx context.Context, send sendFn) (*sdktypes.TxResponse, error) {
 	respCh := make(chan *sdktypes.TxResponse, 1)
 	errCh := make(chan error, len(client.conns))
 
@@ -428,13 +626,17 @@ func (client *TxClient) broadcastMulti(ctx context.Context, txBytes []byte, sign
 	defer cancel()
 
 	var wg sync.WaitGroup
-	wg.Add(len(client.conns))
+	wg.Add(len(client.endpoints.list))
 
-	for _, conn := range client.conns {
-		go func(conn *grpc.ClientConn) {
+	for _, ep := range client.endpoints.list {
+		go func(ep *endpoint) {
 			defer wg.Done()
 
-			resp, err := client.broadcastTx(ctx, conn, txBytes, signer)
+			start := time.Now()
+			resp, err := send(ctx, ep.conn)
+			if ctx.Err() == nil {
+				client.endpoints.record(ep, time.Since(start), err)
+			}
 			if err != nil {
 				errCh <- err
 				return
@@ -446,7 +648,7 @@ func (client *TxClient) broadcastMulti(ctx context.Context, txBytes []byte, sign
 				cancel()
 			case <-ctx.Done():
 			}
-		}(conn)
+		}(ep)
 	}
 
 	// Wait for all attempts to finish
@@ -467,11 +669,26 @@ func (client *TxClient) broadcastMulti(ctx context.Context, txBytes []byte, sign
 	return nil, errors.Join(errs...)
 }
 
+// broadcastToBest broadcasts the transaction to the best endpoint, falling
+// back to the next endpoints if it is unreachable.
+func (client *TxClient) broadcastToBest(ctx context.Context, send sendFn) (*sdktypes.TxResponse, error) {
+	var resp *sdktypes.TxResponse
+	err := client.query(ctx, func(conn *grpc.ClientConn) (err error) {
+		resp, err = send(ctx, conn)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 // pruneTxTracker removes transactions from the local tx tracker that are older than 10 minutes
 func (client *TxClient) pruneTxTracker() {
 	for hash, txInfo := range client.txTracker {
 		if time.Since(txInfo.timestamp) >= txTrackerPruningInterval {
 			delete(client.txTracker, hash)
+			_ = client.unstoreTx(hash)
 		}
 	}
 }
@@ -479,14 +696,39 @@ func (client *TxClient) pruneTxTracker() {
 // ConfirmTx periodically pings the provided node for the commitment of a transaction by its
 // hash. It will continually loop until the context is cancelled, the tx is found or an error
 // is encountered.
+// If the TxClient has a resubmission policy, evicted transactions are resubmitted and the
+// returned response is the one of the last resubmitted transaction.
+// If the TxClient confirms transactions through events, the transaction is resolved from the
+// committed tx events and its status is only polled with the fallback poll time.
+// A transaction that none of the endpoints knows is removed from the local tx tracker and the
+// tx store, as it was likely rejected.
 func (client *TxClient) ConfirmTx(ctx context.Context, txHash string) (*TxResponse, error) {
-	txClient := tx.NewTxClient(client.conns[0])
+	pollTime := client.pollTime
+	var (
+		committed <-chan committedTx
+		unwatch   = func() {}
+	)
+	defer func() { unwatch() }()
+	watch := func(txHash string) {
+		unwatch()
+		if client.confirmer == nil {
+			return
+		}
+		ch, stop, err := client.confirmer.watch(ctx, txHash)
+		if err != nil {
+			// fall back to polling
+			committed, unwatch, pollTime = nil, func() {}, client.pollTime
+			return
+		}
+		committed, unwatch, pollTime = ch, stop, client.confirmer.fallbackPollTime
+	}
+	watch(txHash)
 
-	pollTicker := time.NewTicker(client.pollTime)
+	pollTicker := time.NewTicker(pollTime)
 	defer pollTicker.Stop()
 
 	for {
-		resp, err := txClient.TxStatus(ctx, &tx.TxStatusRequest{TxId: txHash})
+		resp, err := client.txStatus(ctx, txHash, client.acceptingConn(txHash))
 		if err != nil {
 			return nil, err
 		}
@@ -497,28 +739,26 @@ func (client *TxClient) ConfirmTx(ctx context.Context, txHash string) (*TxRespon
 			select {
 			case <-ctx.Done():
 				return nil, ctx.Err()
+			case result := <-committed:
+				return client.committedTxResponse(txHash, result.height, result.code, result.log)
 			case <-pollTicker.C:
 				continue
 			}
 		case core.TxStatusCommitted:
-			txResponse := &TxResponse{
-				Height: resp.Height,
-				TxHash: txHash,
-				Code:   resp.ExecutionCode,
+			return client.committedTxResponse(txHash, resp.Height, resp.ExecutionCode, resp.Error)
+		case core.TxStatusEvicted:
+			if client.resubmissionPolicy == nil {
+				return nil, client.handleEvictions(txHash)
 			}
-			if resp.ExecutionCode != abci.CodeTypeOK {
-				executionErr := &ExecutionError{
-					TxHash:   txHash,
-					Code:     resp.ExecutionCode,
-					ErrorLog: resp.Error,
-				}
-				client.deleteFromTxTracker(txHash)
-				return nil, executionErr
+			txHash, err = client.resubmitEvicted(ctx, txHash)
+			if err != nil {
+				return nil, err
 			}
+			watch(txHash)
+			pollTicker.Reset(pollTime)
+		case core.TxStatusRejected:
 			client.deleteFromTxTracker(txHash)
-			return txResponse, nil
-		case core.TxStatusEvicted:
-			return nil, client.handleEvictions(txHash)
+			return nil, fmt.Errorf("transaction with hash %s was rejected", txHash)
 		default:
 			client.deleteFromTxTracker(txHash)
 			if ctx.Err() != nil {
@@ -529,24 +769,76 @@ func (client *TxClient) ConfirmTx(ctx context.Context, txHash string) (*TxRespon
 	}
 }
 
+// acceptingConn returns the connection of the endpoint that accepted the
+// transaction or nil if it is unknown.
+func (client *TxClient) acceptingConn(txHash string) *grpc.ClientConn {
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	return client.txTracker[txHash].conn
+}
+
+// committedTxResponse removes the committed transaction from the local tx tracker and
+// returns its response, or an ExecutionError if its execution failed.
+func (client *TxClient) committedTxResponse(txHash string, height int64, code uint32, errorLog string) (*TxResponse, error) {
+	client.deleteFromTxTracker(txHash)
+	if code != abci.CodeTypeOK {
+		return nil, &ExecutionError{
+			TxHash:   txHash,
+			Code:     code,
+			ErrorLog: errorLog,
+		}
+	}
+	return &TxResponse{
+		Height: height,
+		TxHash: txHash,
+		Code:   code,
+	}, nil
+}
+
 // handleEvictions handles the scenario where a transaction is evicted from the mempool.
 // It removes the evicted transaction from the local tx tracker without incrementing
 // the signer's sequence.
 func (client *TxClient) handleEvictions(txHash string) error {
 	client.mtx.Lock()
 	defer client.mtx.Unlock()
+	if _, err := client.rollbackEvictedTx(txHash); err != nil {
+		return err
+	}
+	return fmt.Errorf("tx was evicted from the mempool")
+}
+
+// rollbackEvictedTx rolls the signer's sequence back to the sequence of the
+// evicted transaction, unless it is already lower, and removes it from the
+// local tx tracker. It returns the tracked info of the transaction. The caller
+// must hold the lock.
+func (client *TxClient) rollbackEvictedTx(txHash string) (txInfo, error) {
 	// Get transaction from the local tx tracker
-	txInfo, exists := client.txTracker[txHash]
+	info, exists := client.txTracker[txHash]
 	if !exists {
-		return fmt.Errorf("tx: %s not found in tx client txTracker; likely failed during broadcast", txHash)
+		return txInfo{}, fmt.Errorf("tx: %s not found in tx client txTracker; likely failed during broadcast", txHash)
 	}
 	// The sequence should be rolled back to the sequence of the transaction that was evicted to be
 	// ready for resubmission. All transactions with a later nonce will be kicked by the nodes tx pool.
-	if err := client.signer.SetSequence(txInfo.signer, txInfo.sequence); err != nil {
-		return fmt.Errorf("setting sequence: %w", err)
+	if err := client.rollbackSequence(info.signer, info.sequence); err != nil {
+		return txInfo{}, err
 	}
 	delete(client.txTracker, txHash)
-	return fmt.Errorf("tx was evicted from the mempool")
+	_ = client.unstoreTx(txHash)
+	return info, nil
+}
+
+// rollbackSequence sets the sequence of the signer to the provided sequence if
+// it is lower than the current one so that concurrent rollbacks end at the
+// lowest sequence. The caller must hold the lock.
+func (client *TxClient) rollbackSequence(signer string, sequence uint64) error {
+	acc := client.signer.Account(signer)
+	if acc != nil && acc.Sequence() <= sequence {
+		return nil
+	}
+	if err := client.signer.SetSequence(signer, sequence); err != nil {
+		return fmt.Errorf("setting sequence: %w", err)
+	}
+	return nil
 }
 
 // deleteFromTxTracker safely deletes a transaction from the local tx tracker.
@@ -554,6 +846,7 @@ func (client *TxClient) deleteFromTxTracker(txHash string) {
 	client.mtx.Lock()
 	defer client.mtx.Unlock()
 	delete(client.txTracker, txHash)
+	_ = client.unstoreTx(txHash)
 }
 
 // EstimateGas simulates the transaction, calculating the amount of gas that was
@@ -602,9 +895,13 @@ func (client *TxClient) EstimateGasPriceAndUsage(
 	if err != nil {
 		return 0, 0, err
 	}
-	resp, err := client.gasEstimationClient.EstimateGasPriceAndUsage(ctx, &gasestimation.EstimateGasPriceAndUsageRequest{
-		TxPriority: priority,
-		TxBytes:    txBytes,
+	var resp *gasestimation.EstimateGasPriceAndUsageResponse
+	err = client.withGasEstimator(ctx, func(estimator gasestimation.GasEstimatorClient) (err error) {
+		resp, err = estimator.EstimateGasPriceAndUsage(ctx, &gasestimation.EstimateGasPriceAndUsageRequest{
+			TxPriority: priority,
+			TxBytes:    txBytes,
+		})
+		return err
 	})
 	if err != nil {
 		return 0, 0, fmt.Errorf("failed to estimate gas price and usage: %w", err)
@@ -617,8 +914,12 @@ func (client *TxClient) EstimateGasPriceAndUsage(
 
 // EstimateGasPrice calls the gas estimation endpoint to return the estimated gas price based on priority.
 func (client *TxClient) EstimateGasPrice(ctx context.Context, priority gasestimation.TxPriority) (float64, error) {
-	resp, err := client.gasEstimationClient.EstimateGasPrice(ctx, &gasestimation.EstimateGasPriceRequest{
-		TxPriority: priority,
+	var resp *gasestimation.EstimateGasPriceResponse
+	err := client.withGasEstimator(ctx, func(estimator gasestimation.GasEstimatorClient) (err error) {
+		resp, err = estimator.EstimateGasPrice(ctx, &gasestimation.EstimateGasPriceRequest{
+			TxPriority: priority,
+		})
+		return err
 	})
 	if err != nil {
 		return 0, err
@@ -636,7 +937,11 @@ func (client *TxClient) estimateGas(ctx context.Context, txBuilder client.TxBuil
 	if err != nil {
 		return 0, err
 	}
-	resp, err := client.gasEstimationClient.EstimateGasPriceAndUsage(ctx, &gasestimation.EstimateGasPriceAndUsageRequest{TxBytes: txBytes})
+	var resp *gasestimation.EstimateGasPriceAndUsageResponse
+	err = client.withGasEstimator(ctx, func(estimator gasestimation.GasEstimatorClient) (err error) {
+		resp, err = estimator.EstimateGasPriceAndUsage(ctx, &gasestimation.EstimateGasPriceAndUsageRequest{TxBytes: txBytes})
+		return err
+	})
 	if err != nil {
 		return 0, err
 	}
@@ -645,6 +950,17 @@ func (client *TxClient) estimateGas(ctx context.Context, txBuilder client.TxBuil
 	return gasLimit, nil
 }
 
+// withGasEstimator calls fn with the gas estimation service if one is set, and
+// with the gas estimation service of the core endpoints otherwise.
+func (client *TxClient) withGasEstimator(ctx context.Context, fn func(estimator gasestimation.GasEstimatorClient) error) error {
+	if client.gasEstimationClient != nil {
+		return fn(client.gasEstimationClient)
+	}
+	return client.query(ctx, func(conn *grpc.ClientConn) error {
+		return fn(gasestimation.NewGasEstimatorClient(conn))
+	})
+}
+
 // Account returns an account of the signer from the key name. Also returns a bool if the
 // account exists.
 // Thread-safe
@@ -695,13 +1011,39 @@ func (client *TxClient) checkAccountLoaded(ctx context.Context, account string)
 		return fmt.Errorf("retrieving address from keyring: %w", err)
 	}
 	// FIXME: have a less trusting way of getting the account number and sequence
-	accNum, sequence, err := QueryAccount(ctx, client.conns[0], client.registry, addr)
+	var accNum, sequence uint64
+	err = client.query(ctx, func(conn *grpc.ClientConn) (err error) {
+		accNum, sequence, err = QueryAccount(ctx, conn, client.registry, addr)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("querying account %s: %w", account, err)
 	}
 	return client.signer.AddAccount(NewAccount(account, accNum, sequence))
 }
 
+// queryAccount returns the account of the keyring with its account number and
+// sequence on chain.
+func (client *TxClient) queryAccount(ctx context.Context, name string) (*Account, error) {
+	record, err := client.signer.keys.Key(name)
+	if err != nil {
+		return nil, fmt.Errorf("trying to find account %s on keyring: %w", name, err)
+	}
+	addr, err := record.GetAddress()
+	if err != nil {
+		return nil, fmt.Errorf("retrieving address from keyring: %w", err)
+	}
+	var accNum, sequence uint64
+	err = client.query(ctx, func(conn *grpc.ClientConn) (err error) {
+		accNum, sequence, err = QueryAccount(ctx, conn, client.registry, addr)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("querying account %s: %w", name, err)
+	}
+	return NewAccount(name, accNum, sequence), nil
+}
+
 func (client *TxClient) getAccountNameFromMsgs(msgs []sdktypes.Msg) (string, error) {
 	var addr sdktypes.AccAddress
 	for _, msg := range msgs {
@@ -734,6 +1076,19 @@ func (client *TxClient) GetTxFromTxTracker(hash string) (sequence uint64, signer
 	return txInfo.sequence, txInfo.signer, exists
 }
 
+// InFlightTxs returns the hashes of the transactions in the tx client's local
+// tx tracker, i.e. the transactions that were broadcast but not confirmed yet.
+func (client *TxClient) InFlightTxs() []string {
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	hashes := make([]string, 0, len(client.txTracker))
+	for hash := range client.txTracker {
+		hashes = append(hashes, hash)
+	}
+	sort.Strings(hashes)
+	return hashes
+}
+
 // Signer exposes the tx clients underlying signer
 func (client *TxClient) Signer() *Signer {
 	return client.signer
@@ -768,7 +1123,22 @@ func QueryMinimumGasPrice(ctx context.Context, grpcConn *grpc.ClientConn) (float
 	return max(localMinPrice, networkMinPrice), nil
 }
 
+// QueryNetworkMinGasPrice queries the network wide minimum gas price. It
+// prefers the minfee module query, which reflects the dynamic network min gas
+// price, and falls back to the params module for networks that predate it, i.e. when
+// the query is unimplemented or not found.
 func QueryNetworkMinGasPrice(ctx context.Context, grpcConn *grpc.ClientConn) (float64, error) {
+	// the response holds a gogoproto custom type that only the gogoproto codec
+	// can decode, which connections don't necessarily default to.
+	grpcCodec := codec.NewProtoCodec(codectypes.NewInterfaceRegistry()).GRPCCodec()
+	minfeeResponse, err := minfeetypes.NewQueryClient(grpcConn).NetworkMinGasPrice(ctx, &minfeetypes.QueryNetworkMinGasPrice{}, grpc.ForceCodec(grpcCodec))
+	if err == nil {
+		return minfeeResponse.NetworkMinGasPrice.Float64()
+	}
+	if code := status.Code(err); code != codes.Unimplemented && code != codes.NotFound {
+		return 0, fmt.Errorf("querying minfee module: %w", err)
+	}
+
 	paramsClient := paramtypes.NewQueryClient(grpcConn)
 	// NOTE: that we don't prove that this is the correct value
 	paramResponse, err := paramsClient.Params(ctx, &paramtypes.QueryParamsRequest{Subspace: minfeetypes.ModuleName, Key: string(minfeetypes.KeyNetworkMinGasPrice)})
@@ -2,9 +2,11 @@ package user_test
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"net"
+	"sync"
 	"testing"
 	"time"
 
@@ -13,17 +15,21 @@ import (
 	"github.com/celestiaorg/celestia-app/v5/app"
 	"github.com/celestiaorg/celestia-app/v5/app/encoding"
 	"github.com/celestiaorg/celestia-app/v5/app/grpc/gasestimation"
+	"github.com/celestiaorg/celestia-app/v5/app/grpc/tx"
 	"github.com/celestiaorg/celestia-app/v5/app/params"
 	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v5/pkg/user"
 	"github.com/celestiaorg/celestia-app/v5/test/util/blobfactory"
 	"github.com/celestiaorg/celestia-app/v5/test/util/grpctest"
 	"github.com/celestiaorg/celestia-app/v5/test/util/random"
+	"github.com/celestiaorg/celestia-app/v5/test/util/testfactory"
 	"github.com/celestiaorg/celestia-app/v5/test/util/testnode"
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/rpc/core"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/assert"
@@ -255,6 +261,80 @@ func TestEvictions(t *testing.T) {
 	require.Equal(t, seqBeforeEviction, seqAfterEviction)
 }
 
+// TestEvictionResubmission tests that an evicted transaction is resubmitted at
+// the sequence of the evicted transaction with a bumped gas price until the
+// resubmissions are exhausted. The node always reports the transactions as
+// evicted.
+func TestEvictionResubmission(t *testing.T) {
+	var attempts []user.ResubmissionAttempt
+	policy := user.ResubmissionPolicy{
+		MaxAttempts:        3,
+		GasPriceMultiplier: 2,
+		MaxGasPrice:        appconsts.DefaultMinGasPrice * 5,
+		OnAttempt: func(attempt user.ResubmissionAttempt) {
+			attempts = append(attempts, attempt)
+		},
+	}
+
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	var (
+		mtx       sync.Mutex
+		sequences []uint64
+	)
+	mockSvc := &grpctest.MockTxService{
+		BroadcastHandler: func(_ context.Context, req *sdktx.BroadcastTxRequest) (*sdktx.BroadcastTxResponse, error) {
+			decoded, err := enc.TxConfig.TxDecoder()(req.TxBytes)
+			if err != nil {
+				return nil, err
+			}
+			sigs, err := decoded.(authsigning.SigVerifiableTx).GetSignaturesV2()
+			if err != nil {
+				return nil, err
+			}
+			mtx.Lock()
+			sequences = append(sequences, sigs[0].Sequence)
+			mtx.Unlock()
+			hash := fmt.Sprintf("%X", sha256.Sum256(req.TxBytes))
+			return &sdktx.BroadcastTxResponse{TxResponse: &sdk.TxResponse{Code: abci.CodeTypeOK, TxHash: hash}}, nil
+		},
+		TxStatusHandler: func(context.Context, *tx.TxStatusRequest) (*tx.TxStatusResponse, error) {
+			return &tx.TxStatusResponse{Status: core.TxStatusEvicted}, nil
+		},
+	}
+	conn := grpctest.StartMockServer(t, mockSvc)
+
+	const sequence = 7
+	kr := testfactory.TestKeyring(enc.Codec, "a")
+	signer, err := user.NewSigner(kr, enc.TxConfig, "chain", user.NewAccount("a", 1, sequence))
+	require.NoError(t, err)
+	txClient, err := user.NewTxClient(enc.Codec, signer, conn, enc.InterfaceRegistry,
+		user.WithPollTime(time.Millisecond), user.WithResubmissionPolicy(policy))
+	require.NoError(t, err)
+
+	sender := txClient.Signer().Account("a")
+	msg := bank.NewMsgSend(sender.Address(), testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(params.BondDenom, 10)))
+	resp, err := txClient.BroadcastTx(context.Background(), []sdk.Msg{msg}, user.SetGasLimitAndGasPrice(1e6, appconsts.DefaultMinGasPrice))
+	require.NoError(t, err)
+	_, err = txClient.ConfirmTx(context.Background(), resp.TxHash)
+	require.Error(t, err)
+
+	require.Len(t, attempts, policy.MaxAttempts)
+	require.Equal(t, uint64(sequence), sender.Sequence())
+	require.Equal(t, resp.TxHash, attempts[0].EvictedTxHash)
+	for i, attempt := range attempts {
+		require.NoError(t, attempt.Err)
+		require.Equal(t, i+1, attempt.Attempt)
+		require.NotEmpty(t, attempt.TxHash)
+		if i > 0 {
+			require.Equal(t, attempts[i-1].TxHash, attempt.EvictedTxHash)
+		}
+	}
+	require.Equal(t, []float64{appconsts.DefaultMinGasPrice * 2, appconsts.DefaultMinGasPrice * 4, appconsts.DefaultMinGasPrice * 5},
+		[]float64{attempts[0].GasPrice, attempts[1].GasPrice, attempts[2].GasPrice})
+	// every resubmission is signed with the sequence of the evicted tx
+	require.Equal(t, []uint64{sequence, sequence, sequence, sequence}, sequences)
+}
+
 // TestWithEstimatorService ensures that if the WithEstimatorService
 // option is provided to the tx client, the separate gas estimator service is
 // used to estimate gas price and usage instead of the default connection.
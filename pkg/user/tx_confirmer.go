@@ -0,0 +1,194 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	cmtclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	// DefaultEventsFallbackPollTime is the default interval with which the
+	// status of the transactions confirmed through events is polled.
+	DefaultEventsFallbackPollTime = 30 * time.Second
+	// confirmationSubscriber is the prefix of the name that the TxClient
+	// subscribes to the committed transaction events with.
+	confirmationSubscriber = "tx-client"
+	// eventsCapacity is the capacity of the committed transaction events
+	// channel.
+	eventsCapacity = 1000
+)
+
+// committedTxsQuery is the query of the committed transaction events.
+var committedTxsQuery = fmt.Sprintf("%s='%s'", types.EventTypeKey, types.EventTx)
+
+// WithConfirmationEvents makes ConfirmTx resolve the transactions from a
+// single subscription to the committed transaction events of the provided
+// client, instead of polling the status of every transaction with the poll
+// time. The events client is typically a started CometBFT websocket client
+// created with rpc/client/http.New(address, "/websocket").
+//
+// Evicted and rejected transactions don't emit events so their status is still
+// polled, every fallbackPollTime. ConfirmTx falls back to polling with the
+// poll time if the subscription fails. If the events channel is closed, for
+// instance because the websocket connection was lost, the next ConfirmTx call
+// subscribes again. See also UnsubscribeConfirmationEvents.
+func WithConfirmationEvents(eventsClient cmtclient.EventsClient, fallbackPollTime time.Duration) Option {
+	return func(c *TxClient) {
+		c.confirmer = &txConfirmer{
+			eventsClient:     eventsClient,
+			fallbackPollTime: fallbackPollTime,
+			waiters:          make(map[string][]chan committedTx),
+		}
+	}
+}
+
+// committedTx is the result of a committed transaction.
+type committedTx struct {
+	height int64
+	code   uint32
+	log    string
+}
+
+// txConfirmer dispatches the committed transaction events of a single
+// subscription to the ConfirmTx calls waiting for them.
+type txConfirmer struct {
+	eventsClient     cmtclient.EventsClient
+	fallbackPollTime time.Duration
+
+	mtx sync.Mutex
+	// subscriptions is the number of subscriptions made so far. It makes the
+	// name of every subscriber unique.
+	subscriptions int
+	// subscriber is the name of the current subscriber.
+	subscriber string
+	// stop is closed to stop the dispatch of the current subscription. It is
+	// nil if the confirmer is not subscribed.
+	stop chan struct{}
+	// waiters maps the hash of a transaction to the channels of the calls
+	// waiting for it.
+	waiters map[string][]chan committedTx
+}
+
+// watch returns a channel that receives the result of the transaction once it
+// is committed. The returned function must be called to stop watching the
+// transaction. The confirmer subscribes to the events if it isn't subscribed.
+func (c *txConfirmer) watch(ctx context.Context, txHash string) (<-chan committedTx, func(), error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.stop == nil {
+		// the subscriber is unique per confirmer and subscription so that
+		// several clients can share the same events client and a closed
+		// subscription doesn't conflict with the next one.
+		c.subscriptions++
+		subscriber := fmt.Sprintf("%s-%p-%d", confirmationSubscriber, c, c.subscriptions)
+		events, err := c.eventsClient.Subscribe(ctx, subscriber, committedTxsQuery, eventsCapacity)
+		if err != nil {
+			return nil, nil, fmt.Errorf("subscribing to the committed tx events: %w", err)
+		}
+		c.subscriber = subscriber
+		c.stop = make(chan struct{})
+		go c.dispatch(events, c.stop)
+	}
+
+	ch := make(chan committedTx, 1)
+	c.waiters[txHash] = append(c.waiters[txHash], ch)
+	return ch, func() { c.unwatch(txHash, ch) }, nil
+}
+
+// unwatch removes the channel from the waiters of the transaction.
+func (c *txConfirmer) unwatch(txHash string, ch chan committedTx) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	waiters := c.waiters[txHash]
+	for i, waiter := range waiters {
+		if waiter == ch {
+			waiters = append(waiters[:i], waiters[i+1:]...)
+			break
+		}
+	}
+	if len(waiters) == 0 {
+		delete(c.waiters, txHash)
+		return
+	}
+	c.waiters[txHash] = waiters
+}
+
+// unsubscribe stops the dispatch of the events and unsubscribes from them. It
+// is a no-op if the confirmer is not subscribed.
+func (c *txConfirmer) unsubscribe(ctx context.Context) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.stop == nil {
+		return nil
+	}
+	close(c.stop)
+	c.stop = nil
+	if err := c.eventsClient.Unsubscribe(ctx, c.subscriber, committedTxsQuery); err != nil {
+		return fmt.Errorf("unsubscribing from the committed tx events: %w", err)
+	}
+	return nil
+}
+
+// dispatch sends the result of every committed transaction to its waiters
+// until the subscription is stopped or the events channel is closed, in which
+// case the confirmer subscribes again on the next watch.
+func (c *txConfirmer) dispatch(events <-chan coretypes.ResultEvent, stop chan struct{}) {
+	for {
+		select {
+		case <-stop:
+			return
+		case event, ok := <-events:
+			if !ok {
+				c.mtx.Lock()
+				if c.stop == stop {
+					c.stop = nil
+				}
+				c.mtx.Unlock()
+				return
+			}
+			c.deliver(event)
+		}
+	}
+}
+
+// deliver sends the result of the committed transaction of the event to its
+// waiters.
+func (c *txConfirmer) deliver(event coretypes.ResultEvent) {
+	data, ok := event.Data.(types.EventDataTx)
+	if !ok {
+		return
+	}
+	txHash := fmt.Sprintf("%X", types.Tx(data.Tx).Hash())
+	result := committedTx{
+		height: data.Height,
+		code:   data.Result.Code,
+		log:    data.Result.Log,
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	for _, ch := range c.waiters[txHash] {
+		select {
+		case ch <- result:
+		default:
+		}
+	}
+}
+
+// UnsubscribeConfirmationEvents unsubscribes the client from the committed
+// transaction events that it subscribed to because of WithConfirmationEvents.
+// It should be called before the events client is stopped. The ConfirmTx
+// calls in progress fall back to polling every fallback poll time and the
+// next ConfirmTx call subscribes again. It is a no-op if the client doesn't
+// use confirmation events.
+func (client *TxClient) UnsubscribeConfirmationEvents(ctx context.Context) error {
+	if client.confirmer == nil {
+		return nil
+	}
+	return client.confirmer.unsubscribe(ctx)
+}
@@ -0,0 +1,99 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTxConfirmerResubscription tests that the confirmer subscribes again
+// after the events channel is closed and that it can unsubscribe.
+func TestTxConfirmerResubscription(t *testing.T) {
+	eventsClient := &fakeEventsClient{}
+	c := &txConfirmer{
+		eventsClient:     eventsClient,
+		fallbackPollTime: time.Hour,
+		waiters:          make(map[string][]chan committedTx),
+	}
+	isSubscribed := func() bool {
+		c.mtx.Lock()
+		defer c.mtx.Unlock()
+		return c.stop != nil
+	}
+	confirm := func(t *testing.T, tx types.Tx) {
+		txHash := fmt.Sprintf("%X", tx.Hash())
+		ch, unwatch, err := c.watch(context.Background(), txHash)
+		require.NoError(t, err)
+		defer unwatch()
+		eventsClient.send(types.EventDataTx{TxResult: abci.TxResult{Height: 5, Tx: tx}})
+		select {
+		case result := <-ch:
+			require.EqualValues(t, 5, result.height)
+		case <-time.After(10 * time.Second):
+			t.Fatal("the tx was not confirmed")
+		}
+	}
+
+	confirm(t, types.Tx("tx1"))
+	require.Len(t, eventsClient.subscribers, 1)
+
+	// the confirmer subscribes again once the events channel is closed
+	eventsClient.closeEvents()
+	require.Eventually(t, func() bool { return !isSubscribed() }, 10*time.Second, time.Millisecond)
+	confirm(t, types.Tx("tx2"))
+	require.Len(t, eventsClient.subscribers, 2)
+	require.NotEqual(t, eventsClient.subscribers[0], eventsClient.subscribers[1])
+
+	require.NoError(t, c.unsubscribe(context.Background()))
+	require.False(t, isSubscribed())
+	require.Equal(t, eventsClient.subscribers[1:], eventsClient.unsubscribed)
+	// unsubscribing again is a no-op
+	require.NoError(t, c.unsubscribe(context.Background()))
+	require.Len(t, eventsClient.unsubscribed, 1)
+}
+
+// fakeEventsClient is an events client whose events are sent by the test.
+type fakeEventsClient struct {
+	mtx          sync.Mutex
+	events       chan coretypes.ResultEvent
+	subscribers  []string
+	unsubscribed []string
+}
+
+func (f *fakeEventsClient) Subscribe(_ context.Context, subscriber, _ string, _ ...int) (<-chan coretypes.ResultEvent, error) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	f.subscribers = append(f.subscribers, subscriber)
+	f.events = make(chan coretypes.ResultEvent, 1)
+	return f.events, nil
+}
+
+func (f *fakeEventsClient) Unsubscribe(_ context.Context, subscriber, _ string) error {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	f.unsubscribed = append(f.unsubscribed, subscriber)
+	return nil
+}
+
+func (f *fakeEventsClient) UnsubscribeAll(context.Context, string) error {
+	return nil
+}
+
+func (f *fakeEventsClient) send(data types.EventDataTx) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	f.events <- coretypes.ResultEvent{Data: data}
+}
+
+func (f *fakeEventsClient) closeEvents() {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	close(f.events)
+}
@@ -0,0 +1,91 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v5/app/params"
+	"github.com/celestiaorg/celestia-app/v5/pkg/user"
+	"github.com/celestiaorg/celestia-app/v5/test/util/testnode"
+	cmtclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfirmationEvents(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode.")
+	}
+	encCfg, _, ctx := setupTxClient(t, testnode.DefaultTendermintConfig().Mempool.TTLDuration)
+	eventsClient, ok := ctx.Client.(cmtclient.EventsClient)
+	require.True(t, ok)
+
+	confirmConcurrently := func(t *testing.T, txClient *user.TxClient, timeout time.Duration) {
+		subCtx, cancel := context.WithTimeout(ctx.GoContext(), timeout)
+		defer cancel()
+		sender := txClient.Signer().Account(txClient.DefaultAccountName())
+		msg := bank.NewMsgSend(sender.Address(), testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(params.BondDenom, 10)))
+
+		hashes := make([]string, 0)
+		for i := 0; i < 5; i++ {
+			resp, err := txClient.BroadcastTx(subCtx, []sdk.Msg{msg}, user.SetGasLimit(1e6), user.SetFee(1e6))
+			require.NoError(t, err)
+			hashes = append(hashes, resp.TxHash)
+		}
+
+		var wg sync.WaitGroup
+		errs := make(chan error, len(hashes))
+		for _, hash := range hashes {
+			wg.Add(1)
+			go func(hash string) {
+				defer wg.Done()
+				resp, err := txClient.ConfirmTx(subCtx, hash)
+				if err == nil && resp.TxHash != hash {
+					err = errors.New("unexpected tx hash")
+				}
+				errs <- err
+			}(hash)
+		}
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			require.NoError(t, err)
+		}
+		require.Empty(t, txClient.InFlightTxs())
+	}
+
+	t.Run("txs are resolved from the events", func(t *testing.T) {
+		// neither the poll time nor the fallback poll time elapse before the timeout
+		txClient, err := user.SetupTxClient(ctx.GoContext(), ctx.Keyring, ctx.GRPCClient, encCfg,
+			user.WithPollTime(time.Hour), user.WithConfirmationEvents(eventsClient, time.Hour))
+		require.NoError(t, err)
+		confirmConcurrently(t, txClient, 30*time.Second)
+		require.NoError(t, txClient.UnsubscribeConfirmationEvents(ctx.GoContext()))
+	})
+
+	t.Run("polling is used if the subscription fails", func(t *testing.T) {
+		txClient, err := user.SetupTxClient(ctx.GoContext(), ctx.Keyring, ctx.GRPCClient, encCfg,
+			user.WithPollTime(100*time.Millisecond), user.WithConfirmationEvents(failingEventsClient{}, time.Hour))
+		require.NoError(t, err)
+		confirmConcurrently(t, txClient, 30*time.Second)
+	})
+}
+
+type failingEventsClient struct{}
+
+func (failingEventsClient) Subscribe(context.Context, string, string, ...int) (<-chan coretypes.ResultEvent, error) {
+	return nil, errors.New("subscriptions are not supported")
+}
+
+func (failingEventsClient) Unsubscribe(context.Context, string, string) error {
+	return nil
+}
+
+func (failingEventsClient) UnsubscribeAll(context.Context, string) error {
+	return nil
+}
@@ -0,0 +1,269 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cometbft/cometbft/rpc/core"
+	"github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// TxStoreDBName is the name of the LevelDB database created by
+// NewLevelDBTxStore.
+const TxStoreDBName = "tx_client"
+
+// StoredTx is an in-flight transaction persisted by a TxStore.
+type StoredTx struct {
+	TxHash    string    `json:"tx_hash"`
+	TxBytes   []byte    `json:"tx_bytes"`
+	Signer    string    `json:"signer"`
+	Sequence  uint64    `json:"sequence"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// TxStore persists the transactions that the TxClient has in flight so that
+// they can be reconciled against the chain after a restart.
+type TxStore interface {
+	// Put stores the transaction, overwriting any transaction with the same
+	// hash.
+	Put(tx StoredTx) error
+	// Delete removes the transaction with the provided hash. It is a no-op if
+	// the transaction is not stored.
+	Delete(txHash string) error
+	// List returns all the stored transactions.
+	List() ([]StoredTx, error)
+}
+
+var _ TxStore = &DBTxStore{}
+
+// DBTxStore is a TxStore backed by a key-value database.
+type DBTxStore struct {
+	db dbm.DB
+}
+
+// NewDBTxStore returns a TxStore that stores the transactions in the provided
+// db.
+func NewDBTxStore(db dbm.DB) *DBTxStore {
+	return &DBTxStore{db: db}
+}
+
+// NewLevelDBTxStore returns a TxStore backed by a LevelDB database in the
+// provided directory.
+func NewLevelDBTxStore(dir string) (*DBTxStore, error) {
+	db, err := dbm.NewGoLevelDB(TxStoreDBName, dir, nil)
+	if err != nil {
+		return nil, err
+	}
+	return NewDBTxStore(db), nil
+}
+
+// Put implements TxStore.
+func (s *DBTxStore) Put(tx StoredTx) error {
+	value, err := json.Marshal(tx)
+	if err != nil {
+		return err
+	}
+	return s.db.SetSync([]byte(tx.TxHash), value)
+}
+
+// Delete implements TxStore.
+func (s *DBTxStore) Delete(txHash string) error {
+	return s.db.DeleteSync([]byte(txHash))
+}
+
+// List implements TxStore.
+func (s *DBTxStore) List() ([]StoredTx, error) {
+	it, err := s.db.Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	txs := make([]StoredTx, 0)
+	for ; it.Valid(); it.Next() {
+		var tx StoredTx
+		if err := json.Unmarshal(it.Value(), &tx); err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+	}
+	return txs, it.Error()
+}
+
+// Close closes the underlying db.
+func (s *DBTxStore) Close() error {
+	return s.db.Close()
+}
+
+// WithTxStore persists the in-flight transactions of the client in the
+// provided store. The stored transactions are reconciled against the chain by
+// SetupTxClient and the ones still in flight are returned by InFlightTxs.
+// Clients created with NewTxClient must call ReconcileTxs themselves before
+// submitting transactions.
+func WithTxStore(store TxStore) Option {
+	return func(c *TxClient) {
+		c.txStore = store
+	}
+}
+
+// storeTx persists the transaction before it is broadcast. It is a no-op if
+// the client has no store.
+func (client *TxClient) storeTx(txHash string, txBytes []byte, signer string) error {
+	if client.txStore == nil {
+		return nil
+	}
+	acc, exists := client.signer.accounts[signer]
+	if !exists {
+		return fmt.Errorf("account %s does not exist", signer)
+	}
+	return client.txStore.Put(StoredTx{
+		TxHash:    txHash,
+		TxBytes:   txBytes,
+		Signer:    signer,
+		Sequence:  acc.Sequence(),
+		Timestamp: time.Now(),
+	})
+}
+
+// unstoreTx removes the transaction from the store. Failing to remove it is
+// only fatal when the transactions are reconciled as leftover transactions are
+// removed by the next reconciliation.
+func (client *TxClient) unstoreTx(txHash string) error {
+	if client.txStore == nil {
+		return nil
+	}
+	return client.txStore.Delete(txHash)
+}
+
+// txHash returns the hash that the network indexes the transaction by.
+func txHash(txBytes []byte) string {
+	return fmt.Sprintf("%X", types.Tx(txBytes).Hash())
+}
+
+// ReconcileTxs reconciles the transactions of the store against the chain
+// and returns the hashes of the transactions that are still in flight, which
+// can be confirmed with ConfirmTx. For every stored transaction:
+//   - committed transactions are removed from the store.
+//   - pending transactions are tracked again and the sequence of their
+//     signer is moved past them, so that their sequence isn't signed again.
+//   - transactions that are no longer in the mempool are broadcast again if
+//     their sequence hasn't been used yet. Otherwise, they are removed from the
+//     store.
+//
+// It is a no-op if the client has no store.
+func (client *TxClient) ReconcileTxs(ctx context.Context) ([]string, error) {
+	if client.txStore == nil {
+		return nil, nil
+	}
+
+	stored, err := client.txStore.List()
+	if err != nil {
+		return nil, fmt.Errorf("listing the stored txs: %w", err)
+	}
+	// transactions are reconciled in the order they were signed in
+	sort.Slice(stored, func(i, j int) bool {
+		if stored[i].Signer != stored[j].Signer {
+			return stored[i].Signer < stored[j].Signer
+		}
+		return stored[i].Sequence < stored[j].Sequence
+	})
+
+	// the lock is only held while the tracked transactions and the sequences
+	// are updated so that the submissions of the client are not blocked by the
+	// queries.
+	inFlight := make([]string, 0)
+	for _, storedTx := range stored {
+		if err := client.loadAccount(ctx, storedTx.Signer); err != nil {
+			return nil, err
+		}
+		resp, err := client.txStatus(ctx, storedTx.TxHash, nil)
+		if err != nil {
+			return nil, fmt.Errorf("querying the status of tx %s: %w", storedTx.TxHash, err)
+		}
+
+		switch resp.Status {
+		case core.TxStatusCommitted:
+			if err := client.unstoreTx(storedTx.TxHash); err != nil {
+				return nil, fmt.Errorf("removing tx %s from the store: %w", storedTx.TxHash, err)
+			}
+		case core.TxStatusPending:
+			if err := client.trackStoredTx(storedTx); err != nil {
+				return nil, err
+			}
+			inFlight = append(inFlight, storedTx.TxHash)
+		default:
+			reserved, err := client.reserveSequence(storedTx.Signer, storedTx.Sequence)
+			if err != nil {
+				return nil, err
+			}
+			if reserved {
+				broadcastResp, err := client.broadcastSigned(ctx, storedTx.TxBytes, storedTx.Signer, storedTx.Sequence, nil)
+				if err == nil {
+					inFlight = append(inFlight, broadcastResp.TxHash)
+					continue
+				}
+			}
+			// the sequence was used by another transaction or the
+			// transaction can't be broadcast anymore
+			if err := client.unstoreTx(storedTx.TxHash); err != nil {
+				return nil, fmt.Errorf("removing tx %s from the store: %w", storedTx.TxHash, err)
+			}
+		}
+	}
+	return inFlight, nil
+}
+
+// loadAccount loads the account of the keyring if it isn't loaded yet. The
+// account is queried without holding the lock.
+func (client *TxClient) loadAccount(ctx context.Context, account string) error {
+	client.mtx.Lock()
+	_, exists := client.signer.accounts[account]
+	client.mtx.Unlock()
+	if exists {
+		return nil
+	}
+	acc, err := client.queryAccount(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	if _, exists := client.signer.accounts[account]; exists {
+		return nil
+	}
+	return client.signer.AddAccount(acc)
+}
+
+// trackStoredTx tracks the stored transaction that is still pending and moves
+// the sequence of its signer past it, so that its sequence isn't signed again.
+func (client *TxClient) trackStoredTx(storedTx StoredTx) error {
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	client.txTracker[storedTx.TxHash] = txInfo{
+		sequence:  storedTx.Sequence,
+		signer:    storedTx.Signer,
+		timestamp: storedTx.Timestamp,
+	}
+	if client.signer.accounts[storedTx.Signer].Sequence() <= storedTx.Sequence {
+		return client.signer.SetSequence(storedTx.Signer, storedTx.Sequence+1)
+	}
+	return nil
+}
+
+// reserveSequence moves the sequence of the signer past the provided sequence
+// if it is the current one so that the transaction signed with it can be
+// broadcast again. It returns false if the sequence was used by another
+// transaction.
+func (client *TxClient) reserveSequence(signer string, sequence uint64) (bool, error) {
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	if client.signer.accounts[signer].Sequence() != sequence {
+		return false, nil
+	}
+	return true, client.signer.IncrementSequence(signer)
+}
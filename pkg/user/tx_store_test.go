@@ -0,0 +1,141 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v5/app/params"
+	"github.com/celestiaorg/celestia-app/v5/pkg/user"
+	"github.com/celestiaorg/celestia-app/v5/test/util/testnode"
+	"github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDBTxStore(t *testing.T) {
+	store, err := user.NewLevelDBTxStore(t.TempDir())
+	require.NoError(t, err)
+	defer store.Close()
+
+	txs, err := store.List()
+	require.NoError(t, err)
+	require.Empty(t, txs)
+
+	tx1 := user.StoredTx{TxHash: "AA", TxBytes: []byte{1}, Signer: "a", Sequence: 1, Timestamp: time.Unix(10, 0).UTC()}
+	tx2 := user.StoredTx{TxHash: "BB", TxBytes: []byte{2}, Signer: "b", Sequence: 2, Timestamp: time.Unix(20, 0).UTC()}
+	require.NoError(t, store.Put(tx1))
+	require.NoError(t, store.Put(tx2))
+	txs, err = store.List()
+	require.NoError(t, err)
+	require.Equal(t, []user.StoredTx{tx1, tx2}, txs)
+
+	require.NoError(t, store.Delete(tx1.TxHash))
+	// deleting a missing tx is a no-op
+	require.NoError(t, store.Delete(tx1.TxHash))
+	txs, err = store.List()
+	require.NoError(t, err)
+	require.Equal(t, []user.StoredTx{tx2}, txs)
+}
+
+func TestTxClientPersistence(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode.")
+	}
+	store := user.NewDBTxStore(dbm.NewMemDB())
+	encCfg, txClient, ctx := setupTxClient(t, testnode.DefaultTendermintConfig().Mempool.TTLDuration, user.WithTxStore(store))
+	subCtx, cancel := context.WithTimeout(ctx.GoContext(), time.Minute)
+	defer cancel()
+
+	account := txClient.DefaultAccountName()
+	sender := txClient.Signer().Account(account)
+	msg := bank.NewMsgSend(sender.Address(), testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(params.BondDenom, 10)))
+	opts := []user.TxOption{user.SetGasLimit(1e6), user.SetFee(1e6)}
+
+	t.Run("in-flight txs are stored until they are confirmed", func(t *testing.T) {
+		sequence := sender.Sequence()
+		resp, err := txClient.BroadcastTx(subCtx, []sdk.Msg{msg}, opts...)
+		require.NoError(t, err)
+		stored, err := store.List()
+		require.NoError(t, err)
+		require.Len(t, stored, 1)
+		require.Equal(t, resp.TxHash, stored[0].TxHash)
+		require.Equal(t, account, stored[0].Signer)
+		require.Equal(t, sequence, stored[0].Sequence)
+
+		_, err = txClient.ConfirmTx(subCtx, resp.TxHash)
+		require.NoError(t, err)
+		stored, err = store.List()
+		require.NoError(t, err)
+		require.Empty(t, stored)
+	})
+
+	t.Run("in-flight txs are reconciled on restart", func(t *testing.T) {
+		resp, err := txClient.BroadcastTx(subCtx, []sdk.Msg{msg}, opts...)
+		require.NoError(t, err)
+		sequence := sender.Sequence()
+
+		restarted, err := user.SetupTxClient(subCtx, ctx.Keyring, ctx.GRPCClient, encCfg, user.WithTxStore(store))
+		require.NoError(t, err)
+		// the restarted client doesn't sign the sequence of the in-flight tx again
+		require.Equal(t, sequence, restarted.Signer().Account(account).Sequence())
+		for _, hash := range restarted.InFlightTxs() {
+			require.Equal(t, resp.TxHash, hash)
+			_, err = restarted.ConfirmTx(subCtx, hash)
+			require.NoError(t, err)
+		}
+		stored, err := store.List()
+		require.NoError(t, err)
+		require.Empty(t, stored)
+	})
+
+	t.Run("stored txs that are unknown to the network are broadcast again", func(t *testing.T) {
+		sequence := sender.Sequence()
+		txBytes, _, err := txClient.Signer().CreateTx([]sdk.Msg{msg}, opts...)
+		require.NoError(t, err)
+		hash := fmt.Sprintf("%X", types.Tx(txBytes).Hash())
+		require.NoError(t, store.Put(user.StoredTx{TxHash: hash, TxBytes: txBytes, Signer: account, Sequence: sequence}))
+
+		restarted, err := user.SetupTxClient(subCtx, ctx.Keyring, ctx.GRPCClient, encCfg, user.WithTxStore(store))
+		require.NoError(t, err)
+		require.Equal(t, []string{hash}, restarted.InFlightTxs())
+		require.Equal(t, sequence+1, restarted.Signer().Account(account).Sequence())
+		_, err = restarted.ConfirmTx(subCtx, hash)
+		require.NoError(t, err)
+	})
+
+	t.Run("stored txs with a used sequence are dropped", func(t *testing.T) {
+		sequence := sender.Sequence()
+		hash := fmt.Sprintf("%X", types.Tx([]byte{1}).Hash())
+		require.NoError(t, store.Put(user.StoredTx{TxHash: hash, TxBytes: []byte{1}, Signer: account, Sequence: sequence - 1}))
+
+		restarted, err := user.SetupTxClient(subCtx, ctx.Keyring, ctx.GRPCClient, encCfg, user.WithTxStore(store))
+		require.NoError(t, err)
+		require.Empty(t, restarted.InFlightTxs())
+		stored, err := store.List()
+		require.NoError(t, err)
+		require.Empty(t, stored)
+	})
+
+	t.Run("stored txs that can't be removed fail the reconciliation", func(t *testing.T) {
+		hash := fmt.Sprintf("%X", types.Tx([]byte{2}).Hash())
+		require.NoError(t, store.Put(user.StoredTx{TxHash: hash, TxBytes: []byte{2}, Signer: account, Sequence: sender.Sequence() - 1}))
+
+		_, err := user.SetupTxClient(subCtx, ctx.Keyring, ctx.GRPCClient, encCfg, user.WithTxStore(failingDeleteStore{store}))
+		require.ErrorContains(t, err, "removing tx "+hash)
+		require.NoError(t, store.Delete(hash))
+	})
+}
+
+// failingDeleteStore is a TxStore that fails to delete transactions.
+type failingDeleteStore struct {
+	user.TxStore
+}
+
+func (failingDeleteStore) Delete(string) error {
+	return errors.New("delete failed")
+}
@@ -48,6 +48,13 @@ type Tree interface {
 	ProveRange(start, end int) (nmt.Proof, error)
 }
 
+// NamespaceProver is implemented by trees that can prove the presence or
+// absence of a namespace, such as the underlying NamespaceMerkleTree. It is
+// separate from Tree so that existing Tree implementations keep satisfying it.
+type NamespaceProver interface {
+	ProveNamespace(nID namespace.ID) (nmt.Proof, error)
+}
+
 // NewErasuredNamespacedMerkleTree creates a new ErasuredNamespacedMerkleTree
 // with an underlying NMT of namespace size `appconsts.NamespaceSize` and with
 // `ignoreMaxNamespace=true`. axisIndex is the index of the row or column that
@@ -128,6 +135,18 @@ func (w *ErasuredNamespacedMerkleTree) ProveRange(start, end int) (nmt.Proof, er
 	return w.tree.ProveRange(start, end)
 }
 
+// ProveNamespace returns a Merkle range proof for the provided namespace. If
+// the namespace is within the namespace range of the tree but has no leaves,
+// the returned proof is an absence proof. It returns an error if the underlying
+// tree does not implement NamespaceProver.
+func (w *ErasuredNamespacedMerkleTree) ProveNamespace(nID namespace.ID) (nmt.Proof, error) {
+	prover, ok := w.tree.(NamespaceProver)
+	if !ok {
+		return nmt.Proof{}, fmt.Errorf("tree %T does not support namespace proofs", w.tree)
+	}
+	return prover.ProveNamespace(nID)
+}
+
 // incrementShareIndex increments the share index by one.
 func (w *ErasuredNamespacedMerkleTree) incrementShareIndex() {
 	w.shareIndex++
@@ -7,6 +7,7 @@ import (
 	"net"
 	"testing"
 
+	"github.com/celestiaorg/celestia-app/v5/app/grpc/tx"
 	"github.com/celestiaorg/celestia-app/v5/test/util/testnode"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/stretchr/testify/require"
@@ -14,11 +15,13 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 )
 
-// MockTxService allows controlling the behavior of BroadcastTx calls.
+// MockTxService allows controlling the behavior of BroadcastTx and TxStatus
+// calls.
 type MockTxService struct {
 	sdktx.UnimplementedServiceServer // Embed the unimplemented server
 
 	BroadcastHandler func(ctx context.Context, req *sdktx.BroadcastTxRequest) (*sdktx.BroadcastTxResponse, error)
+	TxStatusHandler  func(ctx context.Context, req *tx.TxStatusRequest) (*tx.TxStatusResponse, error)
 }
 
 func (m *MockTxService) BroadcastTx(ctx context.Context, req *sdktx.BroadcastTxRequest) (*sdktx.BroadcastTxResponse, error) {
@@ -28,6 +31,13 @@ func (m *MockTxService) BroadcastTx(ctx context.Context, req *sdktx.BroadcastTxR
 	return nil, fmt.Errorf("MockTxService.BroadcastHandler not set")
 }
 
+func (m *MockTxService) TxStatus(ctx context.Context, req *tx.TxStatusRequest) (*tx.TxStatusResponse, error) {
+	if m.TxStatusHandler != nil {
+		return m.TxStatusHandler(ctx, req)
+	}
+	return nil, fmt.Errorf("MockTxService.TxStatusHandler not set")
+}
+
 func (m *MockTxService) Simulate(context.Context, *sdktx.SimulateRequest) (*sdktx.SimulateResponse, error) {
 	return nil, errors.New("Simulate not implemented in mock")
 }
@@ -75,6 +85,7 @@ func StartMockServer(t *testing.T, service *MockTxService) *grpc.ClientConn {
 
 	s := grpc.NewServer()
 	sdktx.RegisterServiceServer(s, service)
+	tx.RegisterTxServer(s, service)
 
 	go func() {
 		if err := s.Serve(lis); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
@@ -2,6 +2,7 @@ package ante
 
 import (
 	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v5/x/blob/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -33,9 +34,16 @@ func (d MinGasPFBDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool
 		return next(ctx, tx, simulate)
 	}
 
-	gasPerByte := appconsts.GasPerBlobByte
+	// the namespace gas overrides only apply from app version 6 onwards. Before,
+	// the zero params charge appconsts.GasPerBlobByte for every namespace.
+	var params types.Params
+	if ctx.ConsensusParams().Version.GetApp() >= appconsts.V6 {
+		// read the params without charging gas so that the gas remaining is
+		// not affected by the read.
+		params = d.k.GetParams(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()))
+	}
 	txGas := ctx.GasMeter().GasRemaining()
-	err := d.validatePFBHasEnoughGas(tx.GetMsgs(), gasPerByte, txGas)
+	err := d.validatePFBHasEnoughGas(tx.GetMsgs(), params, txGas)
 	if err != nil {
 		return ctx, err
 	}
@@ -46,7 +54,7 @@ func (d MinGasPFBDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool
 // validatePFBHasEnoughGas iterates through all the msgs and nested msgs to find
 // a MsgPayForBlobs. If found, it validates that the txGas is enough to pay for
 // the blobs.
-func (d MinGasPFBDecorator) validatePFBHasEnoughGas(msgs []sdk.Msg, gasPerByte uint32, txGas uint64) error {
+func (d MinGasPFBDecorator) validatePFBHasEnoughGas(msgs []sdk.Msg, params types.Params, txGas uint64) error {
 	for _, m := range msgs {
 		if execMsg, ok := m.(*authz.MsgExec); ok {
 			// Recursively look for PFBs in nested authz messages.
@@ -54,13 +62,13 @@ func (d MinGasPFBDecorator) validatePFBHasEnoughGas(msgs []sdk.Msg, gasPerByte u
 			if err != nil {
 				return err
 			}
-			err = d.validatePFBHasEnoughGas(nestedMsgs, gasPerByte, txGas)
+			err = d.validatePFBHasEnoughGas(nestedMsgs, params, txGas)
 			if err != nil {
 				return err
 			}
 		}
 		if pfb, ok := m.(*types.MsgPayForBlobs); ok {
-			err := validateEnoughGas(pfb, gasPerByte, txGas)
+			err := validateEnoughGas(pfb, params, txGas)
 			if err != nil {
 				return err
 			}
@@ -71,8 +79,8 @@ func (d MinGasPFBDecorator) validatePFBHasEnoughGas(msgs []sdk.Msg, gasPerByte u
 
 // validateEnoughGas returns an error if the gas needed to pay for the blobs is
 // greater than the txGas.
-func validateEnoughGas(msg *types.MsgPayForBlobs, gasPerByte uint32, txGas uint64) error {
-	gasToConsume := msg.Gas(gasPerByte)
+func validateEnoughGas(msg *types.MsgPayForBlobs, params types.Params, txGas uint64) error {
+	gasToConsume := msg.GasWithParams(params)
 	if gasToConsume > txGas {
 		return errors.Wrapf(sdkerrors.ErrInsufficientFee, "not enough gas to pay for blobs (minimum: %d, got: %d)", gasToConsume, txGas)
 	}
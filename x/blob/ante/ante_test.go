@@ -1,6 +1,7 @@
 package ante_test
 
 import (
+	"bytes"
 	"math"
 	"testing"
 
@@ -176,6 +177,55 @@ func TestMinGasPFBDecoratorWithMsgExec(t *testing.T) {
 	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
 }
 
+// TestMinGasPFBDecoratorNamespaceGasOverride tests that the MinGasPFBDecorator
+// requires the gas of the namespace gas overrides.
+func TestMinGasPFBDecoratorNamespaceGasOverride(t *testing.T) {
+	txConfig := encoding.MakeConfig(app.ModuleEncodingRegisters...).TxConfig
+	namespace := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	params := blob.DefaultParams()
+	params.NamespaceGasOverrides = []blob.NamespaceGasOverride{
+		{NamespacePrefix: namespace.Bytes(), GasPerBlobByte: 2 * appconsts.GasPerBlobByte},
+	}
+	anteHandler := ante.NewMinGasPFBDecorator(mockBlobKeeperWithParams{params: params})
+
+	pfb := &blob.MsgPayForBlobs{
+		Namespaces: [][]byte{namespace.Bytes()},
+		BlobSizes:  []uint32{uint32(share.AvailableBytesFromSparseShares(1))},
+	}
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(pfb))
+	tx := txBuilder.GetTx()
+
+	newCtx := func(appVersion, gasLimit uint64) sdk.Context {
+		return sdk.NewContext(nil, tmproto.Header{
+			Version: version.Consensus{App: appVersion},
+			Height:  1,
+		}, true, nil).
+			WithConsensusParams(tmproto.ConsensusParams{Version: &tmproto.VersionParams{App: appVersion}}).
+			WithGasMeter(storetypes.NewGasMeter(gasLimit)).
+			WithIsCheckTx(true)
+	}
+
+	// enough gas without the override is not enough
+	_, err := anteHandler.AnteHandle(newCtx(appconsts.V6, share.ShareSize*uint64(appconsts.GasPerBlobByte)), tx, false, mockNext)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	_, err = anteHandler.AnteHandle(newCtx(appconsts.V6, share.ShareSize*2*uint64(appconsts.GasPerBlobByte)), tx, false, mockNext)
+	require.NoError(t, err)
+
+	// the override is ignored before v6
+	_, err = anteHandler.AnteHandle(newCtx(appconsts.V6-1, share.ShareSize*uint64(appconsts.GasPerBlobByte)), tx, false, mockNext)
+	require.NoError(t, err)
+}
+
+type mockBlobKeeperWithParams struct {
+	params blob.Params
+}
+
+func (k mockBlobKeeperWithParams) GetParams(sdk.Context) blob.Params {
+	return k.params
+}
+
 type mockBlobKeeper struct{}
 
 func (mockBlobKeeper) GetParams(sdk.Context) blob.Params {
@@ -0,0 +1,75 @@
+package ante
+
+import (
+	"cosmossdk.io/errors"
+	blobtypes "github.com/celestiaorg/celestia-app/v5/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// NamespaceOwnershipDecorator rejects a PFB that pays for a blob in a claimed
+// namespace if the signer of the PFB is neither the owner of the namespace nor
+// one of its allowed signers. Namespaces that have not been claimed can be
+// used by every signer. The decorator has no effect while the namespace
+// registry is disabled.
+type NamespaceOwnershipDecorator struct {
+	k NamespaceRegistryKeeper
+}
+
+func NewNamespaceOwnershipDecorator(k NamespaceRegistryKeeper) NamespaceOwnershipDecorator {
+	return NamespaceOwnershipDecorator{k}
+}
+
+// AnteHandle implements the Cosmos SDK AnteHandler function signature. It
+// returns an error if tx contains a MsgPayForBlobs whose signer is not allowed
+// to post blobs in one of its namespaces.
+func (d NamespaceOwnershipDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.IsReCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+
+	if !d.k.IsNamespaceRegistryEnabled(ctx) {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := d.validateMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// validateMsgs iterates through all the msgs and nested msgs to find a
+// MsgPayForBlobs. If found, it validates that its signer is allowed to post
+// blobs in each of its namespaces.
+func (d NamespaceOwnershipDecorator) validateMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, m := range msgs {
+		if execMsg, ok := m.(*authz.MsgExec); ok {
+			// Recursively look for PFBs in nested authz messages.
+			nestedMsgs, err := execMsg.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := d.validateMsgs(ctx, nestedMsgs); err != nil {
+				return err
+			}
+		}
+		pfb, ok := m.(*blobtypes.MsgPayForBlobs)
+		if !ok {
+			continue
+		}
+		for _, namespace := range pfb.Namespaces {
+			if !d.k.IsAllowedNamespaceSigner(ctx, namespace, pfb.Signer) {
+				return errors.Wrapf(blobtypes.ErrUnauthorizedNamespaceSigner, "signer %s namespace %X", pfb.Signer, namespace)
+			}
+		}
+	}
+	return nil
+}
+
+// NamespaceRegistryKeeper is the subset of the blob keeper used to look up the
+// signers allowed in claimed namespaces.
+type NamespaceRegistryKeeper interface {
+	IsNamespaceRegistryEnabled(ctx sdk.Context) bool
+	IsAllowedNamespaceSigner(ctx sdk.Context, namespace []byte, signer string) bool
+}
@@ -0,0 +1,107 @@
+package ante_test
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/celestiaorg/celestia-app/v5/app"
+	"github.com/celestiaorg/celestia-app/v5/app/encoding"
+	"github.com/celestiaorg/celestia-app/v5/x/blob/ante"
+	blob "github.com/celestiaorg/celestia-app/v5/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamespaceOwnershipDecorator(t *testing.T) {
+	owner := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+	allowed := sdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String()
+	other := sdk.AccAddress(bytes.Repeat([]byte{3}, 20)).String()
+	claimed := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	unclaimed := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+
+	records := map[string]blob.NamespaceRecord{
+		string(claimed.Bytes()): blob.NewNamespaceRecord(claimed.Bytes(), owner, sdk.Coin{}, []string{allowed}),
+	}
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+
+	testCases := []struct {
+		name     string
+		msgs     []sdk.Msg
+		disabled bool
+		wantErr  error
+	}{
+		{
+			name: "owner posts to the claimed namespace",
+			msgs: []sdk.Msg{newPFB(owner, claimed)},
+		},
+		{
+			name: "allowed signer posts to the claimed namespace",
+			msgs: []sdk.Msg{newPFB(allowed, claimed)},
+		},
+		{
+			name: "any signer posts to an unclaimed namespace",
+			msgs: []sdk.Msg{newPFB(other, unclaimed)},
+		},
+		{
+			name:    "other signer posts to the claimed namespace",
+			msgs:    []sdk.Msg{newPFB(other, unclaimed, claimed)},
+			wantErr: blob.ErrUnauthorizedNamespaceSigner,
+		},
+		{
+			name:     "other signer posts to the claimed namespace while the registry is disabled",
+			msgs:     []sdk.Msg{newPFB(other, claimed)},
+			disabled: true,
+		},
+		{
+			name:    "other signer posts to the claimed namespace in an authz message",
+			msgs:    []sdk.Msg{newMsgExec(t, other, newPFB(other, claimed))},
+			wantErr: blob.ErrUnauthorizedNamespaceSigner,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			decorator := ante.NewNamespaceOwnershipDecorator(mockNamespaceRegistry{records: records, enabled: !tc.disabled})
+			ctx := sdk.NewContext(nil, tmproto.Header{Height: 1}, true, log.NewNopLogger())
+			txBuilder := enc.TxConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msgs...))
+			_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), false, mockNext)
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+// mockNamespaceRegistry maps namespaces to their records.
+type mockNamespaceRegistry struct {
+	records map[string]blob.NamespaceRecord
+	enabled bool
+}
+
+func (m mockNamespaceRegistry) IsNamespaceRegistryEnabled(sdk.Context) bool {
+	return m.enabled
+}
+
+func (m mockNamespaceRegistry) IsAllowedNamespaceSigner(_ sdk.Context, namespace []byte, signer string) bool {
+	record, found := m.records[string(namespace)]
+	return !found || record.IsAllowedSigner(signer)
+}
+
+func newPFB(signer string, namespaces ...share.Namespace) *blob.MsgPayForBlobs {
+	pfb := &blob.MsgPayForBlobs{Signer: signer}
+	for _, namespace := range namespaces {
+		pfb.Namespaces = append(pfb.Namespaces, namespace.Bytes())
+	}
+	return pfb
+}
+
+func newMsgExec(t *testing.T, grantee string, msgs ...sdk.Msg) *authz.MsgExec {
+	granteeAddr, err := sdk.AccAddressFromBech32(grantee)
+	require.NoError(t, err)
+	msgExec := authz.NewMsgExec(granteeAddr, msgs)
+	return &msgExec
+}
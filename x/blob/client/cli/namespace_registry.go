@@ -0,0 +1,148 @@
+package cli
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/celestiaorg/celestia-app/v5/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdktx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+func CmdClaimNamespace() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-namespace [namespaceID]",
+		Short: "Claim a namespace so that only its owner and allowed signers can pay for blobs in it.",
+		Long: `Claim a namespace so that only its owner and allowed signers can pay for blobs in it.
+The namespace claim bond parameter of the blob module is escrowed until the namespace is released.
+The namespaceID must be a hex encoded string of 10 bytes.`,
+		Example: "celestia-appd tx blob claim-namespace 0x00010203040506070809 --from validator --fees 21000utia",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			namespace, err := getNamespaceFromArgument(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgClaimNamespace(clientCtx.GetFromAddress().String(), namespace)
+			return sdktx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addNamespaceTxFlags(cmd)
+	return cmd
+}
+
+func CmdUpdateNamespaceSigners() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-namespace-signers [namespaceID] [signer,...]",
+		Short: "Replace the signers that, in addition to the owner, can pay for blobs in a claimed namespace.",
+		Long: `Replace the signers that, in addition to the owner, can pay for blobs in a claimed namespace.
+Omit the signers to only allow the owner.
+The namespaceID must be a hex encoded string of 10 bytes.`,
+		Example: "celestia-appd tx blob update-namespace-signers 0x00010203040506070809 celestia1...,celestia1... --from validator --fees 21000utia",
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			namespace, err := getNamespaceFromArgument(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			var allowedSigners []string
+			if len(args) == 2 && args[1] != "" {
+				allowedSigners = strings.Split(args[1], ",")
+			}
+			msg := types.NewMsgUpdateNamespaceSigners(clientCtx.GetFromAddress().String(), namespace, allowedSigners)
+			return sdktx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addNamespaceTxFlags(cmd)
+	return cmd
+}
+
+func CmdReleaseNamespace() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release-namespace [namespaceID]",
+		Short: "Release a claimed namespace and refund its bond to the owner.",
+		Long: `Release a claimed namespace and refund its bond to the owner.
+The namespaceID must be a hex encoded string of 10 bytes.`,
+		Example: "celestia-appd tx blob release-namespace 0x00010203040506070809 --from validator --fees 21000utia",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			namespace, err := getNamespaceFromArgument(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgReleaseNamespace(clientCtx.GetFromAddress().String(), namespace)
+			return sdktx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addNamespaceTxFlags(cmd)
+	return cmd
+}
+
+func CmdQueryNamespaceRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "namespace-record [namespaceID]",
+		Short: "shows the owner and allowed signers of a claimed namespace",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			namespace, err := getNamespaceFromArgument(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.NamespaceRecord(context.Background(), &types.QueryNamespaceRecordRequest{Namespace: namespace.Bytes()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint8(FlagNamespaceVersion, 0, "Specify the namespace version (default 0)")
+	return cmd
+}
+
+func addNamespaceTxFlags(cmd *cobra.Command) {
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Uint8(FlagNamespaceVersion, 0, "Specify the namespace version (default 0)")
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+}
+
+// getNamespaceFromArgument returns the namespace of the hex encoded namespace
+// ID argument and the namespace version flag.
+func getNamespaceFromArgument(cmd *cobra.Command, namespaceIDArg string) (share.Namespace, error) {
+	namespaceVersion, err := cmd.Flags().GetUint8(FlagNamespaceVersion)
+	if err != nil {
+		return share.Namespace{}, err
+	}
+	namespaceID, err := hex.DecodeString(strings.TrimPrefix(namespaceIDArg, "0x"))
+	if err != nil {
+		return share.Namespace{}, fmt.Errorf("failed to decode hex namespace ID: %w", err)
+	}
+	return getNamespace(namespaceID, namespaceVersion)
+}
@@ -19,6 +19,9 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdPayForBlob())
+	cmd.AddCommand(CmdClaimNamespace())
+	cmd.AddCommand(CmdUpdateNamespaceSigners())
+	cmd.AddCommand(CmdReleaseNamespace())
 
 	return cmd
 }
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"bytes"
 	"testing"
 
 	"cosmossdk.io/log"
@@ -59,3 +60,70 @@ func TestPayForBlobGas(t *testing.T) {
 		})
 	}
 }
+
+func TestPayForBlobGasNamespaceGasOverride(t *testing.T) {
+	overridden := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	other := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+	params := types.DefaultParams()
+	params.NamespaceGasOverrides = []types.NamespaceGasOverride{
+		{NamespacePrefix: overridden.Bytes(), GasPerBlobByte: 2},
+	}
+	msg := types.MsgPayForBlobs{
+		Namespaces: [][]byte{overridden.Bytes(), other.Bytes()},
+		BlobSizes:  []uint32{1, 1},
+	}
+
+	testCases := []struct {
+		name       string
+		appVersion uint64
+		want       uint64
+	}{
+		{
+			name:       "overrides apply from v6",
+			appVersion: appconsts.V6,
+			// 1 share * 512 bytes per share * 2 gas per byte + 1 share * 512 bytes per share * 8 gas per byte = 5120 gas
+			want: uint64(share.ShareSize*2 + share.ShareSize*appconsts.GasPerBlobByte),
+		},
+		{
+			name:       "overrides are ignored before v6",
+			appVersion: appconsts.V6 - 1,
+			want:       uint64(2 * share.ShareSize * appconsts.GasPerBlobByte),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, _, ctx := CreateKeeper(t, tc.appVersion)
+			k.SetParams(ctx, params)
+
+			gasBefore := ctx.GasMeter().GasConsumed()
+			_, err := k.PayForBlobs(ctx, &msg)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, ctx.GasMeter().GasConsumed()-gasBefore)
+		})
+	}
+}
+
+func TestUpdateBlobParamsBeforeV6(t *testing.T) {
+	k, _, ctx := CreateKeeper(t, appconsts.V6-1)
+	params := types.NewParams(types.DefaultGasPerBlobByte, types.DefaultGovMaxSquareSize)
+	params.NamespaceGasOverrides = []types.NamespaceGasOverride{
+		{NamespacePrefix: []byte{1}, GasPerBlobByte: 2},
+	}
+
+	_, err := k.UpdateBlobParams(ctx, &types.MsgUpdateBlobParams{Authority: k.GetAuthority(), Params: params})
+	require.ErrorContains(t, err, "namespace gas overrides are not supported before app version 6")
+
+	params.NamespaceGasOverrides = nil
+	params.NamespaceRegistryEnabled = true
+	_, err = k.UpdateBlobParams(ctx, &types.MsgUpdateBlobParams{Authority: k.GetAuthority(), Params: params})
+	require.ErrorContains(t, err, "the namespace registry is not supported before app version 6")
+
+	params.NamespaceRegistryEnabled = false
+	params.NamespaceClaimBond = types.DefaultNamespaceClaimBond
+	_, err = k.UpdateBlobParams(ctx, &types.MsgUpdateBlobParams{Authority: k.GetAuthority(), Params: params})
+	require.ErrorContains(t, err, "the namespace claim bond is not supported before app version 6")
+
+	params.NamespaceClaimBond = sdk.Coin{}
+	_, err = k.UpdateBlobParams(ctx, &types.MsgUpdateBlobParams{Authority: k.GetAuthority(), Params: params})
+	require.NoError(t, err)
+}
@@ -4,17 +4,54 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v5/x/blob/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // InitGenesis initializes the blob module's state from a provided genesis state.
 func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	if err := genState.Params.Validate(); err != nil {
-		return fmt.Errorf("invalid blob genesis state parameters: %w", err)
+	params := genState.Params
+	if sdkCtx.ConsensusParams().Version.GetApp() < appconsts.V6 {
+		// the params of app versions before 6 don't have the params added in
+		// app version 6 and there is no namespace registry.
+		if err := params.ValidateV5(); err != nil {
+			return fmt.Errorf("invalid blob genesis state parameters: %w", err)
+		}
+		if len(genState.NamespaceRecords) > 0 || len(genState.ReservedNamespaces) > 0 {
+			return fmt.Errorf("namespace records and reserved namespaces are not supported before app version %d", appconsts.V6)
+		}
+	} else {
+		// the default genesis doesn't know the app version so the claim bond
+		// of app version 6 is set here if the genesis doesn't set it.
+		if isUnsetCoin(params.NamespaceClaimBond) {
+			params.NamespaceClaimBond = types.DefaultNamespaceClaimBond
+		}
+		if err := params.Validate(); err != nil {
+			return fmt.Errorf("invalid blob genesis state parameters: %w", err)
+		}
+	}
+	k.SetParams(sdkCtx, params)
+	escrowed := sdk.NewCoins()
+	for _, record := range genState.NamespaceRecords {
+		k.SetNamespaceRecord(sdkCtx, record)
+		if record.Bond.IsValid() && record.Bond.IsPositive() {
+			escrowed = escrowed.Add(record.Bond)
+		}
+	}
+	// the bonds of the claimed namespaces are refunded from the blob module
+	// account so its balance must cover them.
+	if !escrowed.IsZero() {
+		balance := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+		if !balance.IsAllGTE(escrowed) {
+			return fmt.Errorf("blob module account balance %s does not cover the namespace claim bonds %s", balance, escrowed)
+		}
+	}
+	for _, namespace := range genState.ReservedNamespaces {
+		k.SetNamespaceReserved(sdkCtx, namespace)
 	}
-	k.SetParams(sdkCtx, genState.Params)
 	return nil
 }
 
@@ -23,5 +60,13 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(sdkCtx)
+	genesis.NamespaceRecords = k.GetAllNamespaceRecords(sdkCtx)
+	genesis.ReservedNamespaces = k.GetAllReservedNamespaces(sdkCtx)
 	return genesis
 }
+
+// isUnsetCoin returns true if neither the denom nor the amount of the coin is
+// set.
+func isUnsetCoin(coin sdk.Coin) bool {
+	return coin.Denom == "" && (coin.Amount.IsNil() || coin.Amount.IsZero())
+}
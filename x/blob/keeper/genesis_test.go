@@ -1,10 +1,12 @@
 package keeper_test
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v5/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
 	"github.com/stretchr/testify/require"
 )
 
@@ -18,5 +20,28 @@ func TestGenesis(t *testing.T) {
 	require.NoError(t, err)
 	got := k.ExportGenesis(ctx)
 	require.NotNil(t, got)
-	require.Equal(t, types.DefaultParams(), got.Params)
+	require.Equal(t, types.DefaultParamsV6(), got.Params)
+}
+
+func TestGenesisBeforeV6(t *testing.T) {
+	t.Run("default params", func(t *testing.T) {
+		k, _, ctx := CreateKeeper(t, appconsts.V6-1)
+		require.NoError(t, k.InitGenesis(ctx, *types.DefaultGenesis()))
+		require.Equal(t, types.DefaultParams(), k.ExportGenesis(ctx).Params)
+	})
+
+	t.Run("params of app version 6", func(t *testing.T) {
+		k, _, ctx := CreateKeeper(t, appconsts.V6-1)
+		genesisState := types.GenesisState{Params: types.DefaultParamsV6()}
+		require.ErrorContains(t, k.InitGenesis(ctx, genesisState), "invalid blob genesis state parameters")
+	})
+
+	t.Run("reserved namespaces", func(t *testing.T) {
+		k, _, ctx := CreateKeeper(t, appconsts.V6-1)
+		genesisState := types.GenesisState{
+			Params:             types.DefaultParams(),
+			ReservedNamespaces: [][]byte{share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize)).Bytes()},
+		}
+		require.ErrorContains(t, k.InitGenesis(ctx, genesisState), "not supported before app version")
+	})
 }
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/v5/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) NamespaceRecord(c context.Context, req *types.QueryNamespaceRecordRequest) (*types.QueryNamespaceRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	record, found := k.GetNamespaceRecord(ctx, req.Namespace)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "namespace %X has not been claimed", req.Namespace)
+	}
+	return &types.QueryNamespaceRecordResponse{Record: record}, nil
+}
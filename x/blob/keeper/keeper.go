@@ -22,6 +22,7 @@ type Keeper struct {
 	cdc            codec.Codec
 	storeKey       storetypes.StoreKey
 	legacySubspace paramtypes.Subspace
+	bankKeeper     types.BankKeeper
 	authority      string
 }
 
@@ -29,6 +30,7 @@ func NewKeeper(
 	cdc codec.Codec,
 	storeKey storetypes.StoreKey,
 	legacySubspace paramtypes.Subspace,
+	bankKeeper types.BankKeeper,
 	authority string,
 ) *Keeper {
 	if !legacySubspace.HasKeyTable() {
@@ -39,6 +41,7 @@ func NewKeeper(
 		cdc:            cdc,
 		storeKey:       storeKey,
 		legacySubspace: legacySubspace,
+		bankKeeper:     bankKeeper,
 		authority:      authority,
 	}
 }
@@ -51,12 +54,12 @@ func (k Keeper) GetAuthority() string {
 // PayForBlobs consumes gas based on the blob sizes in the MsgPayForBlobs.
 func (k Keeper) PayForBlobs(goCtx context.Context, msg *types.MsgPayForBlobs) (*types.MsgPayForBlobsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	gasToConsume := types.GasToConsume(msg.BlobSizes, appconsts.GasPerBlobByte)
+	gasToConsume := msg.GasWithParams(k.gasParams(ctx))
 
 	ctx.GasMeter().ConsumeGas(gasToConsume, payForBlobGasDescriptor)
 
 	if err := ctx.EventManager().EmitTypedEvent(
-		types.NewPayForBlobsEvent(msg.Signer, msg.BlobSizes, msg.Namespaces),
+		types.NewPayForBlobsEvent(msg.Signer, msg.BlobSizes, msg.Namespaces, msg.ShareCommitments),
 	); err != nil {
 		return &types.MsgPayForBlobsResponse{}, err
 	}
@@ -64,6 +67,18 @@ func (k Keeper) PayForBlobs(goCtx context.Context, msg *types.MsgPayForBlobs) (*
 	return &types.MsgPayForBlobsResponse{}, nil
 }
 
+// gasParams returns the params that the gas of a PFB is charged with. The
+// namespace gas overrides only apply from app version 6 onwards. Before, the
+// zero params charge appconsts.GasPerBlobByte for every namespace.
+func (k Keeper) gasParams(ctx sdk.Context) types.Params {
+	if ctx.ConsensusParams().Version.GetApp() < appconsts.V6 {
+		return types.Params{}
+	}
+	// read the params without charging gas so that the gas consumed by a PFB
+	// only depends on its blobs.
+	return k.GetParams(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()))
+}
+
 // UpdateBlobParams updates blob module parameters.
 func (k Keeper) UpdateBlobParams(goCtx context.Context, msg *types.MsgUpdateBlobParams) (*types.MsgUpdateBlobParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -73,7 +88,11 @@ func (k Keeper) UpdateBlobParams(goCtx context.Context, msg *types.MsgUpdateBlob
 		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority: expected: %s, got: %s", k.authority, msg.Authority)
 	}
 
-	if err := msg.Params.Validate(); err != nil {
+	validate := msg.Params.Validate
+	if ctx.ConsensusParams().Version.GetApp() < appconsts.V6 {
+		validate = msg.Params.ValidateV5
+	}
+	if err := validate(); err != nil {
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid parameters: %s", err)
 	}
 
@@ -58,6 +58,7 @@ func TestPayForBlobs(t *testing.T) {
 	assert.Equal(t, signer, event.Signer)
 	assert.Equal(t, namespaces, event.Namespaces)
 	assert.Equal(t, blobSizes, event.BlobSizes)
+	assert.Equal(t, msg.ShareCommitments, event.ShareCommitments)
 }
 
 func convertToEventPayForBlobs(message proto.Message) (*types.EventPayForBlobs, error) {
@@ -76,6 +77,10 @@ func createMsgPayForBlob(t *testing.T, signer string, namespace share.Namespace,
 }
 
 func CreateKeeper(t *testing.T, version uint64) (*keeper.Keeper, store.CommitMultiStore, sdk.Context) {
+	return createKeeperWithBankKeeper(t, version, nil)
+}
+
+func createKeeperWithBankKeeper(t *testing.T, version uint64, bankKeeper types.BankKeeper) (*keeper.Keeper, store.CommitMultiStore, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(paramtypes.StoreKey)
 	blobStoreKey := storetypes.NewKVStoreKey(types.StoreKey)
 	tStoreKey := storetypes.NewTransientStoreKey(paramtypes.TStoreKey)
@@ -94,7 +99,9 @@ func CreateKeeper(t *testing.T, version uint64) (*keeper.Keeper, store.CommitMul
 			Block: 1,
 			App:   version,
 		},
-	}, false, nil)
+	}, false, nil).WithConsensusParams(tmproto.ConsensusParams{
+		Version: &tmproto.VersionParams{App: version},
+	})
 
 	paramsSubspace := paramtypes.NewSubspace(cdc,
 		testutil.MakeAminoCodec(),
@@ -106,6 +113,7 @@ func CreateKeeper(t *testing.T, version uint64) (*keeper.Keeper, store.CommitMul
 		cdc,
 		blobStoreKey,
 		paramsSubspace,
+		bankKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
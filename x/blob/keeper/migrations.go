@@ -21,6 +21,24 @@ func (m *Migrator) MigrateParams(ctx sdk.Context) error {
 	var params blobtypes.Params
 	m.keeper.legacySubspace.GetParamSet(ctx, &params)
 
+	if err := params.ValidateV5(); err != nil {
+		return err
+	}
+
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
+
+// MigrateParamsV6 handles the migration of the blob module parameters to the
+// parameters of app version 6. The namespace gas overrides that were added in
+// app version 6 start out empty, the namespace claim bond is the default bond
+// and the namespace registry is disabled.
+func (m *Migrator) MigrateParamsV6(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.NamespaceGasOverrides = nil
+	params.NamespaceClaimBond = blobtypes.DefaultNamespaceClaimBond
+	params.NamespaceRegistryEnabled = false
+
 	if err := params.Validate(); err != nil {
 		return err
 	}
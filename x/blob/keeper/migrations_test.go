@@ -16,7 +16,7 @@ func TestMigrateParams(t *testing.T) {
 	}{
 		{
 			name:           "success",
-			expectedParams: blobtypes.DefaultParams(),
+			expectedParams: blobtypes.NewParams(blobtypes.DefaultGasPerBlobByte, blobtypes.DefaultGovMaxSquareSize),
 		},
 	}
 
@@ -26,7 +26,21 @@ func TestMigrateParams(t *testing.T) {
 			migrator := keeper.NewMigrator(*k)
 			err := migrator.MigrateParams(ctx)
 			require.NoError(t, err)
-			require.Equal(t, tt.expectedParams, k.GetParams(ctx))
+			params := k.GetParams(ctx)
+			require.Equal(t, tt.expectedParams.GasPerBlobByte, params.GasPerBlobByte)
+			require.Equal(t, tt.expectedParams.GovMaxSquareSize, params.GovMaxSquareSize)
+			require.True(t, params.NamespaceClaimBond.Amount.IsNil() || params.NamespaceClaimBond.IsZero())
 		})
 	}
 }
+
+func TestMigrateParamsV6(t *testing.T) {
+	k, _, ctx := CreateKeeper(t, appconsts.Version)
+	migrator := keeper.NewMigrator(*k)
+	require.NoError(t, migrator.MigrateParams(ctx))
+
+	require.NoError(t, migrator.MigrateParamsV6(ctx))
+	params := k.GetParams(ctx)
+	require.Equal(t, blobtypes.DefaultParamsV6(), params)
+	require.NoError(t, params.Validate())
+}
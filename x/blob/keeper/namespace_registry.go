@@ -0,0 +1,247 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v5/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetNamespaceRecord returns the record of the namespace and whether the
+// namespace has been claimed.
+func (k Keeper) GetNamespaceRecord(ctx sdk.Context, namespace []byte) (types.NamespaceRecord, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.NamespaceRecordKey(namespace))
+	if len(bz) == 0 {
+		return types.NamespaceRecord{}, false
+	}
+	var record types.NamespaceRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// SetNamespaceRecord stores the record of a claimed namespace.
+func (k Keeper) SetNamespaceRecord(ctx sdk.Context, record types.NamespaceRecord) {
+	bz := k.cdc.MustMarshal(&record)
+	ctx.KVStore(k.storeKey).Set(types.NamespaceRecordKey(record.Namespace), bz)
+}
+
+// DeleteNamespaceRecord removes the record of the namespace.
+func (k Keeper) DeleteNamespaceRecord(ctx sdk.Context, namespace []byte) {
+	ctx.KVStore(k.storeKey).Delete(types.NamespaceRecordKey(namespace))
+}
+
+// GetAllNamespaceRecords returns the records of every claimed namespace
+// ordered by namespace.
+func (k Keeper) GetAllNamespaceRecords(ctx sdk.Context) []types.NamespaceRecord {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NamespaceRecordKeyPrefix))
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	records := make([]types.NamespaceRecord, 0)
+	for ; it.Valid(); it.Next() {
+		var record types.NamespaceRecord
+		k.cdc.MustUnmarshal(it.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// IsNamespaceReserved returns true if governance reserved the namespace and it
+// can't be claimed.
+func (k Keeper) IsNamespaceReserved(ctx sdk.Context, namespace []byte) bool {
+	return ctx.KVStore(k.storeKey).Has(types.ReservedNamespaceKey(namespace))
+}
+
+// SetNamespaceReserved stores that the namespace is reserved.
+func (k Keeper) SetNamespaceReserved(ctx sdk.Context, namespace []byte) {
+	ctx.KVStore(k.storeKey).Set(types.ReservedNamespaceKey(namespace), []byte{})
+}
+
+// GetAllReservedNamespaces returns every reserved namespace ordered by
+// namespace.
+func (k Keeper) GetAllReservedNamespaces(ctx sdk.Context) [][]byte {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReservedNamespaceKeyPrefix))
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	namespaces := make([][]byte, 0)
+	for ; it.Valid(); it.Next() {
+		namespaces = append(namespaces, append([]byte{}, it.Key()...))
+	}
+	return namespaces
+}
+
+// IsNamespaceRegistryEnabled returns true if namespaces can be claimed and
+// only the allowed signers may pay for blobs in claimed namespaces. The
+// registry requires app version 6 and is enabled by the
+// NamespaceRegistryEnabled param.
+func (k Keeper) IsNamespaceRegistryEnabled(ctx sdk.Context) bool {
+	if ctx.ConsensusParams().Version.GetApp() < appconsts.V6 {
+		return false
+	}
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	// the params aren't stored yet while the genesis txs are delivered.
+	if !ctx.KVStore(k.storeKey).Has([]byte(types.ParamsKey)) {
+		return false
+	}
+	return k.GetParams(ctx).NamespaceRegistryEnabled
+}
+
+// checkNamespaceRegistryVersion returns an error if the app version does not
+// support the namespace registry.
+func checkNamespaceRegistryVersion(ctx sdk.Context) error {
+	if ctx.ConsensusParams().Version.GetApp() < appconsts.V6 {
+		return errors.Wrapf(types.ErrNamespaceRegistryDisabled, "requires app version %d", appconsts.V6)
+	}
+	return nil
+}
+
+// IsAllowedNamespaceSigner returns true if the signer may pay for blobs in the
+// namespace. Every signer is allowed in namespaces that have not been claimed.
+func (k Keeper) IsAllowedNamespaceSigner(ctx sdk.Context, namespace []byte, signer string) bool {
+	record, found := k.GetNamespaceRecord(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), namespace)
+	return !found || record.IsAllowedSigner(signer)
+}
+
+// ClaimNamespace registers the owner of an unclaimed namespace. The namespace
+// claim bond, if any, is escrowed in the blob module account until the
+// namespace is released.
+func (k Keeper) ClaimNamespace(goCtx context.Context, msg *types.MsgClaimNamespace) (*types.MsgClaimNamespaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := checkNamespaceRegistryVersion(ctx); err != nil {
+		return nil, err
+	}
+	if !k.IsNamespaceRegistryEnabled(ctx) {
+		return nil, errors.Wrap(types.ErrNamespaceRegistryDisabled, "namespaces can't be claimed")
+	}
+
+	if _, found := k.GetNamespaceRecord(ctx, msg.Namespace); found {
+		return nil, errors.Wrapf(types.ErrNamespaceAlreadyClaimed, "namespace %X", msg.Namespace)
+	}
+	if k.IsNamespaceReserved(ctx, msg.Namespace) {
+		return nil, errors.Wrapf(types.ErrNamespaceReserved, "namespace %X", msg.Namespace)
+	}
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	bond := k.GetParams(ctx).NamespaceClaimBond
+	if bond.IsValid() && bond.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(bond)); err != nil {
+			return nil, err
+		}
+	} else {
+		bond = sdk.Coin{}
+	}
+
+	k.SetNamespaceRecord(ctx, types.NewNamespaceRecord(msg.Namespace, msg.Owner, bond, nil))
+
+	if err := ctx.EventManager().EmitTypedEvent(
+		types.NewClaimNamespaceEvent(msg.Owner, msg.Namespace, bond),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimNamespaceResponse{}, nil
+}
+
+// UpdateNamespaceSigners replaces the signers that, in addition to the owner,
+// may pay for blobs in a claimed namespace.
+func (k Keeper) UpdateNamespaceSigners(goCtx context.Context, msg *types.MsgUpdateNamespaceSigners) (*types.MsgUpdateNamespaceSignersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := checkNamespaceRegistryVersion(ctx); err != nil {
+		return nil, err
+	}
+
+	record, err := k.getOwnedNamespaceRecord(ctx, msg.Namespace, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	record.AllowedSigners = msg.AllowedSigners
+	k.SetNamespaceRecord(ctx, record)
+
+	if err := ctx.EventManager().EmitTypedEvent(
+		types.NewUpdateNamespaceSignersEvent(msg.Owner, msg.Namespace, msg.AllowedSigners),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateNamespaceSignersResponse{}, nil
+}
+
+// ReleaseNamespace removes the record of a claimed namespace and refunds the
+// escrowed bond to its owner.
+func (k Keeper) ReleaseNamespace(goCtx context.Context, msg *types.MsgReleaseNamespace) (*types.MsgReleaseNamespaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := checkNamespaceRegistryVersion(ctx); err != nil {
+		return nil, err
+	}
+
+	record, err := k.getOwnedNamespaceRecord(ctx, msg.Namespace, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if record.Bond.IsValid() && record.Bond.IsPositive() {
+		owner, err := sdk.AccAddressFromBech32(record.Owner)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(record.Bond)); err != nil {
+			return nil, err
+		}
+	}
+
+	k.DeleteNamespaceRecord(ctx, msg.Namespace)
+
+	if err := ctx.EventManager().EmitTypedEvent(
+		types.NewReleaseNamespaceEvent(msg.Owner, msg.Namespace),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReleaseNamespaceResponse{}, nil
+}
+
+// ReserveNamespaces reserves namespaces that already have blobs so that they
+// can't be claimed. It can only be executed by governance.
+func (k Keeper) ReserveNamespaces(goCtx context.Context, msg *types.MsgReserveNamespaces) (*types.MsgReserveNamespacesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.GetAuthority() {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority: expected: %s, got: %s", k.authority, msg.Authority)
+	}
+	if err := checkNamespaceRegistryVersion(ctx); err != nil {
+		return nil, err
+	}
+
+	for _, namespace := range msg.Namespaces {
+		k.SetNamespaceReserved(ctx, namespace)
+	}
+
+	return &types.MsgReserveNamespacesResponse{}, nil
+}
+
+// getOwnedNamespaceRecord returns the record of the namespace if it has been
+// claimed by the owner.
+func (k Keeper) getOwnedNamespaceRecord(ctx sdk.Context, namespace []byte, owner string) (types.NamespaceRecord, error) {
+	record, found := k.GetNamespaceRecord(ctx, namespace)
+	if !found {
+		return types.NamespaceRecord{}, errors.Wrapf(types.ErrNamespaceNotClaimed, "namespace %X", namespace)
+	}
+	if record.Owner != owner {
+		return types.NamespaceRecord{}, errors.Wrapf(types.ErrNotNamespaceOwner, "expected %s, got %s", record.Owner, owner)
+	}
+	return record, nil
+}
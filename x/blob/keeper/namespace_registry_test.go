@@ -0,0 +1,204 @@
+package keeper_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v5/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNamespaceRegistry(t *testing.T) {
+	owner := "celestia15drmhzw5kwgenvemy30rqqqgq52axf5wwrruf7"
+	other := sdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String()
+	namespace := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	bond := sdk.NewCoin(appconsts.BondDenom, math.NewInt(1000))
+
+	bankKeeper := newMockBankKeeper()
+	k, _, ctx := createKeeperWithBankKeeper(t, appconsts.Version, bankKeeper)
+	params := types.DefaultParams()
+	params.NamespaceClaimBond = bond
+	params.NamespaceRegistryEnabled = true
+	k.SetParams(ctx, params)
+
+	// every signer is allowed in unclaimed namespaces
+	assert.True(t, k.IsAllowedNamespaceSigner(ctx, namespace.Bytes(), other))
+	_, err := k.NamespaceRecord(ctx, &types.QueryNamespaceRecordRequest{Namespace: namespace.Bytes()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = k.ClaimNamespace(ctx, types.NewMsgClaimNamespace(owner, namespace))
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewCoins(bond), bankKeeper.escrowed[owner])
+	_, err = k.ClaimNamespace(ctx, types.NewMsgClaimNamespace(other, namespace))
+	assert.ErrorIs(t, err, types.ErrNamespaceAlreadyClaimed)
+
+	resp, err := k.NamespaceRecord(ctx, &types.QueryNamespaceRecordRequest{Namespace: namespace.Bytes()})
+	require.NoError(t, err)
+	assert.Equal(t, types.NewNamespaceRecord(namespace.Bytes(), owner, bond, nil), resp.Record)
+	assert.True(t, k.IsAllowedNamespaceSigner(ctx, namespace.Bytes(), owner))
+	assert.False(t, k.IsAllowedNamespaceSigner(ctx, namespace.Bytes(), other))
+
+	_, err = k.UpdateNamespaceSigners(ctx, types.NewMsgUpdateNamespaceSigners(other, namespace, []string{other}))
+	assert.ErrorIs(t, err, types.ErrNotNamespaceOwner)
+	_, err = k.UpdateNamespaceSigners(ctx, types.NewMsgUpdateNamespaceSigners(owner, namespace, []string{other}))
+	require.NoError(t, err)
+	assert.True(t, k.IsAllowedNamespaceSigner(ctx, namespace.Bytes(), other))
+
+	_, err = k.ReleaseNamespace(ctx, types.NewMsgReleaseNamespace(other, namespace))
+	assert.ErrorIs(t, err, types.ErrNotNamespaceOwner)
+	_, err = k.ReleaseNamespace(ctx, types.NewMsgReleaseNamespace(owner, namespace))
+	require.NoError(t, err)
+	assert.True(t, bankKeeper.escrowed[owner].IsZero())
+	_, found := k.GetNamespaceRecord(ctx, namespace.Bytes())
+	assert.False(t, found)
+
+	_, err = k.ReleaseNamespace(ctx, types.NewMsgReleaseNamespace(owner, namespace))
+	assert.ErrorIs(t, err, types.ErrNamespaceNotClaimed)
+}
+
+func TestNamespaceRegistryDisabled(t *testing.T) {
+	owner := "celestia15drmhzw5kwgenvemy30rqqqgq52axf5wwrruf7"
+	namespace := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+
+	t.Run("disabled by default", func(t *testing.T) {
+		k, _, ctx := createKeeperWithBankKeeper(t, appconsts.Version, newMockBankKeeper())
+		k.SetParams(ctx, types.DefaultParams())
+		assert.False(t, k.IsNamespaceRegistryEnabled(ctx))
+
+		_, err := k.ClaimNamespace(ctx, types.NewMsgClaimNamespace(owner, namespace))
+		assert.ErrorIs(t, err, types.ErrNamespaceRegistryDisabled)
+	})
+
+	t.Run("before v6", func(t *testing.T) {
+		k, _, ctx := createKeeperWithBankKeeper(t, appconsts.V6-1, newMockBankKeeper())
+		params := types.DefaultParams()
+		params.NamespaceRegistryEnabled = true
+		k.SetParams(ctx, params)
+		assert.False(t, k.IsNamespaceRegistryEnabled(ctx))
+
+		_, err := k.ClaimNamespace(ctx, types.NewMsgClaimNamespace(owner, namespace))
+		assert.ErrorIs(t, err, types.ErrNamespaceRegistryDisabled)
+		_, err = k.UpdateNamespaceSigners(ctx, types.NewMsgUpdateNamespaceSigners(owner, namespace, nil))
+		assert.ErrorIs(t, err, types.ErrNamespaceRegistryDisabled)
+		_, err = k.ReleaseNamespace(ctx, types.NewMsgReleaseNamespace(owner, namespace))
+		assert.ErrorIs(t, err, types.ErrNamespaceRegistryDisabled)
+		_, err = k.ReserveNamespaces(ctx, types.NewMsgReserveNamespaces(k.GetAuthority(), namespace))
+		assert.ErrorIs(t, err, types.ErrNamespaceRegistryDisabled)
+	})
+}
+
+func TestReservedNamespaces(t *testing.T) {
+	owner := "celestia15drmhzw5kwgenvemy30rqqqgq52axf5wwrruf7"
+	withBlobs := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	reserved := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+	other := share.MustNewV0Namespace(bytes.Repeat([]byte{3}, share.NamespaceVersionZeroIDSize))
+
+	k, _, ctx := createKeeperWithBankKeeper(t, appconsts.Version, newMockBankKeeper())
+	params := types.DefaultParams()
+	params.NamespaceRegistryEnabled = true
+	k.SetParams(ctx, params)
+
+	// paying for blobs doesn't reserve the namespace nor charge gas for it
+	msg := &types.MsgPayForBlobs{Namespaces: [][]byte{other.Bytes()}, BlobSizes: []uint32{1}}
+	gasBefore := ctx.GasMeter().GasConsumed()
+	_, err := k.PayForBlobs(ctx, msg)
+	require.NoError(t, err)
+	assert.Equal(t, msg.Gas(appconsts.GasPerBlobByte), ctx.GasMeter().GasConsumed()-gasBefore)
+	assert.False(t, k.IsNamespaceReserved(ctx, other.Bytes()))
+
+	_, err = k.ReserveNamespaces(ctx, types.NewMsgReserveNamespaces(owner, reserved))
+	assert.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = k.ReserveNamespaces(ctx, types.NewMsgReserveNamespaces(k.GetAuthority(), withBlobs, reserved))
+	require.NoError(t, err)
+
+	for _, namespace := range []share.Namespace{withBlobs, reserved} {
+		_, err = k.ClaimNamespace(ctx, types.NewMsgClaimNamespace(owner, namespace))
+		assert.ErrorIs(t, err, types.ErrNamespaceReserved)
+	}
+	_, err = k.ClaimNamespace(ctx, types.NewMsgClaimNamespace(owner, other))
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{withBlobs.Bytes(), reserved.Bytes()}, k.ExportGenesis(ctx).ReservedNamespaces)
+}
+
+func TestNamespaceRecordsGenesis(t *testing.T) {
+	other := sdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String()
+	records := []types.NamespaceRecord{
+		types.NewNamespaceRecord(share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize)).Bytes(), "celestia15drmhzw5kwgenvemy30rqqqgq52axf5wwrruf7", sdk.NewCoin(appconsts.BondDenom, math.NewInt(10)), nil),
+		types.NewNamespaceRecord(share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize)).Bytes(), "celestia15drmhzw5kwgenvemy30rqqqgq52axf5wwrruf7", sdk.NewCoin(appconsts.BondDenom, math.NewInt(5)), []string{other}),
+	}
+	reserved := [][]byte{share.MustNewV0Namespace(bytes.Repeat([]byte{3}, share.NamespaceVersionZeroIDSize)).Bytes()}
+	genesisState := types.GenesisState{
+		Params:             types.DefaultParams(),
+		NamespaceRecords:   records,
+		ReservedNamespaces: reserved,
+	}
+	require.NoError(t, genesisState.Validate())
+
+	t.Run("escrow balance covers the bonds", func(t *testing.T) {
+		bankKeeper := newMockBankKeeper()
+		bankKeeper.balance = sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, math.NewInt(15)))
+		k, _, ctx := createKeeperWithBankKeeper(t, appconsts.Version, bankKeeper)
+		require.NoError(t, k.InitGenesis(ctx, genesisState))
+		exported := k.ExportGenesis(ctx)
+		assert.Equal(t, records, exported.NamespaceRecords)
+		assert.Equal(t, reserved, exported.ReservedNamespaces)
+	})
+
+	t.Run("escrow balance does not cover the bonds", func(t *testing.T) {
+		bankKeeper := newMockBankKeeper()
+		bankKeeper.balance = sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, math.NewInt(14)))
+		k, _, ctx := createKeeperWithBankKeeper(t, appconsts.Version, bankKeeper)
+		assert.ErrorContains(t, k.InitGenesis(ctx, genesisState), "does not cover the namespace claim bonds")
+	})
+}
+
+// mockBankKeeper tracks the coins escrowed by each account in the blob module
+// account.
+type mockBankKeeper struct {
+	escrowed map[string]sdk.Coins
+	// balance is the balance of the blob module account in addition to the
+	// escrowed coins.
+	balance sdk.Coins
+}
+
+func (m *mockBankKeeper) GetAllBalances(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	if !addr.Equals(authtypes.NewModuleAddress(types.ModuleName)) {
+		return sdk.NewCoins()
+	}
+	balance := m.balance
+	for _, escrowed := range m.escrowed {
+		balance = balance.Add(escrowed...)
+	}
+	return balance
+}
+
+func newMockBankKeeper() *mockBankKeeper {
+	return &mockBankKeeper{escrowed: make(map[string]sdk.Coins)}
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if recipientModule != types.ModuleName {
+		return sdkerrors.ErrUnknownAddress
+	}
+	m.escrowed[senderAddr.String()] = m.escrowed[senderAddr.String()].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	escrowed, hasNeg := m.escrowed[recipientAddr.String()].SafeSub(amt...)
+	if senderModule != types.ModuleName || hasNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	m.escrowed[recipientAddr.String()] = escrowed
+	return nil
+}
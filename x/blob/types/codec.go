@@ -18,6 +18,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgPayForBlobs{},
 		&MsgUpdateBlobParams{},
+		&MsgClaimNamespace{},
+		&MsgUpdateNamespaceSigners{},
+		&MsgReleaseNamespace{},
+		&MsgReserveNamespaces{},
 	)
 
 	registry.RegisterInterface(
@@ -37,4 +37,12 @@ var (
 	ErrTotalBlobSizeTooLarge = errors.Register(ModuleName, 11138, "total blob size too large")
 	ErrBlobsTooLarge         = errors.Register(ModuleName, 11139, "blob(s) too large")
 	ErrInvalidBlobSigner     = errors.Register(ModuleName, 11140, "invalid blob signer")
+
+	ErrNamespaceAlreadyClaimed     = errors.Register(ModuleName, 11141, "namespace already claimed")
+	ErrNamespaceNotClaimed         = errors.Register(ModuleName, 11142, "namespace not claimed")
+	ErrNotNamespaceOwner           = errors.Register(ModuleName, 11143, "signer is not the owner of the namespace")
+	ErrUnauthorizedNamespaceSigner = errors.Register(ModuleName, 11144, "signer is not allowed to pay for blobs in the namespace")
+	ErrTooManyAllowedSigners       = errors.Register(ModuleName, 11145, "too many allowed signers")
+	ErrNamespaceRegistryDisabled   = errors.Register(ModuleName, 11146, "namespace registry is disabled")
+	ErrNamespaceReserved           = errors.Register(ModuleName, 11147, "namespace is reserved")
 )
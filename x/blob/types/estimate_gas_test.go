@@ -61,6 +61,18 @@ func TestPFBGasEstimation(t *testing.T) {
 	}
 }
 
+func TestDefaultEstimateGasForNamespaces(t *testing.T) {
+	blobSizes := []uint32{1000, 1000}
+	namespaces := [][]byte{{0x01, 0x02}, {0x03}}
+	// the default params charge every namespace the same.
+	require.Equal(t, blobtypes.DefaultEstimateGas(blobSizes), blobtypes.DefaultEstimateGasForNamespaces(namespaces, blobSizes, blobtypes.DefaultParams()))
+
+	params := blobtypes.DefaultParams()
+	params.NamespaceGasOverrides = []blobtypes.NamespaceGasOverride{{NamespacePrefix: []byte{0x01}, GasPerBlobByte: 2 * appconsts.GasPerBlobByte}}
+	want := blobtypes.DefaultEstimateGas(blobSizes) + blobtypes.GasToConsume(blobSizes[:1], appconsts.GasPerBlobByte)
+	require.Equal(t, want, blobtypes.DefaultEstimateGasForNamespaces(namespaces, blobSizes, params))
+}
+
 func toUint32(arr []int) []uint32 {
 	res := make([]uint32, len(arr))
 	for i, v := range arr {
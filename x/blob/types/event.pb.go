@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -32,6 +33,9 @@ type EventPayForBlobs struct {
 	// A namespace has length of 29 bytes where the first byte is the
 	// namespaceVersion and the subsequent 28 bytes are the namespaceID.
 	Namespaces [][]byte `protobuf:"bytes,3,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	// share_commitments is a list of share commitments of the blobs in
+	// blob_sizes, in the same order.
+	ShareCommitments [][]byte `protobuf:"bytes,4,rep,name=share_commitments,json=shareCommitments,proto3" json:"share_commitments,omitempty"`
 }
 
 func (m *EventPayForBlobs) Reset()         { *m = EventPayForBlobs{} }
@@ -88,6 +92,13 @@ func (m *EventPayForBlobs) GetNamespaces() [][]byte {
 	return nil
 }
 
+func (m *EventPayForBlobs) GetShareCommitments() [][]byte {
+	if m != nil {
+		return m.ShareCommitments
+	}
+	return nil
+}
+
 // EventUpdateBlobParams defines an event that is emitted when blob parameters are
 // updated. It is triggered after a successful execution of a parameter update proposal.
 type EventUpdateBlobParams struct {
@@ -142,33 +153,224 @@ func (m *EventUpdateBlobParams) GetParams() Params {
 	return Params{}
 }
 
+// EventClaimNamespace defines an event that is emitted when a namespace is
+// claimed.
+type EventClaimNamespace struct {
+	Owner     string     `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace []byte     `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Bond      types.Coin `protobuf:"bytes,3,opt,name=bond,proto3" json:"bond"`
+}
+
+func (m *EventClaimNamespace) Reset()         { *m = EventClaimNamespace{} }
+func (m *EventClaimNamespace) String() string { return proto.CompactTextString(m) }
+func (*EventClaimNamespace) ProtoMessage()    {}
+func (*EventClaimNamespace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d90f0a63835a06e, []int{2}
+}
+func (m *EventClaimNamespace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventClaimNamespace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventClaimNamespace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventClaimNamespace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventClaimNamespace.Merge(m, src)
+}
+func (m *EventClaimNamespace) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventClaimNamespace) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventClaimNamespace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventClaimNamespace proto.InternalMessageInfo
+
+func (m *EventClaimNamespace) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventClaimNamespace) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *EventClaimNamespace) GetBond() types.Coin {
+	if m != nil {
+		return m.Bond
+	}
+	return types.Coin{}
+}
+
+// EventUpdateNamespaceSigners defines an event that is emitted when the
+// allowed signers of a namespace are updated.
+type EventUpdateNamespaceSigners struct {
+	Owner          string   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace      []byte   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	AllowedSigners []string `protobuf:"bytes,3,rep,name=allowed_signers,json=allowedSigners,proto3" json:"allowed_signers,omitempty"`
+}
+
+func (m *EventUpdateNamespaceSigners) Reset()         { *m = EventUpdateNamespaceSigners{} }
+func (m *EventUpdateNamespaceSigners) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateNamespaceSigners) ProtoMessage()    {}
+func (*EventUpdateNamespaceSigners) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d90f0a63835a06e, []int{3}
+}
+func (m *EventUpdateNamespaceSigners) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateNamespaceSigners) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateNamespaceSigners.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateNamespaceSigners) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateNamespaceSigners.Merge(m, src)
+}
+func (m *EventUpdateNamespaceSigners) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateNamespaceSigners) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateNamespaceSigners.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateNamespaceSigners proto.InternalMessageInfo
+
+func (m *EventUpdateNamespaceSigners) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventUpdateNamespaceSigners) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *EventUpdateNamespaceSigners) GetAllowedSigners() []string {
+	if m != nil {
+		return m.AllowedSigners
+	}
+	return nil
+}
+
+// EventReleaseNamespace defines an event that is emitted when a namespace is
+// released.
+type EventReleaseNamespace struct {
+	Owner     string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *EventReleaseNamespace) Reset()         { *m = EventReleaseNamespace{} }
+func (m *EventReleaseNamespace) String() string { return proto.CompactTextString(m) }
+func (*EventReleaseNamespace) ProtoMessage()    {}
+func (*EventReleaseNamespace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d90f0a63835a06e, []int{4}
+}
+func (m *EventReleaseNamespace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventReleaseNamespace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventReleaseNamespace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventReleaseNamespace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventReleaseNamespace.Merge(m, src)
+}
+func (m *EventReleaseNamespace) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventReleaseNamespace) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventReleaseNamespace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventReleaseNamespace proto.InternalMessageInfo
+
+func (m *EventReleaseNamespace) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventReleaseNamespace) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EventPayForBlobs)(nil), "celestia.blob.v1.EventPayForBlobs")
 	proto.RegisterType((*EventUpdateBlobParams)(nil), "celestia.blob.v1.EventUpdateBlobParams")
+	proto.RegisterType((*EventClaimNamespace)(nil), "celestia.blob.v1.EventClaimNamespace")
+	proto.RegisterType((*EventUpdateNamespaceSigners)(nil), "celestia.blob.v1.EventUpdateNamespaceSigners")
+	proto.RegisterType((*EventReleaseNamespace)(nil), "celestia.blob.v1.EventReleaseNamespace")
 }
 
 func init() { proto.RegisterFile("celestia/blob/v1/event.proto", fileDescriptor_9d90f0a63835a06e) }
 
 var fileDescriptor_9d90f0a63835a06e = []byte{
-	// 288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x4f, 0xca, 0xc9, 0x4f, 0xd2, 0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0x4b,
-	0xcd, 0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc9, 0xea, 0x81, 0x64, 0xf5,
-	0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0x94,
-	0x2c, 0x86, 0x29, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x10, 0x69, 0xa5, 0x4c, 0x2e, 0x01, 0x57,
-	0x90, 0xa9, 0x01, 0x89, 0x95, 0x6e, 0xf9, 0x45, 0x4e, 0x39, 0xf9, 0x49, 0xc5, 0x42, 0x62, 0x5c,
-	0x6c, 0xc5, 0x99, 0xe9, 0x79, 0xa9, 0x45, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x50, 0x9e,
-	0x90, 0x2c, 0x17, 0x17, 0xc8, 0x8c, 0xf8, 0xe2, 0xcc, 0xaa, 0xd4, 0x62, 0x09, 0x26, 0x05, 0x66,
-	0x0d, 0xde, 0x20, 0x4e, 0x90, 0x48, 0x30, 0x48, 0x40, 0x48, 0x8e, 0x8b, 0x2b, 0x2f, 0x31, 0x37,
-	0xb5, 0xb8, 0x20, 0x31, 0x39, 0xb5, 0x58, 0x82, 0x59, 0x81, 0x59, 0x83, 0x27, 0x08, 0x49, 0x44,
-	0x29, 0x9d, 0x4b, 0x14, 0x6c, 0x55, 0x68, 0x41, 0x4a, 0x62, 0x49, 0x2a, 0xc8, 0xaa, 0x00, 0xb0,
-	0x4b, 0x70, 0xda, 0x67, 0xc6, 0xc5, 0x06, 0x71, 0xab, 0x04, 0x93, 0x02, 0xa3, 0x06, 0xb7, 0x91,
-	0x84, 0x1e, 0xba, 0x9f, 0xf5, 0x20, 0x26, 0x38, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x04, 0x55,
-	0xed, 0xe4, 0x75, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x06, 0xe9, 0x99,
-	0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x30, 0xb3, 0xf2, 0x8b, 0xd2, 0xe1, 0x6c,
-	0xdd, 0xc4, 0x82, 0x02, 0xfd, 0x0a, 0x48, 0x48, 0x95, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81,
-	0x83, 0xc9, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x10, 0x54, 0xa1, 0x8a, 0x8d, 0x01, 0x00, 0x00,
+	// 446 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0x9b, 0x10, 0x29, 0x43, 0x81, 0x60, 0x0a, 0x32, 0xa5, 0x35, 0x91, 0x2f, 0x44, 0x42,
+	0xec, 0x12, 0x2a, 0xf1, 0x00, 0x89, 0xe0, 0x00, 0x12, 0xaa, 0x5c, 0x71, 0xe1, 0x12, 0xad, 0x9d,
+	0x91, 0x6b, 0xc9, 0xf6, 0x58, 0x9e, 0xc5, 0xa5, 0x3d, 0xf0, 0x0c, 0x9c, 0x78, 0xa6, 0x1e, 0x7b,
+	0xe4, 0x84, 0x50, 0xf2, 0x22, 0xc8, 0xbb, 0x4e, 0x52, 0x81, 0xb8, 0xd0, 0xdb, 0xee, 0xf7, 0x8d,
+	0xe6, 0xfb, 0xd9, 0x85, 0x83, 0x18, 0x33, 0x64, 0x9d, 0x2a, 0x19, 0x65, 0x14, 0xc9, 0x7a, 0x22,
+	0xb1, 0xc6, 0x42, 0x8b, 0xb2, 0x22, 0x4d, 0xee, 0x70, 0xcd, 0x8a, 0x86, 0x15, 0xf5, 0x64, 0x7f,
+	0x2f, 0xa1, 0x84, 0x0c, 0x29, 0x9b, 0x93, 0x9d, 0xdb, 0x3f, 0xfc, 0x6b, 0x4b, 0xa9, 0x2a, 0x95,
+	0x73, 0x4b, 0xfb, 0x31, 0x71, 0x4e, 0x2c, 0x23, 0xc5, 0x28, 0xeb, 0x49, 0x84, 0x5a, 0x4d, 0x64,
+	0x4c, 0x69, 0x61, 0xf9, 0xe0, 0xbb, 0x03, 0xc3, 0x37, 0x8d, 0xec, 0xb1, 0x3a, 0x7f, 0x4b, 0xd5,
+	0x34, 0xa3, 0x88, 0xdd, 0x47, 0xd0, 0xe7, 0x34, 0x29, 0xb0, 0xf2, 0x9c, 0x91, 0x33, 0x1e, 0x84,
+	0xed, 0xcd, 0x3d, 0x04, 0x68, 0x44, 0xe6, 0x9c, 0x5e, 0x20, 0x7b, 0x3b, 0xa3, 0xee, 0xf8, 0x4e,
+	0x38, 0x68, 0x90, 0x93, 0x06, 0x70, 0x7d, 0x80, 0x42, 0xe5, 0xc8, 0xa5, 0x8a, 0x91, 0xbd, 0xee,
+	0xa8, 0x3b, 0xde, 0x0d, 0xaf, 0x21, 0xee, 0x73, 0xb8, 0xcf, 0xa7, 0xaa, 0xc2, 0x79, 0x4c, 0x79,
+	0x9e, 0xea, 0x1c, 0x0b, 0xcd, 0x5e, 0xcf, 0x8c, 0x0d, 0x0d, 0x31, 0xdb, 0xe2, 0x41, 0x02, 0x0f,
+	0x8d, 0xaf, 0x8f, 0xe5, 0x42, 0x69, 0x6c, 0x7c, 0x1d, 0x9b, 0x5c, 0xff, 0x34, 0xf7, 0x1a, 0xfa,
+	0x36, 0xb9, 0xb7, 0x33, 0x72, 0xc6, 0xb7, 0x5f, 0x79, 0xe2, 0xcf, 0x06, 0x85, 0xdd, 0x30, 0xed,
+	0x5d, 0xfe, 0x7c, 0xda, 0x09, 0xdb, 0xe9, 0xe0, 0x2b, 0x3c, 0x30, 0x42, 0xb3, 0x4c, 0xa5, 0xf9,
+	0x87, 0xb5, 0x5b, 0x77, 0x0f, 0x6e, 0xd1, 0xd9, 0x56, 0xc5, 0x5e, 0xdc, 0x03, 0x18, 0x6c, 0x02,
+	0x19, 0x9d, 0xdd, 0x70, 0x0b, 0xb8, 0x47, 0xd0, 0x8b, 0xa8, 0x58, 0x78, 0x5d, 0x63, 0xe0, 0xb1,
+	0xb0, 0xdd, 0x8b, 0xa6, 0x7b, 0xd1, 0x76, 0x2f, 0x66, 0x94, 0x16, 0xad, 0x03, 0x33, 0x1c, 0x5c,
+	0xc0, 0x93, 0x6b, 0x41, 0x37, 0x06, 0x4e, 0x4c, 0x2a, 0xfe, 0x2f, 0x1f, 0xcf, 0xe0, 0x9e, 0xca,
+	0x32, 0x3a, 0xc3, 0xc5, 0xdc, 0x96, 0x63, 0x5f, 0x63, 0x10, 0xde, 0x6d, 0xe1, 0x76, 0x79, 0xf0,
+	0xbe, 0x2d, 0x39, 0xc4, 0x0c, 0x15, 0xe3, 0x8d, 0xd2, 0x4f, 0xdf, 0x5d, 0x2e, 0x7d, 0xe7, 0x6a,
+	0xe9, 0x3b, 0xbf, 0x96, 0xbe, 0xf3, 0x6d, 0xe5, 0x77, 0xae, 0x56, 0x7e, 0xe7, 0xc7, 0xca, 0xef,
+	0x7c, 0x7a, 0x99, 0xa4, 0xfa, 0xf4, 0x73, 0x24, 0x62, 0xca, 0xe5, 0xfa, 0x51, 0xa8, 0x4a, 0x36,
+	0xe7, 0x17, 0xaa, 0x2c, 0xe5, 0x17, 0xfb, 0x81, 0xf5, 0x79, 0x89, 0x1c, 0xf5, 0xcd, 0xef, 0x3c,
+	0xfa, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x51, 0x59, 0xca, 0xa1, 0x24, 0x03, 0x00, 0x00,
 }
 
 func (m *EventPayForBlobs) Marshal() (dAtA []byte, err error) {
@@ -191,6 +393,15 @@ func (m *EventPayForBlobs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ShareCommitments) > 0 {
+		for iNdEx := len(m.ShareCommitments) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ShareCommitments[iNdEx])
+			copy(dAtA[i:], m.ShareCommitments[iNdEx])
+			i = encodeVarintEvent(dAtA, i, uint64(len(m.ShareCommitments[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Namespaces) > 0 {
 		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Namespaces[iNdEx])
@@ -268,6 +479,136 @@ func (m *EventUpdateBlobParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventClaimNamespace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventClaimNamespace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventClaimNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Bond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateNamespaceSigners) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateNamespaceSigners) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateNamespaceSigners) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedSigners) > 0 {
+		for iNdEx := len(m.AllowedSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSigners[iNdEx])
+			copy(dAtA[i:], m.AllowedSigners[iNdEx])
+			i = encodeVarintEvent(dAtA, i, uint64(len(m.AllowedSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventReleaseNamespace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventReleaseNamespace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventReleaseNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -302,6 +643,12 @@ func (m *EventPayForBlobs) Size() (n int) {
 			n += 1 + l + sovEvent(uint64(l))
 		}
 	}
+	if len(m.ShareCommitments) > 0 {
+		for _, b := range m.ShareCommitments {
+			l = len(b)
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -320,6 +667,65 @@ func (m *EventUpdateBlobParams) Size() (n int) {
 	return n
 }
 
+func (m *EventClaimNamespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Bond.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventUpdateNamespaceSigners) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.AllowedSigners) > 0 {
+		for _, s := range m.AllowedSigners {
+			l = len(s)
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventReleaseNamespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -495,12 +901,44 @@ func (m *EventPayForBlobs) Unmarshal(dAtA []byte) error {
 			m.Namespaces = append(m.Namespaces, make([]byte, postIndex-iNdEx))
 			copy(m.Namespaces[len(m.Namespaces)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareCommitments", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareCommitments = append(m.ShareCommitments, make([]byte, postIndex-iNdEx))
+			copy(m.ShareCommitments[len(m.ShareCommitments)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
@@ -631,6 +1069,419 @@ func (m *EventUpdateBlobParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventClaimNamespace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventClaimNamespace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventClaimNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateNamespaceSigners) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateNamespaceSigners: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateNamespaceSigners: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSigners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSigners = append(m.AllowedSigners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventReleaseNamespace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventReleaseNamespace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventReleaseNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
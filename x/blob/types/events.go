@@ -1,6 +1,7 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
 )
 
@@ -10,11 +11,12 @@ var (
 )
 
 // NewPayForBlobsEvent returns a new EventPayForBlobs
-func NewPayForBlobsEvent(signer string, blobSizes []uint32, namespaces [][]byte) *EventPayForBlobs {
+func NewPayForBlobsEvent(signer string, blobSizes []uint32, namespaces, shareCommitments [][]byte) *EventPayForBlobs {
 	return &EventPayForBlobs{
-		Signer:     signer,
-		BlobSizes:  blobSizes,
-		Namespaces: namespaces,
+		Signer:           signer,
+		BlobSizes:        blobSizes,
+		Namespaces:       namespaces,
+		ShareCommitments: shareCommitments,
 	}
 }
 
@@ -25,3 +27,29 @@ func NewUpdateBlobParamsEvent(authority string, params Params) *EventUpdateBlobP
 		Params: params,
 	}
 }
+
+// NewClaimNamespaceEvent returns a new EventClaimNamespace
+func NewClaimNamespaceEvent(owner string, namespace []byte, bond sdk.Coin) *EventClaimNamespace {
+	return &EventClaimNamespace{
+		Owner:     owner,
+		Namespace: namespace,
+		Bond:      bond,
+	}
+}
+
+// NewUpdateNamespaceSignersEvent returns a new EventUpdateNamespaceSigners
+func NewUpdateNamespaceSignersEvent(owner string, namespace []byte, allowedSigners []string) *EventUpdateNamespaceSigners {
+	return &EventUpdateNamespaceSigners{
+		Owner:          owner,
+		Namespace:      namespace,
+		AllowedSigners: allowedSigners,
+	}
+}
+
+// NewReleaseNamespaceEvent returns a new EventReleaseNamespace
+func NewReleaseNamespaceEvent(owner string, namespace []byte) *EventReleaseNamespace {
+	return &EventReleaseNamespace{
+		Owner:     owner,
+		Namespace: namespace,
+	}
+}
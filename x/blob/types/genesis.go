@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultIndex is the default capability global index
 const DefaultIndex uint64 = 1
 
@@ -11,7 +13,22 @@ func DefaultGenesis() *GenesisState {
 }
 
 // Validate performs basic genesis state validation returning an error upon any
-// failure.
+// failure. The params may also be the params of an app version before 6, which
+// don't have the params added in app version 6.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil && gs.Params.ValidateV5() != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(gs.NamespaceRecords))
+	for _, record := range gs.NamespaceRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seen[string(record.Namespace)]; ok {
+			return fmt.Errorf("duplicate namespace record %X", record.Namespace)
+		}
+		seen[string(record.Namespace)] = struct{}{}
+	}
+	return validateReservedNamespaces(gs.ReservedNamespaces)
 }
@@ -26,6 +26,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the capability module's genesis state.
 type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// namespace_records are the claimed namespaces.
+	NamespaceRecords []NamespaceRecord `protobuf:"bytes,2,rep,name=namespace_records,json=namespaceRecords,proto3" json:"namespace_records"`
+	// reserved_namespaces are the namespaces that already have blobs and can't
+	// be claimed.
+	ReservedNamespaces [][]byte `protobuf:"bytes,3,rep,name=reserved_namespaces,json=reservedNamespaces,proto3" json:"reserved_namespaces,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,6 +73,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetNamespaceRecords() []NamespaceRecord {
+	if m != nil {
+		return m.NamespaceRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReservedNamespaces() [][]byte {
+	if m != nil {
+		return m.ReservedNamespaces
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "celestia.blob.v1.GenesisState")
 }
@@ -75,20 +94,25 @@ func init() {
 func init() { proto.RegisterFile("celestia/blob/v1/genesis.proto", fileDescriptor_c0b3a6e29bb6777c) }
 
 var fileDescriptor_c0b3a6e29bb6777c = []byte{
-	// 198 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x4f, 0xca, 0xc9, 0x4f, 0xd2, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd,
-	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc9, 0xeb, 0x81,
-	0xe4, 0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44,
-	0x9d, 0x94, 0x2c, 0x86, 0x39, 0x05, 0x89, 0x45, 0x89, 0xb9, 0x50, 0x63, 0x94, 0xdc, 0xb8, 0x78,
-	0xdc, 0x21, 0xe6, 0x06, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x99, 0x71, 0xb1, 0x41, 0xe4, 0x25, 0x18,
-	0x15, 0x18, 0x35, 0xb8, 0x8d, 0x24, 0xf4, 0xd0, 0xed, 0xd1, 0x0b, 0x00, 0xcb, 0x3b, 0xb1, 0x9c,
-	0xb8, 0x27, 0xcf, 0x10, 0x04, 0x55, 0xed, 0xe4, 0x75, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
-	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
-	0x72, 0x0c, 0x51, 0x06, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x30,
-	0xb3, 0xf2, 0x8b, 0xd2, 0xe1, 0x6c, 0xdd, 0xc4, 0x82, 0x02, 0xfd, 0x0a, 0x88, 0xeb, 0x4a, 0x2a,
-	0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x4e, 0x33, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x9f, 0x63,
-	0x14, 0xe2, 0x03, 0x01, 0x00, 0x00,
+	// 280 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0x3f, 0x4b, 0xc3, 0x40,
+	0x14, 0xc0, 0x73, 0x56, 0x3a, 0xa4, 0x1d, 0x6a, 0x74, 0x08, 0x01, 0xcf, 0xe8, 0x94, 0xc5, 0x3b,
+	0x5b, 0xc1, 0x0f, 0xd0, 0x45, 0x70, 0x10, 0x89, 0x4e, 0x2e, 0xe5, 0x92, 0x3e, 0x62, 0xa0, 0xc9,
+	0x1d, 0xf7, 0xce, 0xa0, 0xdf, 0xc2, 0x8f, 0xd5, 0x45, 0xe8, 0xe8, 0x24, 0x92, 0x7c, 0x11, 0x69,
+	0xfe, 0x81, 0x66, 0x7b, 0xdc, 0xef, 0xc7, 0xef, 0x1e, 0xcf, 0xa6, 0x31, 0x6c, 0x00, 0x4d, 0x2a,
+	0x78, 0xb4, 0x91, 0x11, 0x2f, 0xe6, 0x3c, 0x81, 0x1c, 0x30, 0x45, 0xa6, 0xb4, 0x34, 0xd2, 0x99,
+	0x75, 0x9c, 0xed, 0x39, 0x2b, 0xe6, 0xde, 0x49, 0x22, 0x13, 0x59, 0x43, 0xbe, 0x9f, 0x1a, 0xcf,
+	0x3b, 0x1d, 0x74, 0x94, 0xd0, 0x22, 0x6b, 0x33, 0x9e, 0x3f, 0xc0, 0xb9, 0xc8, 0x00, 0x95, 0x88,
+	0xa1, 0x31, 0x2e, 0x3e, 0x89, 0x3d, 0xbd, 0x6d, 0xbe, 0x7e, 0x34, 0xc2, 0x80, 0x73, 0x63, 0x8f,
+	0x9b, 0x84, 0x4b, 0x7c, 0x12, 0x4c, 0x16, 0x2e, 0xfb, 0xbf, 0x0a, 0x7b, 0xa8, 0xf9, 0xf2, 0x70,
+	0xfb, 0x7d, 0x66, 0x85, 0xad, 0xed, 0x3c, 0xd9, 0x47, 0x7d, 0x7b, 0xa5, 0x21, 0x96, 0x7a, 0x8d,
+	0xee, 0x81, 0x3f, 0x0a, 0x26, 0x8b, 0xf3, 0x61, 0xe2, 0xbe, 0x53, 0xc3, 0xda, 0x6c, 0x5b, 0xb3,
+	0xfc, 0xef, 0x33, 0x3a, 0xdc, 0x3e, 0xd6, 0x80, 0xa0, 0x0b, 0x58, 0xaf, 0x7a, 0x88, 0xee, 0xc8,
+	0x1f, 0x05, 0xd3, 0xd0, 0xe9, 0x50, 0x5f, 0xc3, 0xe5, 0xdd, 0xb6, 0xa4, 0x64, 0x57, 0x52, 0xf2,
+	0x53, 0x52, 0xf2, 0x51, 0x51, 0x6b, 0x57, 0x51, 0xeb, 0xab, 0xa2, 0xd6, 0xf3, 0x55, 0x92, 0x9a,
+	0x97, 0xd7, 0x88, 0xc5, 0x32, 0xe3, 0xdd, 0x3e, 0x52, 0x27, 0xfd, 0x7c, 0x29, 0x94, 0xe2, 0x6f,
+	0xcd, 0xa1, 0xcc, 0xbb, 0x02, 0x8c, 0xc6, 0xf5, 0x89, 0xae, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff,
+	0x42, 0xf7, 0x87, 0x72, 0xad, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -111,6 +135,29 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReservedNamespaces) > 0 {
+		for iNdEx := len(m.ReservedNamespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReservedNamespaces[iNdEx])
+			copy(dAtA[i:], m.ReservedNamespaces[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ReservedNamespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.NamespaceRecords) > 0 {
+		for iNdEx := len(m.NamespaceRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NamespaceRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -143,6 +190,18 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.NamespaceRecords) > 0 {
+		for _, e := range m.NamespaceRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReservedNamespaces) > 0 {
+		for _, b := range m.ReservedNamespaces {
+			l = len(b)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -214,6 +273,72 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceRecords = append(m.NamespaceRecords, NamespaceRecord{})
+			if err := m.NamespaceRecords[len(m.NamespaceRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedNamespaces", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReservedNamespaces = append(m.ReservedNamespaces, make([]byte, postIndex-iNdEx))
+			copy(m.ReservedNamespaces[len(m.ReservedNamespaces)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
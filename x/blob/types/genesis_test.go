@@ -1,14 +1,19 @@
 package types_test
 
 import (
+	"bytes"
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v5/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	namespace := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -39,6 +44,25 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "invalid genesis state because of a zero namespace claim bond",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					GasPerBlobByte:     20,
+					GovMaxSquareSize:   uint64(appconsts.SquareSizeUpperBound),
+					NamespaceClaimBond: sdk.NewCoin(appconsts.BondDenom, math.ZeroInt()),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state because of a duplicate reserved namespace",
+			genState: &types.GenesisState{
+				Params:             types.DefaultParams(),
+				ReservedNamespaces: [][]byte{namespace.Bytes(), namespace.Bytes()},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
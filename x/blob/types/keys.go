@@ -12,8 +12,28 @@ const (
 
 	// ParamsKey defines the key used for storing module parameters
 	ParamsKey = "params"
+
+	// NamespaceRecordKeyPrefix defines the prefix of the keys used for storing
+	// the records of claimed namespaces.
+	NamespaceRecordKeyPrefix = "namespace_record/"
+
+	// ReservedNamespaceKeyPrefix defines the prefix of the keys used for
+	// storing the namespaces that already have blobs and can't be claimed.
+	ReservedNamespaceKeyPrefix = "reserved_namespace/"
 )
 
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
+
+// NamespaceRecordKey returns the key used for storing the record of the
+// namespace.
+func NamespaceRecordKey(namespace []byte) []byte {
+	return append(KeyPrefix(NamespaceRecordKeyPrefix), namespace...)
+}
+
+// ReservedNamespaceKey returns the key used for storing that the namespace is
+// reserved.
+func ReservedNamespaceKey(namespace []byte) []byte {
+	return append(KeyPrefix(ReservedNamespaceKeyPrefix), namespace...)
+}
@@ -1,10 +1,22 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"cosmossdk.io/errors"
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxNamespaceAllowedSigners is the maximum number of signers, in addition to
+// the owner, that can be allowed to pay for blobs in a claimed namespace.
+const MaxNamespaceAllowedSigners = 32
 
 var (
 	_ sdk.Msg = (*MsgPayForBlobs)(nil)
 	_ sdk.Msg = (*MsgUpdateBlobParams)(nil)
+	_ sdk.Msg = (*MsgClaimNamespace)(nil)
+	_ sdk.Msg = (*MsgUpdateNamespaceSigners)(nil)
+	_ sdk.Msg = (*MsgReleaseNamespace)(nil)
+	_ sdk.Msg = (*MsgReserveNamespaces)(nil)
 )
 
 // NewMsgUpdateBlobParams creates a new MsgUpdateBlobParams instance.
@@ -14,3 +26,123 @@ func NewMsgUpdateBlobParams(authority string, params Params) *MsgUpdateBlobParam
 		Params:    params,
 	}
 }
+
+// NewMsgClaimNamespace creates a new MsgClaimNamespace instance.
+func NewMsgClaimNamespace(owner string, namespace share.Namespace) *MsgClaimNamespace {
+	return &MsgClaimNamespace{
+		Owner:     owner,
+		Namespace: namespace.Bytes(),
+	}
+}
+
+// ValidateBasic performs stateless validity checks on the msg.
+func (msg *MsgClaimNamespace) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return err
+	}
+	return validateClaimableNamespace(msg.Namespace)
+}
+
+// NewMsgUpdateNamespaceSigners creates a new MsgUpdateNamespaceSigners instance.
+func NewMsgUpdateNamespaceSigners(owner string, namespace share.Namespace, allowedSigners []string) *MsgUpdateNamespaceSigners {
+	return &MsgUpdateNamespaceSigners{
+		Owner:          owner,
+		Namespace:      namespace.Bytes(),
+		AllowedSigners: allowedSigners,
+	}
+}
+
+// ValidateBasic performs stateless validity checks on the msg.
+func (msg *MsgUpdateNamespaceSigners) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return err
+	}
+	if err := validateClaimableNamespace(msg.Namespace); err != nil {
+		return err
+	}
+	return validateAllowedSigners(msg.AllowedSigners)
+}
+
+// NewMsgReleaseNamespace creates a new MsgReleaseNamespace instance.
+func NewMsgReleaseNamespace(owner string, namespace share.Namespace) *MsgReleaseNamespace {
+	return &MsgReleaseNamespace{
+		Owner:     owner,
+		Namespace: namespace.Bytes(),
+	}
+}
+
+// ValidateBasic performs stateless validity checks on the msg.
+func (msg *MsgReleaseNamespace) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return err
+	}
+	return validateClaimableNamespace(msg.Namespace)
+}
+
+// NewMsgReserveNamespaces creates a new MsgReserveNamespaces instance.
+func NewMsgReserveNamespaces(authority string, namespaces ...share.Namespace) *MsgReserveNamespaces {
+	msg := &MsgReserveNamespaces{
+		Authority:  authority,
+		Namespaces: make([][]byte, len(namespaces)),
+	}
+	for i, namespace := range namespaces {
+		msg.Namespaces[i] = namespace.Bytes()
+	}
+	return msg
+}
+
+// ValidateBasic performs stateless validity checks on the msg.
+func (msg *MsgReserveNamespaces) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+	if len(msg.Namespaces) == 0 {
+		return ErrNoNamespaces
+	}
+	return validateReservedNamespaces(msg.Namespaces)
+}
+
+// validateReservedNamespaces returns an error if the namespaces are not unique
+// valid user-specifiable blob namespaces.
+func validateReservedNamespaces(namespaces [][]byte) error {
+	seen := make(map[string]struct{}, len(namespaces))
+	for _, namespace := range namespaces {
+		if err := validateClaimableNamespace(namespace); err != nil {
+			return err
+		}
+		if _, ok := seen[string(namespace)]; ok {
+			return errors.Wrapf(ErrInvalidNamespace, "duplicate namespace %X", namespace)
+		}
+		seen[string(namespace)] = struct{}{}
+	}
+	return nil
+}
+
+// validateClaimableNamespace returns an error if the namespace is not a valid
+// user-specifiable blob namespace.
+func validateClaimableNamespace(namespace []byte) error {
+	ns, err := share.NewNamespaceFromBytes(namespace)
+	if err != nil {
+		return errors.Wrap(ErrInvalidNamespace, err.Error())
+	}
+	return ValidateBlobNamespace(ns)
+}
+
+// validateAllowedSigners returns an error if the allowed signers are not
+// unique valid addresses or if there are too many of them.
+func validateAllowedSigners(allowedSigners []string) error {
+	if len(allowedSigners) > MaxNamespaceAllowedSigners {
+		return ErrTooManyAllowedSigners.Wrapf("got %d, max %d", len(allowedSigners), MaxNamespaceAllowedSigners)
+	}
+	seen := make(map[string]struct{}, len(allowedSigners))
+	for _, signer := range allowedSigners {
+		if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+			return errors.Wrapf(err, "invalid allowed signer %s", signer)
+		}
+		if _, ok := seen[signer]; ok {
+			return ErrInvalidBlobSigner.Wrapf("duplicate allowed signer %s", signer)
+		}
+		seen[signer] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMsgUpdateNamespaceSignersValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+	signer := sdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String()
+	namespace := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+
+	tooManySigners := make([]string, MaxNamespaceAllowedSigners+1)
+	for i := range tooManySigners {
+		tooManySigners[i] = sdk.AccAddress(bytes.Repeat([]byte{byte(i)}, 20)).String()
+	}
+
+	tests := []struct {
+		name    string
+		msg     *MsgUpdateNamespaceSigners
+		wantErr bool
+	}{
+		{name: "valid", msg: NewMsgUpdateNamespaceSigners(owner, namespace, []string{signer})},
+		{name: "no allowed signers", msg: NewMsgUpdateNamespaceSigners(owner, namespace, nil)},
+		{name: "invalid owner", msg: NewMsgUpdateNamespaceSigners("invalid", namespace, nil), wantErr: true},
+		{name: "reserved namespace", msg: NewMsgUpdateNamespaceSigners(owner, share.TxNamespace, nil), wantErr: true},
+		{name: "invalid namespace", msg: &MsgUpdateNamespaceSigners{Owner: owner, Namespace: []byte{1}}, wantErr: true},
+		{name: "invalid allowed signer", msg: NewMsgUpdateNamespaceSigners(owner, namespace, []string{"invalid"}), wantErr: true},
+		{name: "duplicate allowed signer", msg: NewMsgUpdateNamespaceSigners(owner, namespace, []string{signer, signer}), wantErr: true},
+		{name: "too many allowed signers", msg: NewMsgUpdateNamespaceSigners(owner, namespace, tooManySigners), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewNamespaceRecord creates a new NamespaceRecord instance.
+func NewNamespaceRecord(namespace []byte, owner string, bond sdk.Coin, allowedSigners []string) NamespaceRecord {
+	return NamespaceRecord{
+		Namespace:      namespace,
+		Owner:          owner,
+		Bond:           bond,
+		AllowedSigners: allowedSigners,
+	}
+}
+
+// IsAllowedSigner returns true if the signer may pay for blobs in the
+// namespace of the record. The owner is always allowed.
+func (r NamespaceRecord) IsAllowedSigner(signer string) bool {
+	return signer == r.Owner || slices.Contains(r.AllowedSigners, signer)
+}
+
+// Validate performs stateless validity checks on the record.
+func (r NamespaceRecord) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Owner); err != nil {
+		return err
+	}
+	if err := validateClaimableNamespace(r.Namespace); err != nil {
+		return err
+	}
+	if err := validateNamespaceClaimBond(r.Bond); err != nil {
+		return err
+	}
+	return validateAllowedSigners(r.AllowedSigners)
+}
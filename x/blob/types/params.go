@@ -1,10 +1,15 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 
+	"cosmossdk.io/math"
+
 	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
 	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
@@ -18,6 +23,9 @@ var (
 	KeyGovMaxSquareSize          = []byte("GovMaxSquareSize")
 	// DefaultGovMaxSquareSize is the initial value of the gov max square size parameter.
 	DefaultGovMaxSquareSize uint64 = appconsts.DefaultGovMaxSquareSize
+	// DefaultNamespaceClaimBond is the initial value of the namespace claim
+	// bond parameter (100 TIA).
+	DefaultNamespaceClaimBond = sdk.NewCoin(appconsts.BondDenom, math.NewInt(100_000_000))
 )
 
 // ParamKeyTable returns the param key table for the blob module
@@ -30,6 +38,9 @@ func NewParams(gasPerBlobByte uint32, govMaxSquareSize uint64) Params {
 	return Params{
 		GasPerBlobByte:   gasPerBlobByte,
 		GovMaxSquareSize: govMaxSquareSize,
+		// the namespace claim bond is unset before app version 6. Its amount
+		// is zero rather than nil to match the params read from the store.
+		NamespaceClaimBond: sdk.Coin{Amount: math.ZeroInt()},
 	}
 }
 
@@ -38,6 +49,14 @@ func DefaultParams() Params {
 	return NewParams(DefaultGasPerBlobByte, appconsts.DefaultGovMaxSquareSize)
 }
 
+// DefaultParamsV6 returns the default set of parameters of app version 6,
+// which also sets the namespace claim bond.
+func DefaultParamsV6() Params {
+	params := DefaultParams()
+	params.NamespaceClaimBond = DefaultNamespaceClaimBond
+	return params
+}
+
 // ParamSetPairs gets the list of param key-value pairs
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
@@ -52,7 +71,53 @@ func (p Params) Validate() error {
 	if err != nil {
 		return err
 	}
-	return validateGovMaxSquareSize(p.GovMaxSquareSize)
+	err = validateGovMaxSquareSize(p.GovMaxSquareSize)
+	if err != nil {
+		return err
+	}
+	err = validateNamespaceGasOverrides(p.NamespaceGasOverrides)
+	if err != nil {
+		return err
+	}
+	return validateNamespaceClaimBond(p.NamespaceClaimBond)
+}
+
+// ValidateV5 validates the params of app versions before 6. The params that
+// were added in app version 6 must be unset.
+func (p Params) ValidateV5() error {
+	err := validateGasPerBlobByte(p.GasPerBlobByte)
+	if err != nil {
+		return err
+	}
+	err = validateGovMaxSquareSize(p.GovMaxSquareSize)
+	if err != nil {
+		return err
+	}
+	if len(p.NamespaceGasOverrides) > 0 {
+		return fmt.Errorf("namespace gas overrides are not supported before app version %d", appconsts.V6)
+	}
+	if p.NamespaceClaimBond.Denom != "" || !(p.NamespaceClaimBond.Amount.IsNil() || p.NamespaceClaimBond.Amount.IsZero()) {
+		return fmt.Errorf("the namespace claim bond is not supported before app version %d", appconsts.V6)
+	}
+	if p.NamespaceRegistryEnabled {
+		return fmt.Errorf("the namespace registry is not supported before app version %d", appconsts.V6)
+	}
+	return nil
+}
+
+// GasPerBlobByteForNamespace returns the gas charged per blob byte for blobs
+// in the namespace. It is the gas of the override with the longest namespace
+// prefix that matches the namespace or appconsts.GasPerBlobByte if none does.
+func (p Params) GasPerBlobByteForNamespace(namespace []byte) uint32 {
+	gasPerByte := appconsts.GasPerBlobByte
+	matched := 0
+	for _, override := range p.NamespaceGasOverrides {
+		if len(override.NamespacePrefix) > matched && bytes.HasPrefix(namespace, override.NamespacePrefix) {
+			gasPerByte = override.GasPerBlobByte
+			matched = len(override.NamespacePrefix)
+		}
+	}
+	return gasPerByte
 }
 
 // String implements the Stringer interface.
@@ -95,3 +160,34 @@ func validateGovMaxSquareSize(v interface{}) error {
 
 	return nil
 }
+
+// validateNamespaceGasOverrides validates the NamespaceGasOverrides param
+func validateNamespaceGasOverrides(overrides []NamespaceGasOverride) error {
+	seen := make(map[string]struct{}, len(overrides))
+	for _, override := range overrides {
+		if len(override.NamespacePrefix) == 0 || len(override.NamespacePrefix) > share.NamespaceSize {
+			return fmt.Errorf("namespace prefix must be between 1 and %d bytes: got %d", share.NamespaceSize, len(override.NamespacePrefix))
+		}
+		if _, ok := seen[string(override.NamespacePrefix)]; ok {
+			return fmt.Errorf("duplicate namespace prefix %X", override.NamespacePrefix)
+		}
+		seen[string(override.NamespacePrefix)] = struct{}{}
+
+		if err := validateGasPerBlobByte(override.GasPerBlobByte); err != nil {
+			return fmt.Errorf("namespace prefix %X: %w", override.NamespacePrefix, err)
+		}
+	}
+	return nil
+}
+
+// validateNamespaceClaimBond validates the NamespaceClaimBond param. The bond
+// must be a positive amount of a valid denom.
+func validateNamespaceClaimBond(bond sdk.Coin) error {
+	if bond.Amount.IsNil() || !bond.Amount.IsPositive() {
+		return fmt.Errorf("namespace claim bond must be positive: %s", bond)
+	}
+	if err := bond.Validate(); err != nil {
+		return fmt.Errorf("invalid namespace claim bond: %w", err)
+	}
+	return nil
+}
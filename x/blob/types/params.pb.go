@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -27,6 +28,17 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	GasPerBlobByte   uint32 `protobuf:"varint,1,opt,name=gas_per_blob_byte,json=gasPerBlobByte,proto3" json:"gas_per_blob_byte,omitempty" yaml:"gas_per_blob_byte"`
 	GovMaxSquareSize uint64 `protobuf:"varint,2,opt,name=gov_max_square_size,json=govMaxSquareSize,proto3" json:"gov_max_square_size,omitempty" yaml:"gov_max_square_size"`
+	// namespace_gas_overrides override the gas charged per blob byte for blobs
+	// whose namespace starts with one of the prefixes. If more than one prefix
+	// matches a namespace, the longest one applies.
+	NamespaceGasOverrides []NamespaceGasOverride `protobuf:"bytes,3,rep,name=namespace_gas_overrides,json=namespaceGasOverrides,proto3" json:"namespace_gas_overrides" yaml:"namespace_gas_overrides"`
+	// namespace_claim_bond is the amount escrowed in the blob module account to
+	// claim a namespace. It is returned when the namespace is released.
+	NamespaceClaimBond types.Coin `protobuf:"bytes,4,opt,name=namespace_claim_bond,json=namespaceClaimBond,proto3" json:"namespace_claim_bond" yaml:"namespace_claim_bond"`
+	// namespace_registry_enabled enables claiming namespaces and restricts the
+	// signers that may pay for blobs in claimed namespaces. It is disabled by
+	// default.
+	NamespaceRegistryEnabled bool `protobuf:"varint,5,opt,name=namespace_registry_enabled,json=namespaceRegistryEnabled,proto3" json:"namespace_registry_enabled,omitempty" yaml:"namespace_registry_enabled"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -75,31 +87,124 @@ func (m *Params) GetGovMaxSquareSize() uint64 {
 	return 0
 }
 
+func (m *Params) GetNamespaceGasOverrides() []NamespaceGasOverride {
+	if m != nil {
+		return m.NamespaceGasOverrides
+	}
+	return nil
+}
+
+func (m *Params) GetNamespaceClaimBond() types.Coin {
+	if m != nil {
+		return m.NamespaceClaimBond
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetNamespaceRegistryEnabled() bool {
+	if m != nil {
+		return m.NamespaceRegistryEnabled
+	}
+	return false
+}
+
+// NamespaceGasOverride defines the gas charged per blob byte for blobs whose
+// namespace starts with the namespace prefix.
+type NamespaceGasOverride struct {
+	// namespace_prefix is a prefix of the full namespace (version and id). A
+	// prefix of the full namespace length overrides a single namespace.
+	NamespacePrefix []byte `protobuf:"bytes,1,opt,name=namespace_prefix,json=namespacePrefix,proto3" json:"namespace_prefix,omitempty" yaml:"namespace_prefix"`
+	GasPerBlobByte  uint32 `protobuf:"varint,2,opt,name=gas_per_blob_byte,json=gasPerBlobByte,proto3" json:"gas_per_blob_byte,omitempty" yaml:"gas_per_blob_byte"`
+}
+
+func (m *NamespaceGasOverride) Reset()         { *m = NamespaceGasOverride{} }
+func (m *NamespaceGasOverride) String() string { return proto.CompactTextString(m) }
+func (*NamespaceGasOverride) ProtoMessage()    {}
+func (*NamespaceGasOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2145b82d3e5371c6, []int{1}
+}
+func (m *NamespaceGasOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceGasOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceGasOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceGasOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceGasOverride.Merge(m, src)
+}
+func (m *NamespaceGasOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceGasOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceGasOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceGasOverride proto.InternalMessageInfo
+
+func (m *NamespaceGasOverride) GetNamespacePrefix() []byte {
+	if m != nil {
+		return m.NamespacePrefix
+	}
+	return nil
+}
+
+func (m *NamespaceGasOverride) GetGasPerBlobByte() uint32 {
+	if m != nil {
+		return m.GasPerBlobByte
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "celestia.blob.v1.Params")
+	proto.RegisterType((*NamespaceGasOverride)(nil), "celestia.blob.v1.NamespaceGasOverride")
 }
 
 func init() { proto.RegisterFile("celestia/blob/v1/params.proto", fileDescriptor_2145b82d3e5371c6) }
 
 var fileDescriptor_2145b82d3e5371c6 = []byte{
-	// 270 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x4f, 0xca, 0xc9, 0x4f, 0xd2, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c,
-	0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0x49, 0xeb, 0x81, 0xa4,
-	0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d,
-	0xd2, 0x32, 0x46, 0x2e, 0xb6, 0x00, 0xb0, 0x46, 0x21, 0x77, 0x2e, 0xc1, 0xf4, 0xc4, 0xe2, 0xf8,
-	0x82, 0xd4, 0xa2, 0x78, 0x90, 0x9e, 0xf8, 0xa4, 0xca, 0x92, 0x54, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x5e, 0x27, 0x99, 0x4f, 0xf7, 0xe4, 0x25, 0x2a, 0x13, 0x73, 0x73, 0xac, 0x94, 0x30, 0x94, 0x28,
-	0x05, 0xf1, 0xa5, 0x27, 0x16, 0x07, 0xa4, 0x16, 0x39, 0xe5, 0xe4, 0x27, 0x39, 0x55, 0x96, 0xa4,
-	0x0a, 0xf9, 0x72, 0x09, 0xa7, 0xe7, 0x97, 0xc5, 0xe7, 0x26, 0x56, 0xc4, 0x17, 0x17, 0x96, 0x26,
-	0x16, 0xa5, 0xc6, 0x17, 0x67, 0x56, 0xa5, 0x4a, 0x30, 0x29, 0x30, 0x6a, 0xb0, 0x38, 0xc9, 0x7d,
-	0xba, 0x27, 0x2f, 0x05, 0x35, 0x0a, 0x53, 0x91, 0x52, 0x90, 0x40, 0x7a, 0x7e, 0x99, 0x6f, 0x62,
-	0x45, 0x30, 0x58, 0x2c, 0x38, 0xb3, 0x2a, 0xd5, 0x8a, 0x65, 0xc6, 0x02, 0x79, 0x06, 0x27, 0xaf,
-	0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39,
-	0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x48, 0xcf, 0x2c, 0xc9, 0x28,
-	0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0xf9, 0x3a, 0xbf, 0x28, 0x1d, 0xce, 0xd6, 0x4d, 0x2c,
-	0x28, 0xd0, 0xaf, 0x80, 0x04, 0x53, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0xef, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x2d, 0x7d, 0x23, 0x44, 0x01, 0x00, 0x00,
+	// 497 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x18, 0xcc, 0xb6, 0xa1, 0x42, 0x2e, 0x3f, 0xc5, 0x04, 0xd5, 0xa4, 0x60, 0x07, 0x23, 0xaa, 0x5c,
+	0x58, 0x93, 0x72, 0xeb, 0x71, 0x2b, 0xa8, 0x84, 0x54, 0x88, 0xdc, 0x1b, 0x17, 0x6b, 0xd7, 0xf9,
+	0x30, 0x2b, 0xd9, 0x5e, 0xb3, 0xeb, 0x5a, 0x49, 0x1f, 0xa0, 0x67, 0x8e, 0x1c, 0xb9, 0xf1, 0x2a,
+	0x3d, 0xf6, 0xc8, 0xc9, 0x42, 0xc9, 0x1b, 0xf8, 0x09, 0x90, 0x7f, 0x92, 0x48, 0x6e, 0x38, 0x70,
+	0x5b, 0xcd, 0xcc, 0x37, 0x23, 0x8d, 0x66, 0xb5, 0xe7, 0x3e, 0x84, 0xa0, 0x52, 0x4e, 0x1d, 0x16,
+	0x0a, 0xe6, 0x64, 0x23, 0x27, 0xa1, 0x92, 0x46, 0x0a, 0x27, 0x52, 0xa4, 0x42, 0xdf, 0x5b, 0xd2,
+	0xb8, 0xa4, 0x71, 0x36, 0xea, 0xf7, 0x02, 0x11, 0x88, 0x8a, 0x74, 0xca, 0x57, 0xad, 0xeb, 0x9b,
+	0xbe, 0x50, 0x91, 0x50, 0x0e, 0xa3, 0x0a, 0x9c, 0x6c, 0xc4, 0x20, 0xa5, 0x23, 0xc7, 0x17, 0x3c,
+	0xae, 0x79, 0xfb, 0xaa, 0xab, 0xed, 0x8c, 0x2b, 0x63, 0xfd, 0x54, 0x7b, 0x14, 0x50, 0xe5, 0x25,
+	0x20, 0xbd, 0xd2, 0xd3, 0x63, 0xb3, 0x14, 0x0c, 0x34, 0x40, 0xc3, 0xfb, 0xe4, 0x59, 0x91, 0x5b,
+	0xc6, 0x8c, 0x46, 0xe1, 0xb1, 0x7d, 0x4b, 0x62, 0xbb, 0x0f, 0x02, 0xaa, 0xc6, 0x20, 0x49, 0x28,
+	0x18, 0x99, 0xa5, 0xa0, 0x9f, 0x69, 0x8f, 0x03, 0x91, 0x79, 0x11, 0x9d, 0x7a, 0xea, 0xdb, 0x05,
+	0x95, 0xe0, 0x29, 0x7e, 0x09, 0xc6, 0xd6, 0x00, 0x0d, 0xbb, 0xc4, 0x2c, 0x72, 0xab, 0xdf, 0x58,
+	0xdd, 0x16, 0xd9, 0xee, 0x5e, 0x20, 0xb2, 0x33, 0x3a, 0x3d, 0xaf, 0xb0, 0x73, 0x7e, 0x09, 0xfa,
+	0x15, 0xd2, 0xf6, 0x63, 0x1a, 0x81, 0x4a, 0xa8, 0x0f, 0x5e, 0x99, 0x2f, 0x32, 0x90, 0x92, 0x4f,
+	0x40, 0x19, 0xdb, 0x83, 0xed, 0xe1, 0xee, 0xd1, 0x21, 0x6e, 0xb7, 0x81, 0x3f, 0x2e, 0x0f, 0x4e,
+	0xa9, 0xfa, 0xd4, 0xc8, 0xc9, 0xe1, 0x75, 0x6e, 0x75, 0x8a, 0xdc, 0x32, 0xeb, 0xfc, 0x7f, 0x98,
+	0xda, 0xee, 0x93, 0x78, 0xc3, 0xb5, 0xd2, 0x13, 0xad, 0xb7, 0x3e, 0xf1, 0x43, 0xca, 0x23, 0x8f,
+	0x89, 0x78, 0x62, 0x74, 0x07, 0x68, 0xb8, 0x7b, 0xf4, 0x14, 0xd7, 0x55, 0xe3, 0xb2, 0x6a, 0xdc,
+	0x54, 0x8d, 0x4f, 0x04, 0x8f, 0xc9, 0xcb, 0x26, 0xf7, 0xa0, 0x9d, 0xbb, 0x36, 0xb1, 0x5d, 0x7d,
+	0x05, 0x9f, 0x94, 0x28, 0x11, 0xf1, 0x44, 0xf7, 0xb5, 0xfe, 0x5a, 0x2c, 0x21, 0xe0, 0x2a, 0x95,
+	0x33, 0x0f, 0x62, 0xca, 0x42, 0x98, 0x18, 0x77, 0x06, 0x68, 0x78, 0x97, 0xbc, 0x2a, 0x72, 0xeb,
+	0x45, 0xdb, 0xb8, 0xad, 0xb5, 0x5d, 0x63, 0x45, 0xba, 0x0d, 0xf7, 0xae, 0xa6, 0x8e, 0xbb, 0x3f,
+	0x7e, 0x5a, 0x1d, 0xfb, 0x17, 0xd2, 0x7a, 0x9b, 0x4a, 0xd3, 0xdf, 0x6b, 0x7b, 0x6b, 0xdf, 0x44,
+	0xc2, 0x17, 0x3e, 0xad, 0x56, 0x71, 0x8f, 0x1c, 0x14, 0xb9, 0xb5, 0xdf, 0x4e, 0xae, 0x15, 0xb6,
+	0xfb, 0x70, 0x05, 0x8d, 0x2b, 0x64, 0xf3, 0xbc, 0xb6, 0xfe, 0x7f, 0x5e, 0xe4, 0xc3, 0xf5, 0xdc,
+	0x44, 0x37, 0x73, 0x13, 0xfd, 0x99, 0x9b, 0xe8, 0xfb, 0xc2, 0xec, 0xdc, 0x2c, 0xcc, 0xce, 0xef,
+	0x85, 0xd9, 0xf9, 0xfc, 0x26, 0xe0, 0xe9, 0xd7, 0x0b, 0x86, 0x7d, 0x11, 0x39, 0xcb, 0x45, 0x08,
+	0x19, 0xac, 0xde, 0xaf, 0x69, 0x92, 0x38, 0xd3, 0xfa, 0x43, 0xa5, 0xb3, 0x04, 0x14, 0xdb, 0xa9,
+	0x7e, 0xc1, 0xdb, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7e, 0x8c, 0x20, 0x34, 0x6e, 0x03, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -122,6 +227,40 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NamespaceRegistryEnabled {
+		i--
+		if m.NamespaceRegistryEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.NamespaceClaimBond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.NamespaceGasOverrides) > 0 {
+		for iNdEx := len(m.NamespaceGasOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NamespaceGasOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.GovMaxSquareSize != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.GovMaxSquareSize))
 		i--
@@ -135,6 +274,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NamespaceGasOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceGasOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceGasOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasPerBlobByte != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GasPerBlobByte))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NamespacePrefix) > 0 {
+		i -= len(m.NamespacePrefix)
+		copy(dAtA[i:], m.NamespacePrefix)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.NamespacePrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -158,6 +332,33 @@ func (m *Params) Size() (n int) {
 	if m.GovMaxSquareSize != 0 {
 		n += 1 + sovParams(uint64(m.GovMaxSquareSize))
 	}
+	if len(m.NamespaceGasOverrides) > 0 {
+		for _, e := range m.NamespaceGasOverrides {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.NamespaceClaimBond.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.NamespaceRegistryEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *NamespaceGasOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespacePrefix)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.GasPerBlobByte != 0 {
+		n += 1 + sovParams(uint64(m.GasPerBlobByte))
+	}
 	return n
 }
 
@@ -234,6 +435,196 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceGasOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceGasOverrides = append(m.NamespaceGasOverrides, NamespaceGasOverride{})
+			if err := m.NamespaceGasOverrides[len(m.NamespaceGasOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceClaimBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NamespaceClaimBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceRegistryEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NamespaceRegistryEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceGasOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceGasOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceGasOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespacePrefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespacePrefix = append(m.NamespacePrefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.NamespacePrefix == nil {
+				m.NamespacePrefix = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerBlobByte", wireType)
+			}
+			m.GasPerBlobByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerBlobByte |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1,9 +1,13 @@
 package types
 
 import (
+	"bytes"
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -42,3 +46,110 @@ func Test_validateGovMaxSquareSize(t *testing.T) {
 		}
 	}
 }
+
+func Test_validateNamespaceGasOverrides(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides []NamespaceGasOverride
+		expectErr bool
+	}{
+		{
+			name:      "no overrides",
+			overrides: nil,
+		},
+		{
+			name: "valid",
+			overrides: []NamespaceGasOverride{
+				{NamespacePrefix: []byte{0}, GasPerBlobByte: 4},
+				{NamespacePrefix: bytes.Repeat([]byte{1}, share.NamespaceSize), GasPerBlobByte: 16},
+			},
+		},
+		{
+			name:      "empty prefix",
+			overrides: []NamespaceGasOverride{{NamespacePrefix: []byte{}, GasPerBlobByte: 4}},
+			expectErr: true,
+		},
+		{
+			name:      "prefix longer than a namespace",
+			overrides: []NamespaceGasOverride{{NamespacePrefix: bytes.Repeat([]byte{1}, share.NamespaceSize+1), GasPerBlobByte: 4}},
+			expectErr: true,
+		},
+		{
+			name: "duplicate prefix",
+			overrides: []NamespaceGasOverride{
+				{NamespacePrefix: []byte{0, 1}, GasPerBlobByte: 4},
+				{NamespacePrefix: []byte{0, 1}, GasPerBlobByte: 6},
+			},
+			expectErr: true,
+		},
+		{
+			name:      "zero gas per blob byte",
+			overrides: []NamespaceGasOverride{{NamespacePrefix: []byte{0}, GasPerBlobByte: 0}},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateNamespaceGasOverrides(tt.overrides)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGasPerBlobByteForNamespace(t *testing.T) {
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize)).Bytes()
+	other := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize)).Bytes()
+
+	params := DefaultParams()
+	assert.Equal(t, appconsts.GasPerBlobByte, params.GasPerBlobByteForNamespace(ns))
+
+	params.NamespaceGasOverrides = []NamespaceGasOverride{
+		{NamespacePrefix: ns, GasPerBlobByte: 2},
+		{NamespacePrefix: ns[:share.NamespaceSize-2], GasPerBlobByte: 4},
+		{NamespacePrefix: []byte{share.NamespaceVersionZero}, GasPerBlobByte: 16},
+	}
+	// the longest matching prefix applies
+	assert.Equal(t, uint32(2), params.GasPerBlobByteForNamespace(ns))
+	assert.Equal(t, uint32(16), params.GasPerBlobByteForNamespace(other))
+	assert.Equal(t, appconsts.GasPerBlobByte, params.GasPerBlobByteForNamespace(share.ParitySharesNamespace.Bytes()))
+
+	sizes := []uint32{100, 1000}
+	assert.Equal(t,
+		GasToConsume(sizes[:1], 2)+GasToConsume(sizes[1:], 16),
+		GasToConsumeForNamespaces([][]byte{ns, other}, sizes, params),
+	)
+	assert.Equal(t,
+		GasToConsume(sizes, appconsts.GasPerBlobByte),
+		GasToConsumeForNamespaces([][]byte{ns, other}, sizes, DefaultParams()),
+	)
+}
+
+func Test_validateNamespaceClaimBond(t *testing.T) {
+	tests := []struct {
+		name      string
+		bond      sdk.Coin
+		expectErr bool
+	}{
+		{name: "default", bond: DefaultNamespaceClaimBond},
+		{name: "unset", bond: sdk.Coin{}, expectErr: true},
+		{name: "unset after a round trip", bond: sdk.Coin{Amount: math.ZeroInt()}, expectErr: true},
+		{name: "zero", bond: sdk.NewCoin(appconsts.BondDenom, math.ZeroInt()), expectErr: true},
+		{name: "negative", bond: sdk.Coin{Denom: appconsts.BondDenom, Amount: math.NewInt(-1)}, expectErr: true},
+		{name: "empty denom", bond: sdk.Coin{Amount: math.NewInt(1)}, expectErr: true},
+		{name: "invalid denom", bond: sdk.Coin{Denom: "1", Amount: math.NewInt(1)}, expectErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateNamespaceClaimBond(tt.bond)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
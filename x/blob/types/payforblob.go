@@ -139,6 +139,12 @@ func (msg *MsgPayForBlobs) Gas(gasPerByte uint32) uint64 {
 	return GasToConsume(msg.BlobSizes, gasPerByte)
 }
 
+// GasWithParams returns the gas charged to pay for the blobs in the PFB,
+// taking the namespace gas overrides of the params into account.
+func (msg *MsgPayForBlobs) GasWithParams(params Params) uint64 {
+	return GasToConsumeForNamespaces(msg.Namespaces, msg.BlobSizes, params)
+}
+
 // GasToConsume works out the extra gas charged to pay for a set of blobs in a PFB.
 // Note that transactions will incur other gas costs, such as the signature verification
 // and reads to the user's account.
@@ -151,6 +157,23 @@ func GasToConsume(blobSizes []uint32, gasPerByte uint32) uint64 {
 	return totalSharesUsed * share.ShareSize * uint64(gasPerByte)
 }
 
+// GasToConsumeForNamespaces is like GasToConsume but charges each blob the gas
+// per blob byte of its namespace, as returned by
+// Params.GasPerBlobByteForNamespace. namespaces and blobSizes are expected to
+// have the same length.
+func GasToConsumeForNamespaces(namespaces [][]byte, blobSizes []uint32, params Params) uint64 {
+	var gas uint64
+	for i, size := range blobSizes {
+		var namespace []byte
+		if i < len(namespaces) {
+			namespace = namespaces[i]
+		}
+		gasPerByte := params.GasPerBlobByteForNamespace(namespace)
+		gas += uint64(share.SparseSharesNeeded(size)) * share.ShareSize * uint64(gasPerByte)
+	}
+	return gas
+}
+
 // EstimateGas estimates the total gas required to pay for a set of blobs in a PFB.
 // It is based on a linear model that is dependent on the governance parameters:
 // gasPerByte and txSizeCost. It assumes other variables are constant. This includes
@@ -159,9 +182,24 @@ func EstimateGas(blobSizes []uint32, gasPerByte uint32, txSizeCost uint64) uint6
 	return GasToConsume(blobSizes, gasPerByte) + (txSizeCost * BytesPerBlobInfo * uint64(len(blobSizes))) + PFBGasFixedCost
 }
 
+// EstimateGasForNamespaces is like EstimateGas but charges each blob the gas
+// per blob byte of its namespace as defined by the params.
+func EstimateGasForNamespaces(namespaces [][]byte, blobSizes []uint32, params Params, txSizeCost uint64) uint64 {
+	return GasToConsumeForNamespaces(namespaces, blobSizes, params) + (txSizeCost * BytesPerBlobInfo * uint64(len(blobSizes))) + PFBGasFixedCost
+}
+
 // DefaultEstimateGas runs EstimateGas with the system defaults.
 func DefaultEstimateGas(blobSizes []uint32) uint64 {
-	return EstimateGas(blobSizes, appconsts.GasPerBlobByte, appconsts.TxSizeCostPerByte)
+	return DefaultEstimateGasForNamespaces(nil, blobSizes, DefaultParams())
+}
+
+// DefaultEstimateGasForNamespaces runs EstimateGasForNamespaces with the system
+// default tx size cost. Each blob is charged the gas per blob byte of its
+// namespace as defined by the namespace gas overrides of the params. The
+// namespaces may be nil, in which case every blob is charged
+// appconsts.GasPerBlobByte.
+func DefaultEstimateGasForNamespaces(namespaces [][]byte, blobSizes []uint32, params Params) uint64 {
+	return EstimateGasForNamespaces(namespaces, blobSizes, params, appconsts.TxSizeCostPerByte)
 }
 
 // ValidateBlobNamespace returns an error if the provided namespace is an
@@ -111,33 +111,134 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryNamespaceRecordRequest is the request type for the Query/NamespaceRecord
+// RPC method.
+type QueryNamespaceRecordRequest struct {
+	// namespace is the full namespace (version and id).
+	Namespace []byte `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *QueryNamespaceRecordRequest) Reset()         { *m = QueryNamespaceRecordRequest{} }
+func (m *QueryNamespaceRecordRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNamespaceRecordRequest) ProtoMessage()    {}
+func (*QueryNamespaceRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{2}
+}
+func (m *QueryNamespaceRecordRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamespaceRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamespaceRecordRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNamespaceRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamespaceRecordRequest.Merge(m, src)
+}
+func (m *QueryNamespaceRecordRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamespaceRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamespaceRecordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNamespaceRecordRequest proto.InternalMessageInfo
+
+func (m *QueryNamespaceRecordRequest) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+// QueryNamespaceRecordResponse is the response type for the
+// Query/NamespaceRecord RPC method.
+type QueryNamespaceRecordResponse struct {
+	Record NamespaceRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
+}
+
+func (m *QueryNamespaceRecordResponse) Reset()         { *m = QueryNamespaceRecordResponse{} }
+func (m *QueryNamespaceRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNamespaceRecordResponse) ProtoMessage()    {}
+func (*QueryNamespaceRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{3}
+}
+func (m *QueryNamespaceRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamespaceRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamespaceRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNamespaceRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamespaceRecordResponse.Merge(m, src)
+}
+func (m *QueryNamespaceRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamespaceRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamespaceRecordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNamespaceRecordResponse proto.InternalMessageInfo
+
+func (m *QueryNamespaceRecordResponse) GetRecord() NamespaceRecord {
+	if m != nil {
+		return m.Record
+	}
+	return NamespaceRecord{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "celestia.blob.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.blob.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryNamespaceRecordRequest)(nil), "celestia.blob.v1.QueryNamespaceRecordRequest")
+	proto.RegisterType((*QueryNamespaceRecordResponse)(nil), "celestia.blob.v1.QueryNamespaceRecordResponse")
 }
 
 func init() { proto.RegisterFile("celestia/blob/v1/query.proto", fileDescriptor_29ba8a4248383b64) }
 
 var fileDescriptor_29ba8a4248383b64 = []byte{
-	// 275 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x4f, 0xca, 0xc9, 0x4f, 0xd2, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d,
-	0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc9, 0xea, 0x81, 0x64, 0xf5,
-	0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0x94,
-	0x4c, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x7e, 0x62, 0x41, 0xa6, 0x7e, 0x62, 0x5e, 0x5e, 0x7e,
-	0x49, 0x62, 0x49, 0x66, 0x7e, 0x5e, 0x31, 0x54, 0x56, 0x16, 0xc3, 0x8e, 0x82, 0xc4, 0xa2, 0xc4,
-	0x5c, 0xa8, 0xb4, 0x92, 0x08, 0x97, 0x50, 0x20, 0xc8, 0xce, 0x00, 0xb0, 0x60, 0x50, 0x6a, 0x61,
-	0x69, 0x6a, 0x71, 0x89, 0x92, 0x2f, 0x97, 0x30, 0x8a, 0x68, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa,
-	0x90, 0x19, 0x17, 0x1b, 0x44, 0xb3, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x84, 0x1e, 0xba,
-	0x13, 0xf5, 0x20, 0x3a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xaa, 0x36, 0x2a, 0xe7,
-	0x62, 0x05, 0x1b, 0x27, 0x94, 0xc7, 0xc5, 0x06, 0x51, 0x20, 0xa4, 0x82, 0xa9, 0x15, 0xd3, 0x1d,
-	0x52, 0xaa, 0x04, 0x54, 0x41, 0xdc, 0xa5, 0x24, 0xde, 0x74, 0xf9, 0xc9, 0x64, 0x26, 0x41, 0x21,
-	0x7e, 0x34, 0x3f, 0x3a, 0x79, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47,
-	0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94,
-	0x41, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xcc, 0x8e, 0xfc, 0xa2,
-	0x74, 0x38, 0x5b, 0x37, 0xb1, 0xa0, 0x40, 0xbf, 0x02, 0x62, 0x5e, 0x49, 0x65, 0x41, 0x6a, 0x71,
-	0x12, 0x1b, 0x38, 0xc0, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb0, 0x7e, 0x20, 0x5f, 0xb5,
-	0x01, 0x00, 0x00,
+	// 378 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x4b, 0xe3, 0x40,
+	0x1c, 0xc5, 0x93, 0xb2, 0x1b, 0xd8, 0xd9, 0x85, 0xae, 0x63, 0xc1, 0x9a, 0xc6, 0xd8, 0x06, 0x05,
+	0x2f, 0xcd, 0xd8, 0x0a, 0x5e, 0x3c, 0x08, 0x3d, 0x0a, 0x8a, 0xe6, 0xe8, 0xa5, 0x4c, 0xe2, 0x10,
+	0x03, 0x6d, 0x66, 0x9a, 0x99, 0x16, 0x7b, 0xf5, 0x2e, 0x08, 0xfd, 0x52, 0x3d, 0x16, 0xbc, 0x78,
+	0x12, 0x69, 0xfd, 0x20, 0xd2, 0x99, 0xa4, 0x62, 0x52, 0xd1, 0xdb, 0x30, 0xef, 0xff, 0xde, 0xfb,
+	0xe5, 0x9f, 0x01, 0x56, 0x40, 0x7a, 0x84, 0x8b, 0x08, 0x23, 0xbf, 0x47, 0x7d, 0x34, 0x6a, 0xa1,
+	0xc1, 0x90, 0x24, 0x63, 0x97, 0x25, 0x54, 0x50, 0xf8, 0x3f, 0x53, 0xdd, 0xa5, 0xea, 0x8e, 0x5a,
+	0x66, 0x25, 0xa4, 0x21, 0x95, 0x22, 0x5a, 0x9e, 0xd4, 0x9c, 0x69, 0x85, 0x94, 0x86, 0x3d, 0x82,
+	0x30, 0x8b, 0x10, 0x8e, 0x63, 0x2a, 0xb0, 0x88, 0x68, 0xcc, 0x53, 0x75, 0xa7, 0xd0, 0xc1, 0x70,
+	0x82, 0xfb, 0x99, 0x5c, 0x2f, 0xc8, 0x31, 0xee, 0x13, 0xce, 0x70, 0x40, 0xd4, 0x84, 0x53, 0x01,
+	0xf0, 0x6a, 0x49, 0x75, 0x29, 0x6d, 0x1e, 0x19, 0x0c, 0x09, 0x17, 0xce, 0x39, 0xd8, 0xfc, 0x74,
+	0xcb, 0x19, 0x8d, 0x39, 0x81, 0xc7, 0xc0, 0x50, 0xf1, 0x55, 0xbd, 0xae, 0x1f, 0xfc, 0x6d, 0x57,
+	0xdd, 0xfc, 0x47, 0xb8, 0xca, 0xd1, 0xf9, 0x35, 0x7d, 0xd9, 0xd5, 0xbc, 0x74, 0xda, 0x39, 0x01,
+	0x35, 0x19, 0x77, 0x91, 0x95, 0x7b, 0x24, 0xa0, 0xc9, 0x4d, 0xda, 0x06, 0x2d, 0xf0, 0x67, 0x85,
+	0x25, 0x93, 0xff, 0x79, 0x1f, 0x17, 0x4e, 0x17, 0x58, 0xeb, 0xcd, 0x29, 0xd4, 0x29, 0x30, 0x12,
+	0x79, 0x93, 0x42, 0x35, 0x8a, 0x50, 0x39, 0x6b, 0x46, 0xa7, 0x6c, 0xed, 0x87, 0x12, 0xf8, 0x2d,
+	0x1b, 0x60, 0x0c, 0x0c, 0xc5, 0x0f, 0xf7, 0x8a, 0x21, 0xc5, 0x35, 0x99, 0xfb, 0xdf, 0x4c, 0x29,
+	0x42, 0x67, 0xeb, 0xfe, 0xe9, 0x6d, 0x52, 0xda, 0x80, 0xe5, 0xdc, 0x4f, 0x82, 0x13, 0x1d, 0x94,
+	0x73, 0x6c, 0xb0, 0xf9, 0x45, 0xe6, 0xfa, 0xdd, 0x99, 0xee, 0x4f, 0xc7, 0x53, 0x96, 0x86, 0x64,
+	0xa9, 0xc1, 0xed, 0xe2, 0x8b, 0xe8, 0xaa, 0x7d, 0x74, 0xce, 0xa6, 0x73, 0x5b, 0x9f, 0xcd, 0x6d,
+	0xfd, 0x75, 0x6e, 0xeb, 0x8f, 0x0b, 0x5b, 0x9b, 0x2d, 0x6c, 0xed, 0x79, 0x61, 0x6b, 0xd7, 0x87,
+	0x61, 0x24, 0x6e, 0x87, 0xbe, 0x1b, 0xd0, 0x3e, 0xca, 0x6a, 0x69, 0x12, 0xae, 0xce, 0x4d, 0xcc,
+	0x18, 0xba, 0x53, 0xc9, 0x62, 0xcc, 0x08, 0xf7, 0x0d, 0xf9, 0xca, 0x8e, 0xde, 0x03, 0x00, 0x00,
+	0xff, 0xff, 0xc4, 0x49, 0x1a, 0xda, 0x0c, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -154,6 +255,8 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// NamespaceRecord queries the registration of a claimed namespace.
+	NamespaceRecord(ctx context.Context, in *QueryNamespaceRecordRequest, opts ...grpc.CallOption) (*QueryNamespaceRecordResponse, error)
 }
 
 type queryClient struct {
@@ -173,10 +276,21 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) NamespaceRecord(ctx context.Context, in *QueryNamespaceRecordRequest, opts ...grpc.CallOption) (*QueryNamespaceRecordResponse, error) {
+	out := new(QueryNamespaceRecordResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Query/NamespaceRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// NamespaceRecord queries the registration of a claimed namespace.
+	NamespaceRecord(context.Context, *QueryNamespaceRecordRequest) (*QueryNamespaceRecordResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -186,6 +300,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) NamespaceRecord(ctx context.Context, req *QueryNamespaceRecordRequest) (*QueryNamespaceRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamespaceRecord not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -209,6 +326,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_NamespaceRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNamespaceRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NamespaceRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Query/NamespaceRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NamespaceRecord(ctx, req.(*QueryNamespaceRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.blob.v1.Query",
@@ -218,6 +353,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "NamespaceRecord",
+			Handler:    _Query_NamespaceRecord_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/blob/v1/query.proto",
@@ -279,6 +418,69 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryNamespaceRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamespaceRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamespaceRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNamespaceRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamespaceRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamespaceRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -310,6 +512,30 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryNamespaceRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNamespaceRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -449,6 +675,173 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryNamespaceRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamespaceRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamespaceRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNamespaceRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamespaceRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamespaceRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
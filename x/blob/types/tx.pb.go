@@ -251,50 +251,452 @@ func (m *MsgUpdateBlobParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateBlobParamsResponse proto.InternalMessageInfo
 
+// MsgClaimNamespace claims a namespace for the owner.
+type MsgClaimNamespace struct {
+	// owner is the bech32 encoded address of the account claiming the namespace.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// namespace is the full namespace (version and id) to claim.
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *MsgClaimNamespace) Reset()         { *m = MsgClaimNamespace{} }
+func (m *MsgClaimNamespace) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimNamespace) ProtoMessage()    {}
+func (*MsgClaimNamespace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{4}
+}
+func (m *MsgClaimNamespace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimNamespace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimNamespace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimNamespace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimNamespace.Merge(m, src)
+}
+func (m *MsgClaimNamespace) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimNamespace) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimNamespace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimNamespace proto.InternalMessageInfo
+
+func (m *MsgClaimNamespace) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgClaimNamespace) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+// MsgClaimNamespaceResponse defines the MsgClaimNamespace response type.
+type MsgClaimNamespaceResponse struct {
+}
+
+func (m *MsgClaimNamespaceResponse) Reset()         { *m = MsgClaimNamespaceResponse{} }
+func (m *MsgClaimNamespaceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimNamespaceResponse) ProtoMessage()    {}
+func (*MsgClaimNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{5}
+}
+func (m *MsgClaimNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimNamespaceResponse.Merge(m, src)
+}
+func (m *MsgClaimNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimNamespaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimNamespaceResponse proto.InternalMessageInfo
+
+// MsgUpdateNamespaceSigners replaces the allowed signers of a claimed
+// namespace.
+type MsgUpdateNamespaceSigners struct {
+	// owner is the bech32 encoded address of the owner of the namespace.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// namespace is the full namespace (version and id).
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// allowed_signers are the bech32 encoded addresses, in addition to the
+	// owner, that may pay for blobs in the namespace.
+	AllowedSigners []string `protobuf:"bytes,3,rep,name=allowed_signers,json=allowedSigners,proto3" json:"allowed_signers,omitempty"`
+}
+
+func (m *MsgUpdateNamespaceSigners) Reset()         { *m = MsgUpdateNamespaceSigners{} }
+func (m *MsgUpdateNamespaceSigners) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateNamespaceSigners) ProtoMessage()    {}
+func (*MsgUpdateNamespaceSigners) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{6}
+}
+func (m *MsgUpdateNamespaceSigners) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateNamespaceSigners) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateNamespaceSigners.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateNamespaceSigners) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateNamespaceSigners.Merge(m, src)
+}
+func (m *MsgUpdateNamespaceSigners) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateNamespaceSigners) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateNamespaceSigners.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateNamespaceSigners proto.InternalMessageInfo
+
+func (m *MsgUpdateNamespaceSigners) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgUpdateNamespaceSigners) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *MsgUpdateNamespaceSigners) GetAllowedSigners() []string {
+	if m != nil {
+		return m.AllowedSigners
+	}
+	return nil
+}
+
+// MsgUpdateNamespaceSignersResponse defines the MsgUpdateNamespaceSigners
+// response type.
+type MsgUpdateNamespaceSignersResponse struct {
+}
+
+func (m *MsgUpdateNamespaceSignersResponse) Reset()         { *m = MsgUpdateNamespaceSignersResponse{} }
+func (m *MsgUpdateNamespaceSignersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateNamespaceSignersResponse) ProtoMessage()    {}
+func (*MsgUpdateNamespaceSignersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{7}
+}
+func (m *MsgUpdateNamespaceSignersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateNamespaceSignersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateNamespaceSignersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateNamespaceSignersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateNamespaceSignersResponse.Merge(m, src)
+}
+func (m *MsgUpdateNamespaceSignersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateNamespaceSignersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateNamespaceSignersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateNamespaceSignersResponse proto.InternalMessageInfo
+
+// MsgReleaseNamespace releases a claimed namespace.
+type MsgReleaseNamespace struct {
+	// owner is the bech32 encoded address of the owner of the namespace.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// namespace is the full namespace (version and id).
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *MsgReleaseNamespace) Reset()         { *m = MsgReleaseNamespace{} }
+func (m *MsgReleaseNamespace) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseNamespace) ProtoMessage()    {}
+func (*MsgReleaseNamespace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{8}
+}
+func (m *MsgReleaseNamespace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseNamespace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseNamespace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReleaseNamespace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseNamespace.Merge(m, src)
+}
+func (m *MsgReleaseNamespace) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseNamespace) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseNamespace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReleaseNamespace proto.InternalMessageInfo
+
+func (m *MsgReleaseNamespace) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgReleaseNamespace) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+// MsgReleaseNamespaceResponse defines the MsgReleaseNamespace response type.
+type MsgReleaseNamespaceResponse struct {
+}
+
+func (m *MsgReleaseNamespaceResponse) Reset()         { *m = MsgReleaseNamespaceResponse{} }
+func (m *MsgReleaseNamespaceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseNamespaceResponse) ProtoMessage()    {}
+func (*MsgReleaseNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{9}
+}
+func (m *MsgReleaseNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReleaseNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseNamespaceResponse.Merge(m, src)
+}
+func (m *MsgReleaseNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseNamespaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReleaseNamespaceResponse proto.InternalMessageInfo
+
+// MsgReserveNamespaces reserves namespaces that already have blobs so that
+// they can't be claimed.
+type MsgReserveNamespaces struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// namespaces are the full namespaces (version and id) to reserve.
+	Namespaces [][]byte `protobuf:"bytes,2,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+}
+
+func (m *MsgReserveNamespaces) Reset()         { *m = MsgReserveNamespaces{} }
+func (m *MsgReserveNamespaces) String() string { return proto.CompactTextString(m) }
+func (*MsgReserveNamespaces) ProtoMessage()    {}
+func (*MsgReserveNamespaces) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{10}
+}
+func (m *MsgReserveNamespaces) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReserveNamespaces) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReserveNamespaces.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReserveNamespaces) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReserveNamespaces.Merge(m, src)
+}
+func (m *MsgReserveNamespaces) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReserveNamespaces) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReserveNamespaces.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReserveNamespaces proto.InternalMessageInfo
+
+func (m *MsgReserveNamespaces) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgReserveNamespaces) GetNamespaces() [][]byte {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+// MsgReserveNamespacesResponse defines the MsgReserveNamespaces response type.
+type MsgReserveNamespacesResponse struct {
+}
+
+func (m *MsgReserveNamespacesResponse) Reset()         { *m = MsgReserveNamespacesResponse{} }
+func (m *MsgReserveNamespacesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReserveNamespacesResponse) ProtoMessage()    {}
+func (*MsgReserveNamespacesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9157fbf3d3cd004d, []int{11}
+}
+func (m *MsgReserveNamespacesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReserveNamespacesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReserveNamespacesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReserveNamespacesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReserveNamespacesResponse.Merge(m, src)
+}
+func (m *MsgReserveNamespacesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReserveNamespacesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReserveNamespacesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReserveNamespacesResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgPayForBlobs)(nil), "celestia.blob.v1.MsgPayForBlobs")
 	proto.RegisterType((*MsgPayForBlobsResponse)(nil), "celestia.blob.v1.MsgPayForBlobsResponse")
 	proto.RegisterType((*MsgUpdateBlobParams)(nil), "celestia.blob.v1.MsgUpdateBlobParams")
 	proto.RegisterType((*MsgUpdateBlobParamsResponse)(nil), "celestia.blob.v1.MsgUpdateBlobParamsResponse")
+	proto.RegisterType((*MsgClaimNamespace)(nil), "celestia.blob.v1.MsgClaimNamespace")
+	proto.RegisterType((*MsgClaimNamespaceResponse)(nil), "celestia.blob.v1.MsgClaimNamespaceResponse")
+	proto.RegisterType((*MsgUpdateNamespaceSigners)(nil), "celestia.blob.v1.MsgUpdateNamespaceSigners")
+	proto.RegisterType((*MsgUpdateNamespaceSignersResponse)(nil), "celestia.blob.v1.MsgUpdateNamespaceSignersResponse")
+	proto.RegisterType((*MsgReleaseNamespace)(nil), "celestia.blob.v1.MsgReleaseNamespace")
+	proto.RegisterType((*MsgReleaseNamespaceResponse)(nil), "celestia.blob.v1.MsgReleaseNamespaceResponse")
+	proto.RegisterType((*MsgReserveNamespaces)(nil), "celestia.blob.v1.MsgReserveNamespaces")
+	proto.RegisterType((*MsgReserveNamespacesResponse)(nil), "celestia.blob.v1.MsgReserveNamespacesResponse")
 }
 
 func init() { proto.RegisterFile("celestia/blob/v1/tx.proto", fileDescriptor_9157fbf3d3cd004d) }
 
 var fileDescriptor_9157fbf3d3cd004d = []byte{
-	// 521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xc1, 0x6a, 0xdb, 0x40,
-	0x10, 0xf5, 0xc6, 0xa9, 0xa9, 0xd7, 0x8d, 0x71, 0x55, 0xd3, 0xca, 0x6e, 0xac, 0x18, 0x41, 0x40,
-	0xb8, 0x58, 0x4a, 0x52, 0xe8, 0xc1, 0xb7, 0xba, 0xd0, 0x43, 0xc1, 0x10, 0x14, 0xda, 0x43, 0x2f,
-	0x66, 0x25, 0x6f, 0xd7, 0x02, 0x4b, 0x2b, 0x76, 0x36, 0x26, 0x4e, 0x2f, 0x25, 0x5f, 0x50, 0xe8,
-	0x8f, 0xe4, 0xd0, 0x8f, 0xc8, 0x31, 0xb4, 0x97, 0x9e, 0x4a, 0xb1, 0x0b, 0xb9, 0xf7, 0x0b, 0x8a,
-	0xb4, 0x52, 0xec, 0xc4, 0x81, 0xf6, 0x36, 0x7a, 0xef, 0xcd, 0x9b, 0x79, 0x9a, 0xc5, 0x0d, 0x9f,
-	0x4e, 0x28, 0xc8, 0x80, 0x38, 0xde, 0x84, 0x7b, 0xce, 0x74, 0xdf, 0x91, 0x27, 0x76, 0x2c, 0xb8,
-	0xe4, 0x5a, 0x2d, 0xa7, 0xec, 0x84, 0xb2, 0xa7, 0xfb, 0xcd, 0xd6, 0x9a, 0x38, 0x26, 0x82, 0x84,
-	0xa0, 0x1a, 0x9a, 0x75, 0xc6, 0x19, 0x4f, 0x4b, 0x27, 0xa9, 0x32, 0x74, 0x9b, 0x71, 0xce, 0x26,
-	0xd4, 0x21, 0x71, 0xe0, 0x90, 0x28, 0xe2, 0x92, 0xc8, 0x80, 0x47, 0x79, 0xcf, 0x13, 0x9f, 0x43,
-	0xc8, 0xc1, 0x09, 0x81, 0x25, 0x7e, 0x21, 0xb0, 0x8c, 0x68, 0x28, 0x62, 0xa8, 0xfc, 0xd4, 0x87,
-	0xa2, 0xcc, 0x39, 0xc2, 0xd5, 0x01, 0xb0, 0x43, 0x32, 0x7b, 0xcd, 0x45, 0x7f, 0xc2, 0x3d, 0xd0,
-	0xf6, 0x70, 0x09, 0x02, 0x16, 0x51, 0xa1, 0xa3, 0x36, 0xb2, 0xca, 0x7d, 0xfd, 0xdb, 0xd7, 0x6e,
-	0x3d, 0x6b, 0x7a, 0x39, 0x1a, 0x09, 0x0a, 0x70, 0x24, 0x45, 0x10, 0x31, 0x37, 0xd3, 0x69, 0x06,
-	0xc6, 0x11, 0x09, 0x29, 0xc4, 0xc4, 0xa7, 0xa0, 0x6f, 0xb4, 0x8b, 0xd6, 0x03, 0x77, 0x05, 0xd1,
-	0x5a, 0x18, 0x27, 0x21, 0x87, 0x10, 0x9c, 0x52, 0xd0, 0x8b, 0xed, 0xa2, 0xb5, 0xe5, 0x96, 0x13,
-	0xe4, 0x28, 0x01, 0xb4, 0x67, 0xf8, 0x21, 0x8c, 0x89, 0xa0, 0x43, 0x9f, 0x87, 0x61, 0x20, 0x43,
-	0x1a, 0x49, 0xd0, 0x37, 0x53, 0x97, 0x5a, 0x4a, 0xbc, 0x5a, 0xe2, 0xda, 0x2e, 0xae, 0x2a, 0xf1,
-	0x94, 0x0a, 0x48, 0xc2, 0xeb, 0xf7, 0x53, 0xbf, 0xad, 0x14, 0x7d, 0x97, 0x81, 0xbd, 0xca, 0xd9,
-	0xd5, 0x79, 0x27, 0xdb, 0xcf, 0xd4, 0xf1, 0xe3, 0x9b, 0x19, 0x5d, 0x0a, 0x31, 0x8f, 0x80, 0x9a,
-	0x1f, 0xf1, 0xa3, 0x01, 0xb0, 0xb7, 0xf1, 0x88, 0x48, 0x9a, 0x30, 0x87, 0xe9, 0x0d, 0xb4, 0x6d,
-	0x5c, 0x26, 0xc7, 0x72, 0xcc, 0x45, 0x20, 0x67, 0xea, 0x2f, 0xb8, 0x4b, 0x40, 0x7b, 0x81, 0x4b,
-	0xea, 0x56, 0xfa, 0x46, 0x1b, 0x59, 0x95, 0x03, 0xdd, 0xbe, 0x7d, 0x5d, 0x5b, 0xf9, 0xf4, 0x37,
-	0x2f, 0x7e, 0xee, 0x14, 0xdc, 0x4c, 0xdd, 0xab, 0x26, 0x3b, 0x2d, 0x7d, 0xcc, 0x16, 0x7e, 0x7a,
-	0xc7, 0xf0, 0x7c, 0xb7, 0x83, 0x3f, 0x08, 0x17, 0x07, 0xc0, 0xb4, 0x53, 0x5c, 0x59, 0x3d, 0x4f,
-	0x7b, 0x7d, 0xda, 0xcd, 0x70, 0x4d, 0xeb, 0x5f, 0x8a, 0xeb, 0xf8, 0x3b, 0x67, 0xdf, 0x7f, 0x7f,
-	0xd9, 0x68, 0xf4, 0x50, 0xc7, 0xac, 0xaf, 0xbc, 0xc3, 0xd9, 0x07, 0x2e, 0xbc, 0x74, 0xd8, 0x18,
-	0xd7, 0xd6, 0x7e, 0xce, 0xee, 0x9d, 0xf6, 0xb7, 0x65, 0xcd, 0xee, 0x7f, 0xc9, 0xf2, 0x55, 0x9a,
-	0xf7, 0x3e, 0x5d, 0x9d, 0x77, 0x50, 0xff, 0xcd, 0xc5, 0xdc, 0x40, 0x97, 0x73, 0x03, 0xfd, 0x9a,
-	0x1b, 0xe8, 0xf3, 0xc2, 0x28, 0x5c, 0x2e, 0x8c, 0xc2, 0x8f, 0x85, 0x51, 0x78, 0xbf, 0xc7, 0x02,
-	0x39, 0x3e, 0xf6, 0x6c, 0x9f, 0x87, 0x4e, 0xee, 0xcc, 0x05, 0xbb, 0xae, 0xbb, 0x24, 0x8e, 0x9d,
-	0x13, 0x95, 0x42, 0xce, 0x62, 0x0a, 0x5e, 0x29, 0x7d, 0xe2, 0xcf, 0xff, 0x06, 0x00, 0x00, 0xff,
-	0xff, 0xb3, 0xf0, 0x8e, 0x82, 0x98, 0x03, 0x00, 0x00,
+	// 731 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcb, 0x4e, 0xdb, 0x40,
+	0x14, 0x8d, 0x09, 0xa0, 0x32, 0x40, 0x1a, 0xa6, 0x11, 0x75, 0x0c, 0x98, 0x34, 0x88, 0x2a, 0x02,
+	0x61, 0xf3, 0x90, 0x58, 0x64, 0x47, 0x90, 0xba, 0xa8, 0x94, 0x0a, 0x19, 0xb5, 0x8b, 0x6e, 0xa2,
+	0x49, 0x32, 0x75, 0xac, 0xda, 0x1e, 0xcb, 0xd7, 0x04, 0x42, 0xa5, 0xaa, 0xe2, 0x0b, 0x2a, 0xf5,
+	0x2b, 0xba, 0x63, 0xd1, 0x45, 0x3f, 0x81, 0x25, 0x6a, 0x37, 0x5d, 0x55, 0x55, 0xa8, 0xc4, 0x6f,
+	0x54, 0xf6, 0x38, 0xce, 0xc3, 0x09, 0xa4, 0x52, 0xd5, 0xdd, 0xe4, 0x9e, 0x33, 0xe7, 0x9e, 0xb9,
+	0x0f, 0x07, 0x65, 0x6b, 0xd4, 0xa4, 0xe0, 0x19, 0x44, 0xad, 0x9a, 0xac, 0xaa, 0x36, 0x77, 0x54,
+	0xef, 0x4c, 0x71, 0x5c, 0xe6, 0x31, 0x9c, 0xee, 0x40, 0x8a, 0x0f, 0x29, 0xcd, 0x1d, 0x69, 0x25,
+	0x46, 0x76, 0x88, 0x4b, 0x2c, 0xe0, 0x17, 0xa4, 0x8c, 0xce, 0x74, 0x16, 0x1c, 0x55, 0xff, 0x14,
+	0x46, 0x97, 0x75, 0xc6, 0x74, 0x93, 0xaa, 0xc4, 0x31, 0x54, 0x62, 0xdb, 0xcc, 0x23, 0x9e, 0xc1,
+	0xec, 0xce, 0x9d, 0xc7, 0x35, 0x06, 0x16, 0x03, 0xd5, 0x02, 0xdd, 0xd7, 0xb3, 0x40, 0x0f, 0x81,
+	0x2c, 0x07, 0x2a, 0x5c, 0x8f, 0xff, 0xe0, 0x50, 0xbe, 0x2d, 0xa0, 0x54, 0x19, 0xf4, 0x23, 0xd2,
+	0x7a, 0xc6, 0xdc, 0x92, 0xc9, 0xaa, 0x80, 0xb7, 0xd1, 0x34, 0x18, 0xba, 0x4d, 0x5d, 0x51, 0xc8,
+	0x09, 0x85, 0x99, 0x92, 0xf8, 0xed, 0xcb, 0x56, 0x26, 0xbc, 0x74, 0x50, 0xaf, 0xbb, 0x14, 0xe0,
+	0xd8, 0x73, 0x0d, 0x5b, 0xd7, 0x42, 0x1e, 0x96, 0x11, 0xb2, 0x89, 0x45, 0xc1, 0x21, 0x35, 0x0a,
+	0xe2, 0x44, 0x2e, 0x59, 0x98, 0xd3, 0x7a, 0x22, 0x78, 0x05, 0x21, 0xff, 0x91, 0x15, 0x30, 0xce,
+	0x29, 0x88, 0xc9, 0x5c, 0xb2, 0x30, 0xaf, 0xcd, 0xf8, 0x91, 0x63, 0x3f, 0x80, 0x37, 0xd1, 0x02,
+	0x34, 0x88, 0x4b, 0x2b, 0x35, 0x66, 0x59, 0x86, 0x67, 0x51, 0xdb, 0x03, 0x71, 0x32, 0x50, 0x49,
+	0x07, 0xc0, 0x61, 0x37, 0x8e, 0xd7, 0x51, 0x8a, 0x93, 0x9b, 0xd4, 0x05, 0xff, 0xf1, 0xe2, 0x83,
+	0x40, 0x6f, 0x3e, 0x88, 0xbe, 0x0a, 0x83, 0xc5, 0xd9, 0x8b, 0xdb, 0xcb, 0x8d, 0xd0, 0x5f, 0x5e,
+	0x44, 0x8b, 0xfd, 0x6f, 0xd4, 0x28, 0x38, 0xcc, 0x06, 0x9a, 0x7f, 0x87, 0x1e, 0x95, 0x41, 0x7f,
+	0xe9, 0xd4, 0x89, 0x47, 0x7d, 0xe4, 0x28, 0xe8, 0x01, 0x5e, 0x46, 0x33, 0xe4, 0xc4, 0x6b, 0x30,
+	0xd7, 0xf0, 0x5a, 0xbc, 0x0a, 0x5a, 0x37, 0x80, 0xf7, 0xd1, 0x34, 0xef, 0x95, 0x38, 0x91, 0x13,
+	0x0a, 0xb3, 0xbb, 0xa2, 0x32, 0xd8, 0x5d, 0x85, 0xeb, 0x94, 0x26, 0xaf, 0x7e, 0xae, 0x26, 0xb4,
+	0x90, 0x5d, 0x4c, 0xf9, 0x9e, 0xba, 0x3a, 0xf9, 0x15, 0xb4, 0x34, 0x24, 0x79, 0xe4, 0xcd, 0x42,
+	0x0b, 0x65, 0xd0, 0x0f, 0x4d, 0x62, 0x58, 0x2f, 0x3a, 0xb5, 0xc4, 0x0a, 0x9a, 0x62, 0xa7, 0xe3,
+	0xf4, 0x86, 0xd3, 0xfc, 0x97, 0x44, 0x8d, 0x08, 0xec, 0xce, 0x69, 0xdd, 0x40, 0x11, 0xf9, 0x8e,
+	0x38, 0x33, 0xbf, 0x84, 0xb2, 0xb1, 0x74, 0x91, 0x97, 0xaf, 0x42, 0x80, 0x72, 0xaf, 0x11, 0x7c,
+	0x1c, 0x94, 0x17, 0xfe, 0xad, 0x29, 0x7c, 0x80, 0x1e, 0x12, 0xd3, 0x64, 0xa7, 0xb4, 0x5e, 0xe1,
+	0xfd, 0xe3, 0x23, 0x73, 0x97, 0x6e, 0x2a, 0xbc, 0x10, 0x1a, 0xea, 0x7b, 0xd7, 0x1a, 0x7a, 0x32,
+	0xd2, 0x79, 0xf4, 0x3e, 0x16, 0xcc, 0x81, 0x46, 0x4d, 0x4a, 0x80, 0xfe, 0x8f, 0x6a, 0xf3, 0xde,
+	0x0f, 0x26, 0x8c, 0xfc, 0xbc, 0x47, 0x99, 0x00, 0x06, 0xea, 0x36, 0xbb, 0x30, 0xe0, 0xfd, 0xd8,
+	0x60, 0xde, 0x61, 0xaa, 0x67, 0x64, 0xef, 0xd9, 0xd0, 0xd8, 0x68, 0xca, 0x68, 0x79, 0x58, 0xfe,
+	0x8e, 0xbf, 0xdd, 0xcf, 0x53, 0x28, 0x59, 0x06, 0x1d, 0x9f, 0xa3, 0xd9, 0xde, 0x4f, 0x47, 0x2e,
+	0xbe, 0x09, 0xfd, 0x8b, 0x27, 0x15, 0xee, 0x63, 0x44, 0x25, 0x58, 0xbd, 0xf8, 0xfe, 0xfb, 0xd3,
+	0x44, 0x36, 0x9f, 0xe9, 0xf9, 0x40, 0xb6, 0xde, 0x30, 0xd7, 0xff, 0x05, 0x45, 0x61, 0x03, 0x37,
+	0x50, 0x3a, 0xb6, 0xb8, 0xeb, 0x43, 0xe5, 0x07, 0x69, 0xd2, 0xd6, 0x58, 0xb4, 0x8e, 0x15, 0x5c,
+	0x45, 0xa9, 0x81, 0x35, 0x5c, 0x1b, 0x2a, 0xd0, 0x4f, 0x92, 0x36, 0xc7, 0x20, 0x45, 0x39, 0xce,
+	0xd1, 0xe2, 0x88, 0xed, 0xda, 0xbc, 0xc3, 0xec, 0x20, 0x59, 0xda, 0xfb, 0x0b, 0x72, 0x94, 0xbb,
+	0x81, 0xd2, 0xb1, 0xd1, 0x1f, 0x5e, 0xc9, 0x41, 0xda, 0x88, 0x4a, 0x8e, 0x9a, 0x6b, 0xfc, 0x16,
+	0x2d, 0xc4, 0x87, 0xfa, 0xe9, 0x08, 0x8d, 0x01, 0x9e, 0xa4, 0x8c, 0xc7, 0xeb, 0x24, 0x93, 0xa6,
+	0x3e, 0xdc, 0x5e, 0x6e, 0x08, 0xa5, 0xe7, 0x57, 0x6d, 0x59, 0xb8, 0x6e, 0xcb, 0xc2, 0xaf, 0xb6,
+	0x2c, 0x7c, 0xbc, 0x91, 0x13, 0xd7, 0x37, 0x72, 0xe2, 0xc7, 0x8d, 0x9c, 0x78, 0xbd, 0xad, 0x1b,
+	0x5e, 0xe3, 0xa4, 0xaa, 0xd4, 0x98, 0xa5, 0x76, 0xa4, 0x99, 0xab, 0x47, 0xe7, 0x2d, 0xe2, 0x38,
+	0xea, 0x19, 0x9f, 0x3f, 0xaf, 0xe5, 0x50, 0xa8, 0x4e, 0x07, 0xff, 0x9a, 0x7b, 0x7f, 0x02, 0x00,
+	0x00, 0xff, 0xff, 0xa8, 0x99, 0x8d, 0xc3, 0xeb, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -313,6 +715,18 @@ type MsgClient interface {
 	PayForBlobs(ctx context.Context, in *MsgPayForBlobs, opts ...grpc.CallOption) (*MsgPayForBlobsResponse, error)
 	// UpdateBlobParams defines a rpc handler method for MsgUpdateBlobParams.
 	UpdateBlobParams(ctx context.Context, in *MsgUpdateBlobParams, opts ...grpc.CallOption) (*MsgUpdateBlobParamsResponse, error)
+	// ClaimNamespace claims an unclaimed namespace by escrowing the namespace
+	// claim bond.
+	ClaimNamespace(ctx context.Context, in *MsgClaimNamespace, opts ...grpc.CallOption) (*MsgClaimNamespaceResponse, error)
+	// UpdateNamespaceSigners replaces the signers that, in addition to the owner,
+	// may pay for blobs in a claimed namespace.
+	UpdateNamespaceSigners(ctx context.Context, in *MsgUpdateNamespaceSigners, opts ...grpc.CallOption) (*MsgUpdateNamespaceSignersResponse, error)
+	// ReleaseNamespace releases a claimed namespace and returns the bond to the
+	// owner.
+	ReleaseNamespace(ctx context.Context, in *MsgReleaseNamespace, opts ...grpc.CallOption) (*MsgReleaseNamespaceResponse, error)
+	// ReserveNamespaces reserves namespaces that already have blobs so that they
+	// can't be claimed. It can only be executed by governance.
+	ReserveNamespaces(ctx context.Context, in *MsgReserveNamespaces, opts ...grpc.CallOption) (*MsgReserveNamespacesResponse, error)
 }
 
 type msgClient struct {
@@ -341,12 +755,60 @@ func (c *msgClient) UpdateBlobParams(ctx context.Context, in *MsgUpdateBlobParam
 	return out, nil
 }
 
+func (c *msgClient) ClaimNamespace(ctx context.Context, in *MsgClaimNamespace, opts ...grpc.CallOption) (*MsgClaimNamespaceResponse, error) {
+	out := new(MsgClaimNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Msg/ClaimNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateNamespaceSigners(ctx context.Context, in *MsgUpdateNamespaceSigners, opts ...grpc.CallOption) (*MsgUpdateNamespaceSignersResponse, error) {
+	out := new(MsgUpdateNamespaceSignersResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Msg/UpdateNamespaceSigners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReleaseNamespace(ctx context.Context, in *MsgReleaseNamespace, opts ...grpc.CallOption) (*MsgReleaseNamespaceResponse, error) {
+	out := new(MsgReleaseNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Msg/ReleaseNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReserveNamespaces(ctx context.Context, in *MsgReserveNamespaces, opts ...grpc.CallOption) (*MsgReserveNamespacesResponse, error) {
+	out := new(MsgReserveNamespacesResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Msg/ReserveNamespaces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// PayForBlobs allows the user to pay for the inclusion of one or more blobs
 	PayForBlobs(context.Context, *MsgPayForBlobs) (*MsgPayForBlobsResponse, error)
 	// UpdateBlobParams defines a rpc handler method for MsgUpdateBlobParams.
 	UpdateBlobParams(context.Context, *MsgUpdateBlobParams) (*MsgUpdateBlobParamsResponse, error)
+	// ClaimNamespace claims an unclaimed namespace by escrowing the namespace
+	// claim bond.
+	ClaimNamespace(context.Context, *MsgClaimNamespace) (*MsgClaimNamespaceResponse, error)
+	// UpdateNamespaceSigners replaces the signers that, in addition to the owner,
+	// may pay for blobs in a claimed namespace.
+	UpdateNamespaceSigners(context.Context, *MsgUpdateNamespaceSigners) (*MsgUpdateNamespaceSignersResponse, error)
+	// ReleaseNamespace releases a claimed namespace and returns the bond to the
+	// owner.
+	ReleaseNamespace(context.Context, *MsgReleaseNamespace) (*MsgReleaseNamespaceResponse, error)
+	// ReserveNamespaces reserves namespaces that already have blobs so that they
+	// can't be claimed. It can only be executed by governance.
+	ReserveNamespaces(context.Context, *MsgReserveNamespaces) (*MsgReserveNamespacesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -359,6 +821,18 @@ func (*UnimplementedMsgServer) PayForBlobs(ctx context.Context, req *MsgPayForBl
 func (*UnimplementedMsgServer) UpdateBlobParams(ctx context.Context, req *MsgUpdateBlobParams) (*MsgUpdateBlobParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlobParams not implemented")
 }
+func (*UnimplementedMsgServer) ClaimNamespace(ctx context.Context, req *MsgClaimNamespace) (*MsgClaimNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimNamespace not implemented")
+}
+func (*UnimplementedMsgServer) UpdateNamespaceSigners(ctx context.Context, req *MsgUpdateNamespaceSigners) (*MsgUpdateNamespaceSignersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNamespaceSigners not implemented")
+}
+func (*UnimplementedMsgServer) ReleaseNamespace(ctx context.Context, req *MsgReleaseNamespace) (*MsgReleaseNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseNamespace not implemented")
+}
+func (*UnimplementedMsgServer) ReserveNamespaces(ctx context.Context, req *MsgReserveNamespaces) (*MsgReserveNamespacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveNamespaces not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -400,19 +874,107 @@ func _Msg_UpdateBlobParams_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "celestia.blob.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "PayForBlobs",
-			Handler:    _Msg_PayForBlobs_Handler,
-		},
-		{
+func _Msg_ClaimNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimNamespace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Msg/ClaimNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimNamespace(ctx, req.(*MsgClaimNamespace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateNamespaceSigners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateNamespaceSigners)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateNamespaceSigners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Msg/UpdateNamespaceSigners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateNamespaceSigners(ctx, req.(*MsgUpdateNamespaceSigners))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReleaseNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReleaseNamespace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReleaseNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Msg/ReleaseNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReleaseNamespace(ctx, req.(*MsgReleaseNamespace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReserveNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReserveNamespaces)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReserveNamespaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Msg/ReserveNamespaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReserveNamespaces(ctx, req.(*MsgReserveNamespaces))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.blob.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PayForBlobs",
+			Handler:    _Msg_PayForBlobs_Handler,
+		},
+		{
 			MethodName: "UpdateBlobParams",
 			Handler:    _Msg_UpdateBlobParams_Handler,
 		},
+		{
+			MethodName: "ClaimNamespace",
+			Handler:    _Msg_ClaimNamespace_Handler,
+		},
+		{
+			MethodName: "UpdateNamespaceSigners",
+			Handler:    _Msg_UpdateNamespaceSigners_Handler,
+		},
+		{
+			MethodName: "ReleaseNamespace",
+			Handler:    _Msg_ReleaseNamespace_Handler,
+		},
+		{
+			MethodName: "ReserveNamespaces",
+			Handler:    _Msg_ReserveNamespaces_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/blob/v1/tx.proto",
@@ -588,96 +1150,1336 @@ func (m *MsgUpdateBlobParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgClaimNamespace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgPayForBlobs) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MsgClaimNamespace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateNamespaceSigners) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Namespaces) > 0 {
-		for _, b := range m.Namespaces {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateNamespaceSigners) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateNamespaceSigners) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedSigners) > 0 {
+		for iNdEx := len(m.AllowedSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSigners[iNdEx])
+			copy(dAtA[i:], m.AllowedSigners[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AllowedSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.BlobSizes) > 0 {
-		l = 0
-		for _, e := range m.BlobSizes {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.ShareCommitments) > 0 {
-		for _, b := range m.ShareCommitments {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.ShareVersions) > 0 {
-		l = 0
-		for _, e := range m.ShareVersions {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateNamespaceSignersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgPayForBlobsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateNamespaceSignersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateNamespaceSignersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseNamespace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseNamespace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateBlobParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgReleaseNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReserveNamespaces) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReserveNamespaces) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReserveNamespaces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Namespaces[iNdEx])
+			copy(dAtA[i:], m.Namespaces[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Namespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReserveNamespacesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReserveNamespacesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReserveNamespacesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgPayForBlobs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Namespaces) > 0 {
+		for _, b := range m.Namespaces {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.BlobSizes) > 0 {
+		l = 0
+		for _, e := range m.BlobSizes {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.ShareCommitments) > 0 {
+		for _, b := range m.ShareCommitments {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ShareVersions) > 0 {
+		l = 0
+		for _, e := range m.ShareVersions {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgPayForBlobsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateBlobParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateBlobParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimNamespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateNamespaceSigners) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AllowedSigners) > 0 {
+		for _, s := range m.AllowedSigners {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateNamespaceSignersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReleaseNamespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReleaseNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReserveNamespaces) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Namespaces) > 0 {
+		for _, b := range m.Namespaces {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgReserveNamespacesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgPayForBlobs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayForBlobs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayForBlobs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, make([]byte, postIndex-iNdEx))
+			copy(m.Namespaces[len(m.Namespaces)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BlobSizes = append(m.BlobSizes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BlobSizes) == 0 {
+					m.BlobSizes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BlobSizes = append(m.BlobSizes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobSizes", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareCommitments", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareCommitments = append(m.ShareCommitments, make([]byte, postIndex-iNdEx))
+			copy(m.ShareCommitments[len(m.ShareCommitments)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ShareVersions = append(m.ShareVersions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ShareVersions) == 0 {
+					m.ShareVersions = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShareVersions = append(m.ShareVersions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareVersions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayForBlobsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayForBlobsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayForBlobsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateBlobParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateBlobParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateBlobParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateBlobParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateBlobParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateBlobParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimNamespace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimNamespace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimNamespaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimNamespaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateNamespaceSigners) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateNamespaceSigners: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateNamespaceSigners: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSigners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSigners = append(m.AllowedSigners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateNamespaceSignersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateNamespaceSignersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateNamespaceSignersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateBlobParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgPayForBlobs) Unmarshal(dAtA []byte) error {
+func (m *MsgReleaseNamespace) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -700,15 +2502,15 @@ func (m *MsgPayForBlobs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayForBlobs: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReleaseNamespace: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayForBlobs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReleaseNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -736,11 +2538,11 @@ func (m *MsgPayForBlobs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -767,193 +2569,11 @@ func (m *MsgPayForBlobs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespaces = append(m.Namespaces, make([]byte, postIndex-iNdEx))
-			copy(m.Namespaces[len(m.Namespaces)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.BlobSizes = append(m.BlobSizes, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.BlobSizes) == 0 {
-					m.BlobSizes = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.BlobSizes = append(m.BlobSizes, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlobSizes", wireType)
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareCommitments", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
 			}
-			m.ShareCommitments = append(m.ShareCommitments, make([]byte, postIndex-iNdEx))
-			copy(m.ShareCommitments[len(m.ShareCommitments)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ShareVersions = append(m.ShareVersions, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ShareVersions) == 0 {
-					m.ShareVersions = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ShareVersions = append(m.ShareVersions, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareVersions", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -975,7 +2595,7 @@ func (m *MsgPayForBlobs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayForBlobsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReleaseNamespaceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -998,10 +2618,10 @@ func (m *MsgPayForBlobsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayForBlobsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReleaseNamespaceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayForBlobsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReleaseNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1025,7 +2645,7 @@ func (m *MsgPayForBlobsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateBlobParams) Unmarshal(dAtA []byte) error {
+func (m *MsgReserveNamespaces) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1048,10 +2668,10 @@ func (m *MsgUpdateBlobParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateBlobParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReserveNamespaces: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateBlobParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReserveNamespaces: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1088,9 +2708,9 @@ func (m *MsgUpdateBlobParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1100,24 +2720,23 @@ func (m *MsgUpdateBlobParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespaces = append(m.Namespaces, make([]byte, postIndex-iNdEx))
+			copy(m.Namespaces[len(m.Namespaces)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1140,7 +2759,7 @@ func (m *MsgUpdateBlobParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateBlobParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReserveNamespacesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1163,10 +2782,10 @@ func (m *MsgUpdateBlobParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateBlobParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReserveNamespacesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateBlobParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReserveNamespacesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v5/x/minfee/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SplitFee burns, or sends to the fee split recipient, the fee split of a
+// transaction whose fee has already been deducted to the fee collector. isPFB
+// indicates whether the transaction contains a MsgPayForBlobs. It is a no-op
+// before app version 6.
+//
+// The params are read without charging gas so that the gas consumed by a
+// transaction doesn't change while the fee split is disabled. The split itself
+// is charged to the transaction.
+func (k Keeper) SplitFee(ctx sdk.Context, fee sdk.Coins, isPFB bool) error {
+	if ctx.ConsensusParams().Version.GetApp() < appconsts.V6 {
+		return nil
+	}
+
+	params := k.GetParams(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()))
+	split := params.FeeSplit(fee, isPFB)
+	if split.IsZero() {
+		return nil
+	}
+
+	burned, redistributed := sdk.NewCoins(), sdk.NewCoins()
+	if params.FeeSplitRecipient == "" {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, split); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, split); err != nil {
+			return err
+		}
+		burned = split
+		k.addFees(ctx, types.BurnedFeesKeyPrefix, burned)
+	} else {
+		recipient, err := sdk.AccAddressFromBech32(params.FeeSplitRecipient)
+		if err != nil {
+			return err
+		}
+		if recipient.Equals(authtypes.NewModuleAddress(distrtypes.ModuleName)) {
+			// the distribution module account only holds the coins of the
+			// community pool and the rewards, so the split funds the
+			// community pool.
+			if err := k.distrKeeper.FundCommunityPool(ctx, split, authtypes.NewModuleAddress(authtypes.FeeCollectorName)); err != nil {
+				return err
+			}
+		} else if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, recipient, split); err != nil {
+			return err
+		}
+		redistributed = split
+		k.addFees(ctx, types.RedistributedFeesKeyPrefix, redistributed)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(types.NewFeeSplitEvent(burned, redistributed, params.FeeSplitRecipient))
+}
+
+// validateFeeSplitRecipient returns an error if the fee split recipient of the
+// params is a module account other than the distribution module account, whose
+// split funds the community pool, or a blocked address of the bank keeper.
+func (k Keeper) validateFeeSplitRecipient(ctx sdk.Context, params types.Params) error {
+	if params.FeeSplitRecipient == "" {
+		return nil
+	}
+	recipient, err := sdk.AccAddressFromBech32(params.FeeSplitRecipient)
+	if err != nil {
+		return err
+	}
+	if recipient.Equals(authtypes.NewModuleAddress(distrtypes.ModuleName)) {
+		return nil
+	}
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return fmt.Errorf("fee split recipient %s is not allowed to receive funds", params.FeeSplitRecipient)
+	}
+	if _, ok := k.accountKeeper.GetAccount(ctx, recipient).(sdk.ModuleAccountI); ok {
+		return fmt.Errorf("fee split recipient %s is a module account", params.FeeSplitRecipient)
+	}
+	return nil
+}
+
+// GetBurnedFees returns the cumulative fees burned by the fee split.
+func (k Keeper) GetBurnedFees(ctx sdk.Context) sdk.Coins {
+	return k.getFees(ctx, types.BurnedFeesKeyPrefix)
+}
+
+// GetRedistributedFees returns the cumulative fees sent to fee split
+// recipients.
+func (k Keeper) GetRedistributedFees(ctx sdk.Context) sdk.Coins {
+	return k.getFees(ctx, types.RedistributedFeesKeyPrefix)
+}
+
+// FeeSplitTotals returns the cumulative fees burned or redistributed by the
+// fee split.
+func (k Keeper) FeeSplitTotals(c context.Context, req *types.QueryFeeSplitTotalsRequest) (*types.QueryFeeSplitTotalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryFeeSplitTotalsResponse{
+		Burned:        k.GetBurnedFees(ctx),
+		Redistributed: k.GetRedistributedFees(ctx),
+	}, nil
+}
+
+// addFees adds the coins to the cumulative fees stored under the key prefix.
+func (k Keeper) addFees(ctx sdk.Context, keyPrefix string, coins sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(keyPrefix))
+	for _, coin := range coins {
+		total := coin.Amount
+		if bz := store.Get([]byte(coin.Denom)); len(bz) != 0 {
+			var stored math.Int
+			if err := stored.Unmarshal(bz); err != nil {
+				panic(err)
+			}
+			total = total.Add(stored)
+		}
+		bz, err := total.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		store.Set([]byte(coin.Denom), bz)
+	}
+}
+
+// getFees returns the cumulative fees stored under the key prefix.
+func (k Keeper) getFees(ctx sdk.Context, keyPrefix string) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(keyPrefix))
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	fees := sdk.NewCoins()
+	for ; it.Valid(); it.Next() {
+		var amount math.Int
+		if err := amount.Unmarshal(it.Value()); err != nil {
+			panic(err)
+		}
+		fees = fees.Add(sdk.NewCoin(string(it.Key()), amount))
+	}
+	return fees
+}
@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v5/app"
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	testutil "github.com/celestiaorg/celestia-app/v5/test/util"
+	"github.com/celestiaorg/celestia-app/v5/test/util/testnode"
+	"github.com/celestiaorg/celestia-app/v5/x/minfee/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitFee(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1000))
+	recipient := testnode.RandomAddress().(sdk.AccAddress)
+
+	tests := []struct {
+		name              string
+		fraction          math.LegacyDec
+		recipient         string
+		pfbOnly           bool
+		isPFB             bool
+		appVersion        uint64
+		wantBurned        sdk.Coins
+		wantRedistributed sdk.Coins
+	}{
+		{
+			name:     "disabled",
+			fraction: math.LegacyZeroDec(),
+		},
+		{
+			name:       "burn",
+			fraction:   math.LegacyMustNewDecFromStr("0.25"),
+			wantBurned: sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 250)),
+		},
+		{
+			name:              "redistribute",
+			fraction:          math.LegacyMustNewDecFromStr("0.5"),
+			recipient:         recipient.String(),
+			wantRedistributed: sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 500)),
+		},
+		{
+			name:       "no split before v6",
+			fraction:   math.LegacyMustNewDecFromStr("0.25"),
+			recipient:  recipient.String(),
+			appVersion: appconsts.V6 - 1,
+		},
+		{
+			name:     "pfb only skips other txs",
+			fraction: math.LegacyMustNewDecFromStr("0.25"),
+			pfbOnly:  true,
+		},
+		{
+			name:       "pfb only applies to pfbs",
+			fraction:   math.LegacyMustNewDecFromStr("0.25"),
+			pfbOnly:    true,
+			isPFB:      true,
+			wantBurned: sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 250)),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+			appVersion := appconsts.V6
+			if tc.appVersion != 0 {
+				appVersion = tc.appVersion
+			}
+			ctx := testApp.NewContext(false).WithConsensusParams(cmtproto.ConsensusParams{
+				Version: &cmtproto.VersionParams{App: appVersion},
+			})
+			k := testApp.MinFeeKeeper
+
+			// simulate the fee being deducted to the fee collector
+			require.NoError(t, testApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, fee))
+			require.NoError(t, testApp.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, fee))
+			feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+			feeCollectorBalance := testApp.BankKeeper.GetBalance(ctx, feeCollector, appconsts.BondDenom)
+			supply := testApp.BankKeeper.GetSupply(ctx, appconsts.BondDenom)
+
+			params := types.DefaultParams()
+			params.FeeSplitFraction = tc.fraction
+			params.FeeSplitRecipient = tc.recipient
+			params.FeeSplitPfbOnly = tc.pfbOnly
+			require.NoError(t, params.Validate())
+			k.SetParams(ctx, params)
+
+			require.NoError(t, k.SplitFee(ctx, fee, tc.isPFB))
+
+			split := tc.wantBurned.Add(tc.wantRedistributed...)
+			require.Equal(t, feeCollectorBalance.Amount.Sub(split.AmountOf(appconsts.BondDenom)), testApp.BankKeeper.GetBalance(ctx, feeCollector, appconsts.BondDenom).Amount)
+			require.Equal(t, supply.Amount.Sub(tc.wantBurned.AmountOf(appconsts.BondDenom)), testApp.BankKeeper.GetSupply(ctx, appconsts.BondDenom).Amount)
+			require.Equal(t, tc.wantRedistributed.AmountOf(appconsts.BondDenom), testApp.BankKeeper.GetBalance(ctx, recipient, appconsts.BondDenom).Amount)
+
+			// the totals are cumulative
+			require.NoError(t, testApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, fee))
+			require.NoError(t, testApp.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, fee))
+			require.NoError(t, k.SplitFee(ctx, fee, tc.isPFB))
+			resp, err := k.FeeSplitTotals(ctx, &types.QueryFeeSplitTotalsRequest{})
+			require.NoError(t, err)
+			require.Equal(t, tc.wantBurned.Add(tc.wantBurned...), resp.Burned)
+			require.Equal(t, tc.wantRedistributed.Add(tc.wantRedistributed...), resp.Redistributed)
+		})
+	}
+}
+
+func TestSplitFeeToCommunityPool(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1000))
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	ctx := testApp.NewContext(false).WithConsensusParams(cmtproto.ConsensusParams{
+		Version: &cmtproto.VersionParams{App: appconsts.V6},
+	})
+	k := testApp.MinFeeKeeper
+
+	require.NoError(t, testApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, fee))
+	require.NoError(t, testApp.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, fee))
+	feePool, err := testApp.DistrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.FeeSplitFraction = math.LegacyMustNewDecFromStr("0.5")
+	params.FeeSplitRecipient = authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+	k.SetParams(ctx, params)
+
+	require.NoError(t, k.SplitFee(ctx, fee, false))
+
+	got, err := testApp.DistrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, feePool.CommunityPool.AmountOf(appconsts.BondDenom).Add(math.LegacyNewDec(500)), got.CommunityPool.AmountOf(appconsts.BondDenom))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 500)), k.GetRedistributedFees(ctx))
+}
+
+func TestUpdateFeeSplitParams(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	k := testApp.MinFeeKeeper
+	newCtx := func(appVersion uint64) sdk.Context {
+		return testApp.NewContext(false).WithConsensusParams(cmtproto.ConsensusParams{
+			Version: &cmtproto.VersionParams{App: appVersion},
+		})
+	}
+	update := func(ctx sdk.Context, modify func(p *types.Params)) error {
+		params := types.DefaultParams()
+		modify(&params)
+		_, err := k.UpdateMinfeeParams(ctx, &types.MsgUpdateMinfeeParams{Authority: k.GetAuthority(), Params: params})
+		return err
+	}
+
+	ctx := newCtx(appconsts.V6)
+	require.NoError(t, update(ctx, func(p *types.Params) {
+		p.FeeSplitFraction = math.LegacyMustNewDecFromStr("0.25")
+		p.FeeSplitRecipient = testnode.RandomAddress().String()
+	}))
+
+	require.NoError(t, update(ctx, func(p *types.Params) {
+		p.FeeSplitFraction = math.LegacyMustNewDecFromStr("0.25")
+		p.FeeSplitRecipient = authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+	}))
+
+	err := update(ctx, func(p *types.Params) {
+		p.FeeSplitFraction = math.LegacyMustNewDecFromStr("0.25")
+		p.FeeSplitRecipient = authtypes.NewModuleAddress(minttypes.ModuleName).String()
+	})
+	require.ErrorContains(t, err, "is a module account")
+
+	err = update(newCtx(appconsts.V6-1), func(p *types.Params) {
+		p.FeeSplitFraction = math.LegacyMustNewDecFromStr("0.25")
+	})
+	require.ErrorContains(t, err, "not supported before app version")
+}
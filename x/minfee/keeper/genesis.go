@@ -15,6 +15,9 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 	if err := genState.Params.Validate(); err != nil {
 		return fmt.Errorf("invalid minfee genesis state parameters: %w", err)
 	}
+	if err := k.validateFeeSplitRecipient(sdkCtx, genState.Params); err != nil {
+		return fmt.Errorf("invalid minfee genesis state parameters: %w", err)
+	}
 
 	k.SetParams(sdkCtx, genState.Params)
 	return nil
@@ -13,8 +13,7 @@ var _ types.QueryServer = &Keeper{}
 
 // NetworkMinGasPrice returns the network minimum gas price.
 func (k *Keeper) NetworkMinGasPrice(ctx context.Context, _ *types.QueryNetworkMinGasPrice) (*types.QueryNetworkMinGasPriceResponse, error) {
-	// delegate to the self managed params.
-	networkMinGasPrice := k.GetParams(sdk.UnwrapSDKContext(ctx)).NetworkMinGasPrice
+	networkMinGasPrice := k.GetNetworkMinGasPrice(sdk.UnwrapSDKContext(ctx))
 	return &types.QueryNetworkMinGasPriceResponse{NetworkMinGasPrice: networkMinGasPrice}, nil
 }
 
@@ -13,6 +13,9 @@ type Keeper struct {
 	storeKey       storetypes.StoreKey
 	paramsKeeper   params.Keeper
 	legacySubspace paramtypes.Subspace
+	bankKeeper     types.BankKeeper
+	accountKeeper  types.AccountKeeper
+	distrKeeper    types.DistributionKeeper
 	authority      string
 }
 
@@ -21,6 +24,9 @@ func NewKeeper(
 	storeKey storetypes.StoreKey,
 	paramsKeeper params.Keeper,
 	legacySubspace paramtypes.Subspace,
+	bankKeeper types.BankKeeper,
+	accountKeeper types.AccountKeeper,
+	distrKeeper types.DistributionKeeper,
 	authority string,
 ) *Keeper {
 	if !legacySubspace.HasKeyTable() {
@@ -32,6 +38,9 @@ func NewKeeper(
 		storeKey:       storeKey,
 		paramsKeeper:   paramsKeeper,
 		legacySubspace: legacySubspace,
+		bankKeeper:     bankKeeper,
+		accountKeeper:  accountKeeper,
+		distrKeeper:    distrKeeper,
 		authority:      authority,
 	}
 }
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"cosmossdk.io/math"
 	minfeetypes "github.com/celestiaorg/celestia-app/v5/x/minfee/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -26,3 +27,24 @@ func (m *Migrator) MigrateParams(ctx sdk.Context) error {
 	m.keeper.SetParams(ctx, minfeetypes.NewParams(params.NetworkMinGasPrice))
 	return nil
 }
+
+// MigrateParamsV6 handles the migration of the minfee module parameters to the
+// parameters of app version 6. The dynamic network min gas price and the fee
+// split that were added in app version 6 start out disabled.
+func (m *Migrator) MigrateParamsV6(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.DynamicMinGasPriceEnabled = false
+	params.MaxNetworkMinGasPrice = minfeetypes.DefaultMaxNetworkMinGasPrice
+	params.TargetSquareUtilization = minfeetypes.DefaultTargetSquareUtilization
+	params.MaxChangeRate = minfeetypes.DefaultMaxChangeRate
+	params.FeeSplitFraction = math.LegacyZeroDec()
+	params.FeeSplitRecipient = ""
+	params.FeeSplitPfbOnly = false
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
@@ -42,3 +42,22 @@ func TestMigrateParams(t *testing.T) {
 		})
 	}
 }
+
+func TestMigrateParamsV6(t *testing.T) {
+	testApp, _ := util.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	ctx := testApp.GetBaseApp().NewContext(true)
+
+	networkMinGasPrice := math.LegacyMustNewDecFromStr("0.000005")
+	testApp.MinFeeKeeper.SetParams(ctx, minfeetypes.Params{NetworkMinGasPrice: networkMinGasPrice})
+
+	migrator := keeper.NewMigrator(testApp.MinFeeKeeper)
+	require.NoError(t, migrator.MigrateParamsV6(ctx))
+
+	params := testApp.MinFeeKeeper.GetParams(ctx)
+	require.Equal(t, networkMinGasPrice, params.NetworkMinGasPrice)
+	require.False(t, params.DynamicMinGasPriceEnabled)
+	require.Equal(t, minfeetypes.DefaultMaxNetworkMinGasPrice, params.MaxNetworkMinGasPrice)
+	require.Equal(t, minfeetypes.DefaultTargetSquareUtilization, params.TargetSquareUtilization)
+	require.Equal(t, minfeetypes.DefaultMaxChangeRate, params.MaxChangeRate)
+	require.Equal(t, math.LegacyZeroDec(), params.FeeSplitFraction)
+}
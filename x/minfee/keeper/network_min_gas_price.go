@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v5/x/minfee/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsDynamicMinGasPriceEnabled returns true if the dynamic network min gas price
+// is enabled by the params and supported by the current app version.
+func (k Keeper) IsDynamicMinGasPriceEnabled(ctx sdk.Context) bool {
+	if ctx.ConsensusParams().Version.GetApp() < appconsts.V6 {
+		return false
+	}
+	return k.GetParams(ctx).DynamicMinGasPriceEnabled
+}
+
+// GetNetworkMinGasPrice returns the network min gas price that transactions
+// must pay. It is the NetworkMinGasPrice param unless the dynamic network min
+// gas price is enabled, in which case it is the current dynamic price.
+func (k Keeper) GetNetworkMinGasPrice(ctx sdk.Context) math.LegacyDec {
+	params := k.GetParams(ctx)
+	if !k.IsDynamicMinGasPriceEnabled(ctx) {
+		return params.NetworkMinGasPrice
+	}
+
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.NetworkMinGasPriceKey))
+	if len(bz) == 0 {
+		return params.NetworkMinGasPrice
+	}
+	var price math.LegacyDec
+	if err := price.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	// the bounds may have been changed by governance since the price was set.
+	return params.BoundNetworkMinGasPrice(price)
+}
+
+// SetNetworkMinGasPrice sets the current dynamic network min gas price.
+func (k Keeper) SetNetworkMinGasPrice(ctx sdk.Context, price math.LegacyDec) {
+	bz, err := price.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set([]byte(types.NetworkMinGasPriceKey), bz)
+}
+
+// SetSquareUtilization records the fraction of the max effective square
+// occupied by the block being finalized. It is consumed by the EndBlocker to
+// adjust the dynamic network min gas price.
+func (k Keeper) SetSquareUtilization(ctx sdk.Context, utilization math.LegacyDec) {
+	bz, err := utilization.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set([]byte(types.SquareUtilizationKey), bz)
+}
+
+// EndBlocker adjusts the dynamic network min gas price based on the square
+// utilization recorded for the current block. The new price applies from the
+// next block onwards so that transactions are always checked and delivered
+// against the same price.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := sdkCtx.KVStore(k.storeKey)
+
+	bz := store.Get([]byte(types.SquareUtilizationKey))
+	if len(bz) == 0 {
+		return nil
+	}
+	store.Delete([]byte(types.SquareUtilizationKey))
+
+	if !k.IsDynamicMinGasPriceEnabled(sdkCtx) {
+		return nil
+	}
+	params := k.GetParams(sdkCtx)
+
+	var utilization math.LegacyDec
+	if err := utilization.Unmarshal(bz); err != nil {
+		return err
+	}
+
+	price := params.NextNetworkMinGasPrice(k.GetNetworkMinGasPrice(sdkCtx), utilization)
+	k.SetNetworkMinGasPrice(sdkCtx, price)
+
+	return sdkCtx.EventManager().EmitTypedEvent(types.NewNetworkMinGasPriceEvent(price, utilization))
+}
@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v5/app"
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	testutil "github.com/celestiaorg/celestia-app/v5/test/util"
+	"github.com/celestiaorg/celestia-app/v5/x/minfee/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDynamicNetworkMinGasPrice(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	k := testApp.MinFeeKeeper
+	ctx := testApp.NewContext(false).WithConsensusParams(cmtproto.ConsensusParams{
+		Version: &cmtproto.VersionParams{App: appconsts.V6},
+	})
+
+	params := types.DefaultParams()
+	floor := params.NetworkMinGasPrice
+
+	// the utilization is ignored while the dynamic price is disabled
+	k.SetSquareUtilization(ctx, math.LegacyOneDec())
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Equal(t, floor, k.GetNetworkMinGasPrice(ctx))
+
+	params.DynamicMinGasPriceEnabled = true
+	k.SetParams(ctx, params)
+	require.Equal(t, floor, k.GetNetworkMinGasPrice(ctx))
+
+	// no utilization was recorded for this block so the price is unchanged
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Equal(t, floor, k.GetNetworkMinGasPrice(ctx))
+
+	// a full square raises the price by the max change rate
+	k.SetSquareUtilization(ctx, math.LegacyOneDec())
+	require.NoError(t, k.EndBlocker(ctx))
+	raised := floor.Add(floor.Mul(params.MaxChangeRate))
+	require.Equal(t, raised, k.GetNetworkMinGasPrice(ctx))
+
+	resp, err := k.NetworkMinGasPrice(ctx, &types.QueryNetworkMinGasPrice{})
+	require.NoError(t, err)
+	require.Equal(t, raised, resp.NetworkMinGasPrice)
+
+	// an empty square lowers the price but never below the floor
+	k.SetSquareUtilization(ctx, math.LegacyZeroDec())
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Equal(t, floor, k.GetNetworkMinGasPrice(ctx))
+
+	// governance lowering the max bounds the current price
+	k.SetSquareUtilization(ctx, math.LegacyOneDec())
+	require.NoError(t, k.EndBlocker(ctx))
+	params.MaxNetworkMinGasPrice = floor
+	k.SetParams(ctx, params)
+	require.Equal(t, floor, k.GetNetworkMinGasPrice(ctx))
+
+	// disabling the dynamic price restores the static one
+	params.DynamicMinGasPriceEnabled = false
+	params.NetworkMinGasPrice = floor.MulInt64(3)
+	k.SetParams(ctx, params)
+	require.Equal(t, params.NetworkMinGasPrice, k.GetNetworkMinGasPrice(ctx))
+}
+
+func TestDynamicNetworkMinGasPriceBeforeV6(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	k := testApp.MinFeeKeeper
+	ctx := testApp.NewContext(false).WithConsensusParams(cmtproto.ConsensusParams{
+		Version: &cmtproto.VersionParams{App: appconsts.V6 - 1},
+	})
+
+	params := types.DefaultParams()
+	params.DynamicMinGasPriceEnabled = true
+
+	_, err := k.UpdateMinfeeParams(ctx, &types.MsgUpdateMinfeeParams{
+		Authority: k.GetAuthority(),
+		Params:    params,
+	})
+	require.ErrorContains(t, err, "not supported before app version")
+
+	// a price stored before the upgrade is ignored and never adjusted.
+	k.SetParams(ctx, params)
+	k.SetNetworkMinGasPrice(ctx, params.MaxNetworkMinGasPrice)
+	require.False(t, k.IsDynamicMinGasPriceEnabled(ctx))
+	require.Equal(t, params.NetworkMinGasPrice, k.GetNetworkMinGasPrice(ctx))
+
+	k.SetSquareUtilization(ctx, math.LegacyOneDec())
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Equal(t, params.NetworkMinGasPrice, k.GetNetworkMinGasPrice(ctx))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"cosmossdk.io/errors"
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v5/x/minfee/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -24,6 +25,19 @@ func (k Keeper) UpdateMinfeeParams(goCtx context.Context, msg *types.MsgUpdateMi
 		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid parameters: %s", err)
 	}
 
+	if err := k.validateFeeSplitRecipient(ctx, msg.Params); err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid parameters: %s", err)
+	}
+
+	if ctx.ConsensusParams().Version.GetApp() < appconsts.V6 {
+		if msg.Params.DynamicMinGasPriceEnabled {
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "the dynamic network min gas price is not supported before app version %d", appconsts.V6)
+		}
+		if !msg.Params.FeeSplitFraction.IsNil() && msg.Params.FeeSplitFraction.IsPositive() {
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "the fee split is not supported before app version %d", appconsts.V6)
+		}
+	}
+
 	k.SetParams(ctx, msg.Params)
 
 	// Emit an event indicating successful parameter update.
@@ -28,7 +28,7 @@ func TestNewModuleInitializesKeyTable(t *testing.T) {
 	subspace := paramsKeeper.Subspace(types.ModuleName)
 
 	// Initialize the minfee module which registers the key table
-	minfee.NewAppModule(cdc, keeper.NewKeeper(cdc, nil, paramsKeeper, subspace, ""))
+	minfee.NewAppModule(cdc, keeper.NewKeeper(cdc, nil, paramsKeeper, subspace, nil, nil, nil, ""))
 
 	// Require key table to be initialized
 	hasKeyTable := subspace.HasKeyTable()
@@ -4,7 +4,11 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -77,28 +81,155 @@ func (m *EventUpdateMinfeeParams) GetParams() Params {
 	return Params{}
 }
 
+// EventNetworkMinGasPrice defines an event that is emitted when the dynamic
+// network min gas price is adjusted at the end of a block.
+type EventNetworkMinGasPrice struct {
+	// network_min_gas_price is the price that applies from the next block.
+	NetworkMinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=network_min_gas_price,json=networkMinGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"network_min_gas_price"`
+	// square_utilization is the fraction of the max effective square that was
+	// occupied by the block.
+	SquareUtilization cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=square_utilization,json=squareUtilization,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"square_utilization"`
+}
+
+func (m *EventNetworkMinGasPrice) Reset()         { *m = EventNetworkMinGasPrice{} }
+func (m *EventNetworkMinGasPrice) String() string { return proto.CompactTextString(m) }
+func (*EventNetworkMinGasPrice) ProtoMessage()    {}
+func (*EventNetworkMinGasPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c24135af0aaa5c3, []int{1}
+}
+func (m *EventNetworkMinGasPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNetworkMinGasPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNetworkMinGasPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventNetworkMinGasPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNetworkMinGasPrice.Merge(m, src)
+}
+func (m *EventNetworkMinGasPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNetworkMinGasPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNetworkMinGasPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNetworkMinGasPrice proto.InternalMessageInfo
+
+// EventFeeSplit defines an event that is emitted when a part of the fees of a
+// transaction is burned or sent to the fee split recipient.
+type EventFeeSplit struct {
+	// burned are the fees that were burned.
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+	// redistributed are the fees that were sent to the recipient.
+	Redistributed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=redistributed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"redistributed"`
+	// recipient is the bech32 encoded address that received the redistributed
+	// fees.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *EventFeeSplit) Reset()         { *m = EventFeeSplit{} }
+func (m *EventFeeSplit) String() string { return proto.CompactTextString(m) }
+func (*EventFeeSplit) ProtoMessage()    {}
+func (*EventFeeSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c24135af0aaa5c3, []int{2}
+}
+func (m *EventFeeSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFeeSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeeSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFeeSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeeSplit.Merge(m, src)
+}
+func (m *EventFeeSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFeeSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeeSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeeSplit proto.InternalMessageInfo
+
+func (m *EventFeeSplit) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func (m *EventFeeSplit) GetRedistributed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Redistributed
+	}
+	return nil
+}
+
+func (m *EventFeeSplit) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventUpdateMinfeeParams)(nil), "celestia.minfee.v1.EventUpdateMinfeeParams")
+	proto.RegisterType((*EventNetworkMinGasPrice)(nil), "celestia.minfee.v1.EventNetworkMinGasPrice")
+	proto.RegisterType((*EventFeeSplit)(nil), "celestia.minfee.v1.EventFeeSplit")
 }
 
 func init() { proto.RegisterFile("celestia/minfee/v1/event.proto", fileDescriptor_0c24135af0aaa5c3) }
 
 var fileDescriptor_0c24135af0aaa5c3 = []byte{
-	// 224 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0xcf, 0xcd, 0xcc, 0x4b, 0x4b, 0x4d, 0xd5, 0x2f, 0x33, 0xd4, 0x4f,
-	0x2d, 0x4b, 0xcd, 0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0xc9, 0xeb, 0x41,
-	0xe4, 0xf5, 0xca, 0x0c, 0xa5, 0xe4, 0xb1, 0xe8, 0x29, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0x86, 0x68,
-	0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0x88, 0xa8, 0x52, 0x36, 0x97,
-	0xb8, 0x2b, 0xc8, 0xe4, 0xd0, 0x82, 0x94, 0xc4, 0x92, 0x54, 0x5f, 0xb0, 0xd6, 0x00, 0xb0, 0x36,
-	0x21, 0x31, 0x2e, 0xb6, 0xe2, 0xcc, 0xf4, 0xbc, 0xd4, 0x22, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce,
-	0x20, 0x28, 0x4f, 0xc8, 0x82, 0x8b, 0x0d, 0x62, 0xb0, 0x04, 0x93, 0x02, 0xa3, 0x06, 0xb7, 0x91,
-	0x94, 0x1e, 0xa6, 0x73, 0xf4, 0x20, 0x66, 0x38, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x04, 0x55,
-	0xef, 0xe4, 0x73, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x46, 0xe9, 0x99,
-	0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x30, 0xd3, 0xf2, 0x8b, 0xd2, 0xe1, 0x6c,
-	0xdd, 0xc4, 0x82, 0x02, 0xfd, 0x0a, 0x98, 0xd7, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0,
-	0x3e, 0x30, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x1a, 0x05, 0xdd, 0x2e, 0x01, 0x00, 0x00,
+	// 469 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3d, 0x6f, 0x13, 0x41,
+	0x10, 0xf5, 0x25, 0xc8, 0x52, 0x36, 0x4a, 0xc1, 0x8a, 0x8f, 0x8b, 0x41, 0x67, 0xcb, 0x95, 0x1b,
+	0xef, 0x61, 0xd3, 0x50, 0x9b, 0x00, 0x4d, 0x82, 0x22, 0xa3, 0x34, 0x34, 0x66, 0x6f, 0x6f, 0xb8,
+	0x8c, 0xec, 0xdb, 0xbd, 0xec, 0xee, 0x19, 0x42, 0x4f, 0xcf, 0xef, 0xa0, 0xe6, 0x47, 0xa4, 0x8c,
+	0xa8, 0x10, 0x45, 0x00, 0xfb, 0x8f, 0xa0, 0xdb, 0xdd, 0x10, 0x50, 0xa8, 0x10, 0xd5, 0xcd, 0xcc,
+	0xbb, 0x79, 0x6f, 0x9e, 0x66, 0x96, 0x24, 0x02, 0x16, 0x60, 0x2c, 0xf2, 0xb4, 0x44, 0xf9, 0x1a,
+	0x20, 0x5d, 0x8e, 0x52, 0x58, 0x82, 0xb4, 0xac, 0xd2, 0xca, 0x2a, 0x4a, 0x2f, 0x71, 0xe6, 0x71,
+	0xb6, 0x1c, 0x75, 0xba, 0x7f, 0xe9, 0xa9, 0xb8, 0xe6, 0xa5, 0xf1, 0x4d, 0x9d, 0x5b, 0x85, 0x2a,
+	0x94, 0x0b, 0xd3, 0x26, 0x0a, 0xd5, 0x5d, 0xa1, 0x4c, 0xa9, 0xcc, 0xcc, 0x03, 0x3e, 0x09, 0x50,
+	0xe2, 0xb3, 0x34, 0xe3, 0xa6, 0x61, 0xcb, 0xc0, 0xf2, 0x51, 0x2a, 0x14, 0x4a, 0x8f, 0xf7, 0xe7,
+	0xe4, 0xee, 0x93, 0x66, 0xa8, 0xa3, 0x2a, 0xe7, 0x16, 0x0e, 0x9c, 0xea, 0xa1, 0x53, 0xa4, 0x77,
+	0x48, 0xdb, 0x60, 0x21, 0x41, 0xc7, 0x51, 0x2f, 0x1a, 0x6c, 0x4d, 0x43, 0x46, 0x1f, 0x91, 0xb6,
+	0x9f, 0x29, 0xde, 0xe8, 0x45, 0x83, 0xed, 0x71, 0x87, 0x5d, 0x77, 0xc2, 0x3c, 0xc7, 0xe4, 0xc6,
+	0xd9, 0x45, 0xb7, 0x35, 0x0d, 0xff, 0xf7, 0x7f, 0x44, 0x41, 0xed, 0x39, 0xd8, 0x37, 0x4a, 0xcf,
+	0x0f, 0x50, 0x3e, 0xe3, 0xe6, 0x50, 0xa3, 0x00, 0x9a, 0x93, 0xdb, 0xd2, 0x57, 0x67, 0x25, 0xca,
+	0x59, 0xc1, 0x1b, 0x3b, 0x28, 0xc0, 0x8b, 0x4f, 0x46, 0x0d, 0xd1, 0xd7, 0x8b, 0xee, 0x3d, 0xef,
+	0xc7, 0xe4, 0x73, 0x86, 0x2a, 0x2d, 0xb9, 0x3d, 0x66, 0xfb, 0x50, 0x70, 0x71, 0xba, 0x07, 0xe2,
+	0xf3, 0xa7, 0x21, 0x09, 0xe6, 0xf7, 0x40, 0x4c, 0xa9, 0xbc, 0xae, 0xf2, 0x8a, 0x50, 0x73, 0x52,
+	0x73, 0x0d, 0xb3, 0xda, 0xe2, 0x02, 0xdf, 0x71, 0x8b, 0x4a, 0x3a, 0x1f, 0xff, 0x24, 0x71, 0xd3,
+	0x93, 0x1d, 0x5d, 0x71, 0xf5, 0xdf, 0x6f, 0x90, 0x1d, 0xe7, 0xf1, 0x29, 0xc0, 0x8b, 0x6a, 0x81,
+	0x96, 0x0a, 0xd2, 0xce, 0x6a, 0x2d, 0x21, 0x8f, 0xa3, 0xde, 0xe6, 0x60, 0x7b, 0xbc, 0xcb, 0x02,
+	0x43, 0xb3, 0x13, 0x16, 0x76, 0xc2, 0x1e, 0x2b, 0x94, 0x93, 0x07, 0xcd, 0x08, 0x1f, 0xbf, 0x75,
+	0x07, 0x05, 0xda, 0xe3, 0x3a, 0x63, 0x42, 0x95, 0x61, 0x9d, 0xe1, 0x33, 0x34, 0xf9, 0x3c, 0xb5,
+	0xa7, 0x15, 0x18, 0xd7, 0x60, 0xa6, 0x81, 0x9a, 0x9e, 0x90, 0x1d, 0x0d, 0x39, 0x1a, 0xab, 0x31,
+	0xab, 0x2d, 0xe4, 0xf1, 0xc6, 0xff, 0xd7, 0xfa, 0x53, 0x81, 0xde, 0x27, 0x5b, 0x1a, 0x04, 0x56,
+	0x08, 0xd2, 0xc6, 0x9b, 0xee, 0x44, 0xae, 0x0a, 0x93, 0xfd, 0xb3, 0x55, 0x12, 0x9d, 0xaf, 0x92,
+	0xe8, 0xfb, 0x2a, 0x89, 0x3e, 0xac, 0x93, 0xd6, 0xf9, 0x3a, 0x69, 0x7d, 0x59, 0x27, 0xad, 0x97,
+	0xe3, 0xdf, 0x05, 0xc3, 0xe5, 0x28, 0x5d, 0xfc, 0x8a, 0x87, 0xbc, 0xaa, 0xd2, 0xb7, 0x97, 0x2f,
+	0xc0, 0x0d, 0x90, 0xb5, 0xdd, 0xb5, 0x3e, 0xfc, 0x19, 0x00, 0x00, 0xff, 0xff, 0x1b, 0xad, 0xce,
+	0x3e, 0x55, 0x03, 0x00, 0x00,
 }
 
 func (m *EventUpdateMinfeeParams) Marshal() (dAtA []byte, err error) {
@@ -141,6 +272,107 @@ func (m *EventUpdateMinfeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventNetworkMinGasPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNetworkMinGasPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNetworkMinGasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SquareUtilization.Size()
+		i -= size
+		if _, err := m.SquareUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.NetworkMinGasPrice.Size()
+		i -= size
+		if _, err := m.NetworkMinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFeeSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeeSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeeSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Redistributed) > 0 {
+		for iNdEx := len(m.Redistributed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redistributed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -167,6 +399,44 @@ func (m *EventUpdateMinfeeParams) Size() (n int) {
 	return n
 }
 
+func (m *EventNetworkMinGasPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NetworkMinGasPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.SquareUtilization.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventFeeSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if len(m.Redistributed) > 0 {
+		for _, e := range m.Redistributed {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -288,6 +558,274 @@ func (m *EventUpdateMinfeeParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventNetworkMinGasPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNetworkMinGasPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNetworkMinGasPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkMinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetworkMinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SquareUtilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SquareUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFeeSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFeeSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFeeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redistributed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redistributed = append(m.Redistributed, types.Coin{})
+			if err := m.Redistributed[len(m.Redistributed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
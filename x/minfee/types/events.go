@@ -1,5 +1,10 @@
 package types
 
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // NewUpdateMinfeeParamsEvent returns a new EventUpdateMinfeeParams
 func NewUpdateMinfeeParamsEvent(authority string, params Params) *EventUpdateMinfeeParams {
 	return &EventUpdateMinfeeParams{
@@ -7,3 +12,20 @@ func NewUpdateMinfeeParamsEvent(authority string, params Params) *EventUpdateMin
 		Params: params,
 	}
 }
+
+// NewNetworkMinGasPriceEvent returns a new EventNetworkMinGasPrice
+func NewNetworkMinGasPriceEvent(networkMinGasPrice, squareUtilization math.LegacyDec) *EventNetworkMinGasPrice {
+	return &EventNetworkMinGasPrice{
+		NetworkMinGasPrice: networkMinGasPrice,
+		SquareUtilization:  squareUtilization,
+	}
+}
+
+// NewFeeSplitEvent returns a new EventFeeSplit
+func NewFeeSplitEvent(burned, redistributed sdk.Coins, recipient string) *EventFeeSplit {
+	return &EventFeeSplit{
+		Burned:        burned,
+		Redistributed: redistributed,
+		Recipient:     recipient,
+	}
+}
@@ -9,4 +9,20 @@ const (
 
 	// ParamsKey defines the key used for storing module parameters
 	ParamsKey = "params"
+
+	// NetworkMinGasPriceKey defines the key used for storing the current
+	// dynamic network min gas price.
+	NetworkMinGasPriceKey = "network_min_gas_price"
+
+	// SquareUtilizationKey defines the key used for storing the utilization of
+	// the square of the block being finalized.
+	SquareUtilizationKey = "square_utilization"
+
+	// BurnedFeesKeyPrefix defines the prefix of the keys used for storing the
+	// cumulative fees burned by the fee split, per denom.
+	BurnedFeesKeyPrefix = "burned_fees/"
+
+	// RedistributedFeesKeyPrefix defines the prefix of the keys used for
+	// storing the cumulative fees sent to fee split recipients, per denom.
+	RedistributedFeesKeyPrefix = "redistributed_fees/"
 )
@@ -5,33 +5,140 @@ import (
 
 	"cosmossdk.io/math"
 	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 var DefaultNetworkMinGasPrice math.LegacyDec
 
+var (
+	// DefaultMaxNetworkMinGasPrice is the default upper bound of the dynamic
+	// network min gas price.
+	DefaultMaxNetworkMinGasPrice math.LegacyDec
+	// DefaultTargetSquareUtilization is the default fraction of the max
+	// effective square that the dynamic network min gas price aims for.
+	DefaultTargetSquareUtilization = math.LegacyNewDecWithPrec(5, 1)
+	// DefaultMaxChangeRate is the default maximum fraction by which the
+	// dynamic network min gas price can change per block.
+	DefaultMaxChangeRate = math.LegacyNewDecWithPrec(125, 3)
+)
+
 func init() {
 	DefaultNetworkMinGasPriceDec, err := math.LegacyNewDecFromStr(fmt.Sprintf("%f", appconsts.DefaultNetworkMinGasPrice))
 	if err != nil {
 		panic(err)
 	}
 	DefaultNetworkMinGasPrice = DefaultNetworkMinGasPriceDec
+	DefaultMaxNetworkMinGasPrice = DefaultNetworkMinGasPriceDec.MulInt64(1000)
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := p.validateFeeSplit(); err != nil {
+		return err
+	}
+	if !p.DynamicMinGasPriceEnabled {
+		return nil
+	}
+
+	// the dynamic price is adjusted multiplicatively so it must not be zero.
+	if p.NetworkMinGasPrice.IsNil() || !p.NetworkMinGasPrice.IsPositive() {
+		return fmt.Errorf("network min gas price must be positive: %s", p.NetworkMinGasPrice)
+	}
+	if p.MaxNetworkMinGasPrice.IsNil() || p.MaxNetworkMinGasPrice.LT(p.NetworkMinGasPrice) {
+		return fmt.Errorf("max network min gas price %s must not be lower than the network min gas price %s", p.MaxNetworkMinGasPrice, p.NetworkMinGasPrice)
+	}
+	if p.TargetSquareUtilization.IsNil() || !p.TargetSquareUtilization.IsPositive() || p.TargetSquareUtilization.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("target square utilization must be in (0, 1]: %s", p.TargetSquareUtilization)
+	}
+	if p.MaxChangeRate.IsNil() || !p.MaxChangeRate.IsPositive() || p.MaxChangeRate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max change rate must be in (0, 1]: %s", p.MaxChangeRate)
+	}
 	return nil
 }
 
 // DefaultParams returns the default parameters for the module.
 func DefaultParams() Params {
-	return Params{
-		NetworkMinGasPrice: DefaultNetworkMinGasPrice,
-	}
+	return NewParams(DefaultNetworkMinGasPrice)
 }
 
-// NewParams creates a new instance of Params with the provided NetworkMinGasPrice.
+// NewParams creates a new instance of Params with the provided
+// NetworkMinGasPrice. The dynamic network min gas price is disabled.
 func NewParams(networkMinGasPrice math.LegacyDec) Params {
 	return Params{
-		NetworkMinGasPrice: networkMinGasPrice,
+		NetworkMinGasPrice:        networkMinGasPrice,
+		DynamicMinGasPriceEnabled: false,
+		MaxNetworkMinGasPrice:     DefaultMaxNetworkMinGasPrice,
+		TargetSquareUtilization:   DefaultTargetSquareUtilization,
+		MaxChangeRate:             DefaultMaxChangeRate,
+		FeeSplitFraction:          math.LegacyZeroDec(),
+	}
+}
+
+// validateFeeSplit validates the fee split params. An unset fraction disables
+// the fee split.
+func (p Params) validateFeeSplit() error {
+	if !p.FeeSplitFraction.IsNil() && (p.FeeSplitFraction.IsNegative() || p.FeeSplitFraction.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("fee split fraction must be in [0, 1]: %s", p.FeeSplitFraction)
+	}
+	if p.FeeSplitRecipient != "" {
+		recipient, err := sdk.AccAddressFromBech32(p.FeeSplitRecipient)
+		if err != nil {
+			return fmt.Errorf("invalid fee split recipient: %w", err)
+		}
+		// the split is taken from the fee collector and burned via this
+		// module's account so neither can receive it. Other module accounts
+		// are rejected by the keeper, which can look up the accounts.
+		for _, name := range []string{authtypes.FeeCollectorName, ModuleName} {
+			if recipient.Equals(authtypes.NewModuleAddress(name)) {
+				return fmt.Errorf("fee split recipient %s is the %s module account", p.FeeSplitRecipient, name)
+			}
+		}
+	}
+	return nil
+}
+
+// FeeSplit returns the part of the fee that is burned or sent to the fee split
+// recipient. It is empty if the fee split is disabled or does not apply to a
+// transaction that does or does not contain a MsgPayForBlobs according to
+// isPFB.
+func (p Params) FeeSplit(fee sdk.Coins, isPFB bool) sdk.Coins {
+	if p.FeeSplitFraction.IsNil() || !p.FeeSplitFraction.IsPositive() || (p.FeeSplitPfbOnly && !isPFB) {
+		return sdk.NewCoins()
+	}
+	split := sdk.NewCoins()
+	for _, coin := range fee {
+		amount := p.FeeSplitFraction.MulInt(coin.Amount).TruncateInt()
+		split = split.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	return split
+}
+
+// NextNetworkMinGasPrice returns the network min gas price that follows the
+// current one given the utilization of the last square, i.e. the fraction of
+// the max effective square that it occupied. The relative change is
+// proportional to the distance between the utilization and the target, capped
+// at MaxChangeRate, and the result is bounded by NetworkMinGasPrice and
+// MaxNetworkMinGasPrice.
+func (p Params) NextNetworkMinGasPrice(current, utilization math.LegacyDec) math.LegacyDec {
+	change := p.MaxChangeRate.Mul(utilization.Sub(p.TargetSquareUtilization)).Quo(p.TargetSquareUtilization)
+	if change.GT(p.MaxChangeRate) {
+		change = p.MaxChangeRate
+	}
+	if change.LT(p.MaxChangeRate.Neg()) {
+		change = p.MaxChangeRate.Neg()
+	}
+	return p.BoundNetworkMinGasPrice(current.Add(current.Mul(change)))
+}
+
+// BoundNetworkMinGasPrice returns the price bounded by NetworkMinGasPrice and
+// MaxNetworkMinGasPrice.
+func (p Params) BoundNetworkMinGasPrice(price math.LegacyDec) math.LegacyDec {
+	if price.LT(p.NetworkMinGasPrice) {
+		return p.NetworkMinGasPrice
+	}
+	if price.GT(p.MaxNetworkMinGasPrice) {
+		return p.MaxNetworkMinGasPrice
 	}
+	return price
 }
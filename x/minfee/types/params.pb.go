@@ -28,6 +28,31 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params defines the parameters for the module.
 type Params struct {
 	NetworkMinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=network_min_gas_price,json=networkMinGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"network_min_gas_price"`
+	// dynamic_min_gas_price_enabled enables adjusting the network min gas price
+	// every block based on how full the square was compared to the max
+	// effective square size. network_min_gas_price is then the lower bound of
+	// the network min gas price.
+	DynamicMinGasPriceEnabled bool `protobuf:"varint,2,opt,name=dynamic_min_gas_price_enabled,json=dynamicMinGasPriceEnabled,proto3" json:"dynamic_min_gas_price_enabled,omitempty"`
+	// max_network_min_gas_price is the upper bound of the dynamic network min
+	// gas price.
+	MaxNetworkMinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_network_min_gas_price,json=maxNetworkMinGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_network_min_gas_price"`
+	// target_square_utilization is the fraction of the max effective square
+	// that the dynamic network min gas price aims for. The price increases when
+	// a square is fuller and decreases when it is emptier.
+	TargetSquareUtilization cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=target_square_utilization,json=targetSquareUtilization,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"target_square_utilization"`
+	// max_change_rate is the maximum fraction by which the dynamic network min
+	// gas price can change from one block to the next.
+	MaxChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_change_rate"`
+	// fee_split_fraction is the fraction of transaction fees that is taken
+	// from the fee collector after the fees are deducted. It is burned unless
+	// fee_split_recipient is set.
+	FeeSplitFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=fee_split_fraction,json=feeSplitFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_split_fraction"`
+	// fee_split_recipient is the bech32 encoded address, e.g. a community
+	// address, that receives the fee split instead of it being burned.
+	FeeSplitRecipient string `protobuf:"bytes,7,opt,name=fee_split_recipient,json=feeSplitRecipient,proto3" json:"fee_split_recipient,omitempty"`
+	// fee_split_pfb_only restricts the fee split to the fees of transactions
+	// that contain a MsgPayForBlobs.
+	FeeSplitPfbOnly bool `protobuf:"varint,8,opt,name=fee_split_pfb_only,json=feeSplitPfbOnly,proto3" json:"fee_split_pfb_only,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -63,6 +88,27 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetDynamicMinGasPriceEnabled() bool {
+	if m != nil {
+		return m.DynamicMinGasPriceEnabled
+	}
+	return false
+}
+
+func (m *Params) GetFeeSplitRecipient() string {
+	if m != nil {
+		return m.FeeSplitRecipient
+	}
+	return ""
+}
+
+func (m *Params) GetFeeSplitPfbOnly() bool {
+	if m != nil {
+		return m.FeeSplitPfbOnly
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "celestia.minfee.v1.Params")
 }
@@ -70,23 +116,36 @@ func init() {
 func init() { proto.RegisterFile("celestia/minfee/v1/params.proto", fileDescriptor_821eedeb4e2f93bf) }
 
 var fileDescriptor_821eedeb4e2f93bf = []byte{
-	// 253 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0xcf, 0xcd, 0xcc, 0x4b, 0x4b, 0x4d, 0xd5, 0x2f, 0x33, 0xd4, 0x2f,
-	0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0x29, 0xd0,
-	0x83, 0x28, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83, 0x58,
-	0x10, 0x95, 0x52, 0x92, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xf1, 0x10, 0x09, 0x08, 0x07, 0x22,
-	0xa5, 0x94, 0xc7, 0xc5, 0x16, 0x00, 0x36, 0x54, 0x28, 0x85, 0x4b, 0x34, 0x2f, 0xb5, 0xa4, 0x3c,
-	0xbf, 0x28, 0x3b, 0x3e, 0x37, 0x33, 0x2f, 0x3e, 0x3d, 0x11, 0xa4, 0x3e, 0x33, 0x39, 0x55, 0x82,
-	0x51, 0x81, 0x51, 0x83, 0xd3, 0xc9, 0xf0, 0xc4, 0x3d, 0x79, 0x86, 0x5b, 0xf7, 0xe4, 0xa5, 0x21,
-	0xda, 0x8b, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x73, 0x13, 0x4b, 0x32, 0xf4, 0x7c, 0x52, 0xd3,
-	0x13, 0x93, 0x2b, 0x5d, 0x52, 0x93, 0x2f, 0x6d, 0xd1, 0xe5, 0x82, 0x9a, 0xee, 0x92, 0x9a, 0x1c,
-	0x24, 0x04, 0x35, 0xcf, 0x37, 0x33, 0xcf, 0x3d, 0xb1, 0x38, 0x00, 0x64, 0x98, 0x93, 0xcf, 0x89,
-	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x19, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26,
-	0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xc3, 0x7c, 0x96, 0x5f, 0x94, 0x0e, 0x67, 0xeb, 0x26, 0x16, 0x14,
-	0xe8, 0x57, 0xc0, 0x02, 0xa3, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x09, 0x63, 0x40,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x58, 0x21, 0xaa, 0xfb, 0x2c, 0x01, 0x00, 0x00,
+	// 463 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
+	0x14, 0x85, 0x63, 0xa0, 0xa1, 0x8c, 0x84, 0x0a, 0x43, 0x2b, 0x9c, 0x22, 0x9c, 0x8a, 0x55, 0x25,
+	0x14, 0x5b, 0x81, 0x17, 0x80, 0x52, 0x7e, 0x16, 0x05, 0xa2, 0x44, 0x2c, 0x60, 0x33, 0xba, 0x19,
+	0x5f, 0x3b, 0xa3, 0x78, 0x66, 0xcc, 0xcc, 0xa4, 0x24, 0x3c, 0x05, 0x0f, 0xd3, 0x3d, 0xdb, 0x2e,
+	0xab, 0xae, 0x10, 0x8b, 0x0a, 0x25, 0x2f, 0x82, 0xfc, 0x47, 0x8b, 0x60, 0x95, 0xdd, 0x58, 0xf7,
+	0x9c, 0xef, 0x9c, 0x2b, 0xf9, 0x92, 0x2e, 0xc7, 0x0c, 0xad, 0x13, 0x10, 0x49, 0xa1, 0x12, 0xc4,
+	0xe8, 0xb8, 0x1f, 0xe5, 0x60, 0x40, 0xda, 0x30, 0x37, 0xda, 0x69, 0x4a, 0x1b, 0x41, 0x58, 0x09,
+	0xc2, 0xe3, 0xfe, 0xee, 0x76, 0xaa, 0x53, 0x5d, 0x8e, 0xa3, 0xe2, 0x55, 0x29, 0x77, 0x3b, 0x5c,
+	0x5b, 0xa9, 0x2d, 0xab, 0x06, 0xd5, 0x47, 0x35, 0x7a, 0xf4, 0x7d, 0x83, 0xb4, 0x07, 0x25, 0x95,
+	0xc6, 0x64, 0x47, 0xa1, 0xfb, 0xa2, 0xcd, 0x94, 0x49, 0xa1, 0x58, 0x0a, 0x85, 0x41, 0x70, 0xf4,
+	0xbd, 0x3d, 0x6f, 0xff, 0xd6, 0x41, 0xff, 0xf4, 0xa2, 0xdb, 0xfa, 0x79, 0xd1, 0x7d, 0x50, 0xf9,
+	0x6d, 0x3c, 0x0d, 0x85, 0x8e, 0x24, 0xb8, 0x49, 0x78, 0x84, 0x29, 0xf0, 0xc5, 0x21, 0xf2, 0xf3,
+	0x93, 0x1e, 0xa9, 0xf1, 0x87, 0xc8, 0x87, 0xb4, 0xe6, 0xbd, 0x15, 0xea, 0x35, 0xd8, 0x41, 0x01,
+	0xa3, 0xcf, 0xc8, 0xc3, 0x78, 0xa1, 0x40, 0x0a, 0xfe, 0x77, 0x0a, 0x43, 0x05, 0xe3, 0x0c, 0x63,
+	0xff, 0xda, 0x9e, 0xb7, 0xbf, 0x39, 0xec, 0xd4, 0xa2, 0x2b, 0xd6, 0x97, 0x95, 0x80, 0x4e, 0x49,
+	0x47, 0xc2, 0x9c, 0xfd, 0xbf, 0xeb, 0xf5, 0x75, 0xbb, 0xee, 0x48, 0x98, 0xbf, 0xfb, 0xb7, 0xae,
+	0x24, 0x1d, 0x07, 0x26, 0x45, 0xc7, 0xec, 0xe7, 0x19, 0x18, 0x64, 0x33, 0x27, 0x32, 0xf1, 0x15,
+	0x9c, 0xd0, 0xca, 0xbf, 0xb1, 0x6e, 0xd8, 0xfd, 0x8a, 0x39, 0x2a, 0x91, 0x1f, 0x2e, 0x89, 0xf4,
+	0x23, 0xd9, 0x2a, 0x76, 0xe3, 0x13, 0x50, 0x29, 0x32, 0x03, 0x0e, 0xfd, 0x8d, 0x75, 0x43, 0x6e,
+	0x4b, 0x98, 0xbf, 0x28, 0x41, 0x43, 0x70, 0x48, 0x19, 0xa1, 0x09, 0x22, 0xb3, 0x79, 0x26, 0x1c,
+	0x4b, 0x0c, 0xf0, 0x72, 0x85, 0xf6, 0xba, 0xf4, 0x3b, 0x09, 0xe2, 0xa8, 0x60, 0xbd, 0xaa, 0x51,
+	0xf4, 0x0d, 0xb9, 0x77, 0x19, 0x60, 0x90, 0x8b, 0x5c, 0xa0, 0x72, 0xfe, 0xcd, 0x32, 0xc1, 0x3f,
+	0x3f, 0xe9, 0x6d, 0xd7, 0xf6, 0xe7, 0x71, 0x6c, 0xd0, 0xda, 0x91, 0x33, 0x42, 0xa5, 0xc3, 0xbb,
+	0x0d, 0x68, 0xd8, 0x58, 0xe8, 0xe3, 0xab, 0x55, 0xf3, 0x64, 0xcc, 0xb4, 0xca, 0x16, 0xfe, 0x66,
+	0xf9, 0x63, 0x6c, 0x35, 0xf2, 0x41, 0x32, 0x7e, 0xaf, 0xb2, 0xc5, 0xc1, 0xd1, 0xe9, 0x32, 0xf0,
+	0xce, 0x96, 0x81, 0xf7, 0x6b, 0x19, 0x78, 0xdf, 0x56, 0x41, 0xeb, 0x6c, 0x15, 0xb4, 0x7e, 0xac,
+	0x82, 0xd6, 0xa7, 0x27, 0xa9, 0x70, 0x93, 0xd9, 0x38, 0xe4, 0x5a, 0x46, 0xcd, 0xad, 0x68, 0x93,
+	0xfe, 0x79, 0xf7, 0x20, 0xcf, 0xa3, 0x79, 0x73, 0x5e, 0x6e, 0x91, 0xa3, 0x1d, 0xb7, 0xcb, 0xb3,
+	0x78, 0xfa, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xc5, 0xda, 0xea, 0xbb, 0x7e, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -109,6 +168,73 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FeeSplitPfbOnly {
+		i--
+		if m.FeeSplitPfbOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.FeeSplitRecipient) > 0 {
+		i -= len(m.FeeSplitRecipient)
+		copy(dAtA[i:], m.FeeSplitRecipient)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.FeeSplitRecipient)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.FeeSplitFraction.Size()
+		i -= size
+		if _, err := m.FeeSplitFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TargetSquareUtilization.Size()
+		i -= size
+		if _, err := m.TargetSquareUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxNetworkMinGasPrice.Size()
+		i -= size
+		if _, err := m.MaxNetworkMinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.DynamicMinGasPriceEnabled {
+		i--
+		if m.DynamicMinGasPriceEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size := m.NetworkMinGasPrice.Size()
 		i -= size
@@ -141,6 +267,24 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.NetworkMinGasPrice.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.DynamicMinGasPriceEnabled {
+		n += 2
+	}
+	l = m.MaxNetworkMinGasPrice.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TargetSquareUtilization.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.FeeSplitFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.FeeSplitRecipient)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.FeeSplitPfbOnly {
+		n += 2
+	}
 	return n
 }
 
@@ -213,6 +357,214 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicMinGasPriceEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DynamicMinGasPriceEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNetworkMinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxNetworkMinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSquareUtilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetSquareUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplitFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeSplitFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplitRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSplitRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplitPfbOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FeeSplitPfbOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParamsValidate(t *testing.T) {
+	enabled := func(modify func(p *Params)) Params {
+		p := DefaultParams()
+		p.DynamicMinGasPriceEnabled = true
+		modify(&p)
+		return p
+	}
+
+	tests := []struct {
+		name      string
+		params    Params
+		expectErr bool
+	}{
+		{name: "default", params: DefaultParams()},
+		{name: "disabled with unset dynamic params", params: Params{NetworkMinGasPrice: DefaultNetworkMinGasPrice}},
+		{name: "enabled", params: enabled(func(*Params) {})},
+		{name: "zero min gas price", params: enabled(func(p *Params) { p.NetworkMinGasPrice = math.LegacyZeroDec() }), expectErr: true},
+		{name: "max below min", params: enabled(func(p *Params) { p.MaxNetworkMinGasPrice = p.NetworkMinGasPrice.QuoInt64(2) }), expectErr: true},
+		{name: "zero target", params: enabled(func(p *Params) { p.TargetSquareUtilization = math.LegacyZeroDec() }), expectErr: true},
+		{name: "target above one", params: enabled(func(p *Params) { p.TargetSquareUtilization = math.LegacyNewDec(2) }), expectErr: true},
+		{name: "zero change rate", params: enabled(func(p *Params) { p.MaxChangeRate = math.LegacyZeroDec() }), expectErr: true},
+		{name: "unset change rate", params: enabled(func(p *Params) { p.MaxChangeRate = math.LegacyDec{} }), expectErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNextNetworkMinGasPrice(t *testing.T) {
+	params := Params{
+		NetworkMinGasPrice:        math.LegacyMustNewDecFromStr("1"),
+		DynamicMinGasPriceEnabled: true,
+		MaxNetworkMinGasPrice:     math.LegacyMustNewDecFromStr("2"),
+		TargetSquareUtilization:   math.LegacyMustNewDecFromStr("0.25"),
+		MaxChangeRate:             math.LegacyMustNewDecFromStr("0.1"),
+	}
+
+	tests := []struct {
+		name        string
+		current     string
+		utilization string
+		want        string
+	}{
+		{name: "at target", current: "1.5", utilization: "0.25", want: "1.5"},
+		{name: "above target", current: "1.5", utilization: "0.375", want: "1.575"},
+		{name: "full square is capped at the max change rate", current: "1.5", utilization: "1", want: "1.65"},
+		{name: "empty square", current: "1.5", utilization: "0", want: "1.35"},
+		{name: "bounded by the min", current: "1.05", utilization: "0", want: "1"},
+		{name: "bounded by the max", current: "1.95", utilization: "1", want: "2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := params.NextNetworkMinGasPrice(math.LegacyMustNewDecFromStr(tt.current), math.LegacyMustNewDecFromStr(tt.utilization))
+			assert.Equal(t, math.LegacyMustNewDecFromStr(tt.want).String(), got.String())
+		})
+	}
+}
+
+func TestFeeSplitParamsValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		modify    func(p *Params)
+		expectErr bool
+	}{
+		{name: "unset fraction", modify: func(p *Params) { p.FeeSplitFraction = math.LegacyDec{} }},
+		{name: "full fraction", modify: func(p *Params) { p.FeeSplitFraction = math.LegacyOneDec() }},
+		{name: "negative fraction", modify: func(p *Params) { p.FeeSplitFraction = math.LegacyNewDec(-1) }, expectErr: true},
+		{name: "fraction above one", modify: func(p *Params) { p.FeeSplitFraction = math.LegacyNewDec(2) }, expectErr: true},
+		{name: "invalid recipient", modify: func(p *Params) { p.FeeSplitRecipient = "invalid" }, expectErr: true},
+		{name: "fee collector recipient", modify: func(p *Params) { p.FeeSplitRecipient = authtypes.NewModuleAddress(authtypes.FeeCollectorName).String() }, expectErr: true},
+		{name: "minfee module recipient", modify: func(p *Params) { p.FeeSplitRecipient = authtypes.NewModuleAddress(ModuleName).String() }, expectErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := DefaultParams()
+			tt.modify(&p)
+			err := p.Validate()
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
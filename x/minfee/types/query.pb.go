@@ -8,6 +8,8 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -190,44 +192,150 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryFeeSplitTotalsRequest is the request type for the Query/FeeSplitTotals
+// RPC method.
+type QueryFeeSplitTotalsRequest struct {
+}
+
+func (m *QueryFeeSplitTotalsRequest) Reset()         { *m = QueryFeeSplitTotalsRequest{} }
+func (m *QueryFeeSplitTotalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSplitTotalsRequest) ProtoMessage()    {}
+func (*QueryFeeSplitTotalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c41d9a8b7bf8984, []int{4}
+}
+func (m *QueryFeeSplitTotalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSplitTotalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSplitTotalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeSplitTotalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSplitTotalsRequest.Merge(m, src)
+}
+func (m *QueryFeeSplitTotalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSplitTotalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSplitTotalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeSplitTotalsRequest proto.InternalMessageInfo
+
+// QueryFeeSplitTotalsResponse is the response type for the
+// Query/FeeSplitTotals RPC method.
+type QueryFeeSplitTotalsResponse struct {
+	// burned are the cumulative fees that were burned.
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+	// redistributed are the cumulative fees that were sent to fee split
+	// recipients.
+	Redistributed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=redistributed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"redistributed"`
+}
+
+func (m *QueryFeeSplitTotalsResponse) Reset()         { *m = QueryFeeSplitTotalsResponse{} }
+func (m *QueryFeeSplitTotalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSplitTotalsResponse) ProtoMessage()    {}
+func (*QueryFeeSplitTotalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c41d9a8b7bf8984, []int{5}
+}
+func (m *QueryFeeSplitTotalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSplitTotalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSplitTotalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeSplitTotalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSplitTotalsResponse.Merge(m, src)
+}
+func (m *QueryFeeSplitTotalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSplitTotalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSplitTotalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeSplitTotalsResponse proto.InternalMessageInfo
+
+func (m *QueryFeeSplitTotalsResponse) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func (m *QueryFeeSplitTotalsResponse) GetRedistributed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Redistributed
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryNetworkMinGasPrice)(nil), "celestia.minfee.v1.QueryNetworkMinGasPrice")
 	proto.RegisterType((*QueryNetworkMinGasPriceResponse)(nil), "celestia.minfee.v1.QueryNetworkMinGasPriceResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "celestia.minfee.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.minfee.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryFeeSplitTotalsRequest)(nil), "celestia.minfee.v1.QueryFeeSplitTotalsRequest")
+	proto.RegisterType((*QueryFeeSplitTotalsResponse)(nil), "celestia.minfee.v1.QueryFeeSplitTotalsResponse")
 }
 
 func init() { proto.RegisterFile("celestia/minfee/v1/query.proto", fileDescriptor_4c41d9a8b7bf8984) }
 
 var fileDescriptor_4c41d9a8b7bf8984 = []byte{
-	// 421 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x8e, 0xd3, 0x30,
-	0x10, 0xc6, 0x93, 0x15, 0x54, 0xc2, 0x9c, 0xf0, 0x2e, 0x82, 0x06, 0x94, 0x40, 0x90, 0xf8, 0x23,
-	0xb4, 0xb6, 0xda, 0xbd, 0x70, 0xae, 0x56, 0xe2, 0xb2, 0xc0, 0x92, 0x23, 0x97, 0xca, 0xeb, 0x1d,
-	0xbc, 0x56, 0x1b, 0x3b, 0x8d, 0xdd, 0x42, 0xaf, 0x5c, 0xb8, 0x22, 0xf1, 0x04, 0x7d, 0x07, 0x1e,
-	0xa2, 0xc7, 0x0a, 0x2e, 0x88, 0x43, 0x85, 0x5a, 0x1e, 0x04, 0x25, 0x4e, 0x2a, 0x55, 0x69, 0x85,
-	0xb8, 0x4d, 0xf2, 0xcd, 0x37, 0xf3, 0xf3, 0xa7, 0x41, 0x21, 0x87, 0x21, 0x18, 0x2b, 0x19, 0x4d,
-	0xa5, 0x7a, 0x0f, 0x40, 0x27, 0x1d, 0x3a, 0x1a, 0x43, 0x3e, 0x25, 0x59, 0xae, 0xad, 0xc6, 0xb8,
-	0xd6, 0x89, 0xd3, 0xc9, 0xa4, 0x13, 0x44, 0x3b, 0x3c, 0x19, 0xcb, 0x59, 0x6a, 0x9c, 0x29, 0x38,
-	0x12, 0x5a, 0xe8, 0xb2, 0xa4, 0x45, 0x55, 0xfd, 0xbd, 0x2f, 0xb4, 0x16, 0x43, 0xa0, 0x2c, 0x93,
-	0x94, 0x29, 0xa5, 0x2d, 0xb3, 0x52, 0xab, 0xda, 0xd3, 0xe6, 0xda, 0xa4, 0xda, 0xf4, 0x9d, 0xcd,
-	0x7d, 0x38, 0x29, 0x6e, 0xa3, 0x3b, 0x6f, 0x0b, 0xa4, 0xd7, 0x60, 0x3f, 0xe8, 0x7c, 0xf0, 0x4a,
-	0xaa, 0x97, 0xcc, 0x9c, 0xe7, 0x92, 0x43, 0xfc, 0xd9, 0x47, 0xd1, 0x1e, 0x2d, 0x01, 0x93, 0x69,
-	0x65, 0x00, 0x5f, 0xa2, 0xdb, 0xca, 0xa9, 0xfd, 0x54, 0xaa, 0xbe, 0x60, 0xc5, 0x12, 0xc9, 0xe1,
-	0xae, 0xff, 0xc0, 0x7f, 0x7a, 0xa3, 0xd7, 0x99, 0x2f, 0x23, 0xef, 0xd7, 0x32, 0xba, 0xe7, 0x76,
-	0x9a, 0xcb, 0x01, 0x91, 0x9a, 0xa6, 0xcc, 0x5e, 0x91, 0x33, 0x10, 0x8c, 0x4f, 0x4f, 0x81, 0x7f,
-	0xff, 0x76, 0x8c, 0x2a, 0xa4, 0x53, 0xe0, 0x09, 0x56, 0x4d, 0x92, 0x23, 0x84, 0x4b, 0x90, 0xf3,
-	0x32, 0x88, 0x04, 0x46, 0x63, 0x30, 0x36, 0x7e, 0x83, 0x0e, 0xb7, 0xfe, 0x56, 0x48, 0x2f, 0x50,
-	0xcb, 0x05, 0x56, 0x32, 0xdc, 0xec, 0x06, 0xa4, 0x19, 0x33, 0x71, 0x9e, 0xde, 0xb5, 0x82, 0x2f,
-	0xa9, 0xfa, 0xbb, 0xb3, 0x03, 0x74, 0xbd, 0x9c, 0x88, 0x67, 0x3e, 0xc2, 0xcd, 0x57, 0xe3, 0xe7,
-	0xbb, 0x46, 0xed, 0x89, 0x28, 0x38, 0xf9, 0x8f, 0xe6, 0x1a, 0x3e, 0x7e, 0xf6, 0xe9, 0xc7, 0x9f,
-	0xaf, 0x07, 0x8f, 0xf0, 0x43, 0xba, 0xe3, 0x0e, 0xb6, 0x12, 0xc6, 0x16, 0xb5, 0xdc, 0x2b, 0xf0,
-	0xe3, 0xbd, 0x9b, 0xb6, 0x02, 0x0b, 0x9e, 0xfc, 0xb3, 0xaf, 0xa2, 0x68, 0x97, 0x14, 0x87, 0xf8,
-	0x56, 0xe3, 0x08, 0x7b, 0x67, 0xf3, 0x55, 0xe8, 0x2f, 0x56, 0xa1, 0xff, 0x7b, 0x15, 0xfa, 0x5f,
-	0xd6, 0xa1, 0xb7, 0x58, 0x87, 0xde, 0xcf, 0x75, 0xe8, 0xbd, 0xeb, 0x0a, 0x69, 0xaf, 0xc6, 0x17,
-	0x84, 0xeb, 0x74, 0x03, 0xaf, 0x73, 0xb1, 0xa9, 0x8f, 0x59, 0x96, 0xd1, 0x8f, 0xf5, 0x44, 0x3b,
-	0xcd, 0xc0, 0x5c, 0xb4, 0xca, 0x23, 0x3c, 0xf9, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x89, 0x37, 0xfc,
-	0x9c, 0x2a, 0x03, 0x00, 0x00,
+	// 575 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x31, 0x6f, 0x13, 0x31,
+	0x18, 0xcd, 0xb5, 0x10, 0x09, 0x57, 0x20, 0xe1, 0x16, 0xd1, 0x5c, 0xab, 0x4b, 0x09, 0xa8, 0x14,
+	0x41, 0x6c, 0x92, 0x2e, 0xcc, 0xa1, 0x82, 0xa5, 0x40, 0x09, 0x4c, 0x2c, 0x91, 0xef, 0xee, 0xeb,
+	0xd5, 0x4a, 0xce, 0xbe, 0x9c, 0x9d, 0x42, 0x56, 0x16, 0x56, 0x24, 0x7e, 0x00, 0x62, 0x65, 0xe6,
+	0x1f, 0xb0, 0x74, 0xac, 0x60, 0x41, 0x0c, 0x05, 0x25, 0xfc, 0x05, 0x76, 0x74, 0x3e, 0xa7, 0x22,
+	0xca, 0x45, 0x80, 0xc4, 0x14, 0x9f, 0xbf, 0xef, 0x7d, 0xef, 0xf9, 0xf9, 0x39, 0xc8, 0x0b, 0xa0,
+	0x07, 0x4a, 0x73, 0x46, 0x63, 0x2e, 0xf6, 0x01, 0xe8, 0x61, 0x83, 0xf6, 0x07, 0x90, 0x0e, 0x49,
+	0x92, 0x4a, 0x2d, 0x31, 0x9e, 0xd4, 0x49, 0x5e, 0x27, 0x87, 0x0d, 0xb7, 0x5a, 0x80, 0x49, 0x58,
+	0xca, 0x62, 0x95, 0x83, 0xdc, 0x95, 0x48, 0x46, 0xd2, 0x2c, 0x69, 0xb6, 0xb2, 0xbb, 0xeb, 0x91,
+	0x94, 0x51, 0x0f, 0x28, 0x4b, 0x38, 0x65, 0x42, 0x48, 0xcd, 0x34, 0x97, 0x62, 0x82, 0xa9, 0x04,
+	0x52, 0xc5, 0x52, 0x75, 0x72, 0x58, 0xfe, 0x61, 0x4b, 0x5e, 0xfe, 0x45, 0x7d, 0xa6, 0x32, 0x2e,
+	0x1f, 0x34, 0x6b, 0xd0, 0x40, 0x72, 0x91, 0xd7, 0x6b, 0x15, 0x74, 0xf9, 0x71, 0x26, 0xf9, 0x21,
+	0xe8, 0xe7, 0x32, 0xed, 0x3e, 0xe0, 0xe2, 0x3e, 0x53, 0x7b, 0x29, 0x0f, 0xa0, 0xf6, 0xca, 0x41,
+	0xd5, 0x39, 0xb5, 0x36, 0xa8, 0x44, 0x0a, 0x05, 0x38, 0x44, 0x97, 0x44, 0x5e, 0xed, 0xc4, 0x5c,
+	0x74, 0x22, 0x96, 0x89, 0xe0, 0x01, 0xac, 0x3a, 0x1b, 0xce, 0xd6, 0xb9, 0x56, 0xe3, 0xe8, 0xa4,
+	0x5a, 0xfa, 0x7a, 0x52, 0x5d, 0xcb, 0x55, 0xa8, 0xb0, 0x4b, 0xb8, 0xa4, 0x31, 0xd3, 0x07, 0x64,
+	0x17, 0x22, 0x16, 0x0c, 0x77, 0x20, 0xf8, 0xf4, 0xa1, 0x8e, 0xac, 0xe4, 0x1d, 0x08, 0xda, 0x58,
+	0xcc, 0x2a, 0x59, 0x41, 0xd8, 0x08, 0xd9, 0x33, 0x46, 0xb5, 0xa1, 0x3f, 0x00, 0xa5, 0x6b, 0x8f,
+	0xd0, 0xf2, 0xd4, 0xae, 0x95, 0x74, 0x07, 0x95, 0x73, 0x43, 0x8d, 0x86, 0xa5, 0xa6, 0x4b, 0x66,
+	0xaf, 0x81, 0xe4, 0x98, 0xd6, 0x99, 0x4c, 0x5f, 0xdb, 0xf6, 0xd7, 0xd6, 0x91, 0x6b, 0x06, 0xde,
+	0x03, 0x78, 0x92, 0xf4, 0xb8, 0x7e, 0x2a, 0x35, 0xeb, 0x9d, 0xd2, 0xfd, 0x74, 0xd0, 0x5a, 0x61,
+	0xd9, 0xf2, 0x06, 0xa8, 0xec, 0x0f, 0x52, 0x01, 0xe1, 0xaa, 0xb3, 0xb1, 0xb8, 0xb5, 0xd4, 0xac,
+	0x10, 0x7b, 0xaa, 0xcc, 0x7a, 0x62, 0xad, 0x27, 0x77, 0x25, 0x17, 0xad, 0xdb, 0x19, 0xed, 0xfb,
+	0x6f, 0xd5, 0xad, 0x88, 0xeb, 0x83, 0x81, 0x4f, 0x02, 0x19, 0xdb, 0x5b, 0xb3, 0x3f, 0x75, 0x15,
+	0x76, 0xa9, 0x1e, 0x26, 0xa0, 0x0c, 0x40, 0xb5, 0xed, 0x68, 0xdc, 0x47, 0xe7, 0x53, 0x08, 0xb9,
+	0xd2, 0x29, 0xf7, 0x07, 0x1a, 0xc2, 0xd5, 0x85, 0xff, 0xcf, 0x35, 0xcd, 0xd0, 0xfc, 0xb8, 0x88,
+	0xce, 0x9a, 0x73, 0xe3, 0x77, 0x0e, 0xc2, 0xb3, 0x59, 0xc0, 0x37, 0x8b, 0x0c, 0x9e, 0x13, 0x1c,
+	0x77, 0xfb, 0x1f, 0x9a, 0x27, 0xd6, 0xd6, 0x6e, 0xbc, 0xfc, 0xfc, 0xe3, 0xcd, 0xc2, 0x55, 0x7c,
+	0x85, 0x16, 0xbc, 0x9e, 0xa9, 0xdc, 0x61, 0x8d, 0xca, 0xf9, 0xdd, 0xe2, 0xcd, 0xb9, 0x4c, 0x53,
+	0x31, 0x72, 0xaf, 0xff, 0xb1, 0xcf, 0xaa, 0xa8, 0x18, 0x15, 0xcb, 0xf8, 0xe2, 0xcc, 0xd3, 0xc5,
+	0x6f, 0x1d, 0x74, 0x61, 0x3a, 0x16, 0x98, 0xcc, 0x1d, 0x5b, 0x18, 0x2f, 0x97, 0xfe, 0x75, 0xbf,
+	0x95, 0x73, 0xcb, 0xc8, 0xd9, 0xc4, 0xd7, 0x8a, 0x4c, 0xd9, 0x07, 0xe8, 0xa8, 0x0c, 0xd4, 0xd1,
+	0x06, 0xd5, 0xda, 0x3d, 0x1a, 0x79, 0xce, 0xf1, 0xc8, 0x73, 0xbe, 0x8f, 0x3c, 0xe7, 0xf5, 0xd8,
+	0x2b, 0x1d, 0x8f, 0xbd, 0xd2, 0x97, 0xb1, 0x57, 0x7a, 0xd6, 0xfc, 0x3d, 0x18, 0x76, 0x92, 0x4c,
+	0xa3, 0xd3, 0x75, 0x9d, 0x25, 0x09, 0x7d, 0x31, 0x99, 0x6d, 0x82, 0xe2, 0x97, 0xcd, 0x9f, 0xc7,
+	0xf6, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3a, 0xdf, 0x10, 0x6e, 0x02, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -246,6 +354,9 @@ type QueryClient interface {
 	NetworkMinGasPrice(ctx context.Context, in *QueryNetworkMinGasPrice, opts ...grpc.CallOption) (*QueryNetworkMinGasPriceResponse, error)
 	// Params queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// FeeSplitTotals queries the cumulative fees that were burned or
+	// redistributed by the fee split.
+	FeeSplitTotals(ctx context.Context, in *QueryFeeSplitTotalsRequest, opts ...grpc.CallOption) (*QueryFeeSplitTotalsResponse, error)
 }
 
 type queryClient struct {
@@ -274,12 +385,24 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) FeeSplitTotals(ctx context.Context, in *QueryFeeSplitTotalsRequest, opts ...grpc.CallOption) (*QueryFeeSplitTotalsResponse, error) {
+	out := new(QueryFeeSplitTotalsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.minfee.v1.Query/FeeSplitTotals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// NetworkMinGasPrice queries the network wide minimum gas price.
 	NetworkMinGasPrice(context.Context, *QueryNetworkMinGasPrice) (*QueryNetworkMinGasPriceResponse, error)
 	// Params queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// FeeSplitTotals queries the cumulative fees that were burned or
+	// redistributed by the fee split.
+	FeeSplitTotals(context.Context, *QueryFeeSplitTotalsRequest) (*QueryFeeSplitTotalsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -292,6 +415,9 @@ func (*UnimplementedQueryServer) NetworkMinGasPrice(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) FeeSplitTotals(ctx context.Context, req *QueryFeeSplitTotalsRequest) (*QueryFeeSplitTotalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeSplitTotals not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -333,6 +459,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeeSplitTotals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeSplitTotalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeSplitTotals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.minfee.v1.Query/FeeSplitTotals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeSplitTotals(ctx, req.(*QueryFeeSplitTotalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.minfee.v1.Query",
@@ -346,6 +490,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "FeeSplitTotals",
+			Handler:    _Query_FeeSplitTotals_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/minfee/v1/query.proto",
@@ -463,6 +611,80 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryFeeSplitTotalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSplitTotalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSplitTotalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSplitTotalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSplitTotalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSplitTotalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Redistributed) > 0 {
+		for iNdEx := len(m.Redistributed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redistributed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -514,6 +736,36 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFeeSplitTotalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeSplitTotalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Redistributed) > 0 {
+		for _, e := range m.Redistributed {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -787,6 +1039,174 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFeeSplitTotalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeSplitTotalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeSplitTotalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeSplitTotalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeSplitTotalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeSplitTotalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redistributed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redistributed = append(m.Redistributed, types.Coin{})
+			if err := m.Redistributed[len(m.Redistributed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
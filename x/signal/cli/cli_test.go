@@ -37,6 +37,14 @@ func (s *CLITestSuite) TestCmdQueryTally() {
 	s.Require().Contains(output.String(), "voting_power")
 	s.Require().Contains(output.String(), "threshold_power")
 	s.Require().Contains(output.String(), "total_voting_power")
+	s.Require().Contains(output.String(), "threshold")
+}
+
+func (s *CLITestSuite) TestCmdQueryParams() {
+	cmd := cli.CmdQueryParams()
+	output, err := testutil.ExecTestCLICmd(s.ctx.Context, cmd, []string{})
+	s.Require().NoError(err)
+	s.Require().Contains(output.String(), "0.833333333333333333")
 }
 
 func (s *CLITestSuite) TestCmdGetUpgrade() {
@@ -45,3 +53,18 @@ func (s *CLITestSuite) TestCmdGetUpgrade() {
 	s.Require().NoError(err)
 	s.Require().Contains(output.String(), "No upgrade is pending.")
 }
+
+func (s *CLITestSuite) TestCmdGetUpgradeHistory() {
+	cmd := cli.CmdGetUpgrade()
+	output, err := testutil.ExecTestCLICmd(s.ctx.Context, cmd, []string{"--history", "--output=json"})
+	s.Require().NoError(err)
+	s.Require().Contains(output.String(), "history")
+}
+
+func (s *CLITestSuite) TestCmdQueryValidatorSignals() {
+	cmd := cli.CmdQueryValidatorSignals()
+	output, err := testutil.ExecTestCLICmd(s.ctx.Context, cmd, []string{"2"})
+	s.Require().NoError(err)
+	s.Require().Contains(output.String(), "validator_address")
+	s.Require().Contains(output.String(), "participation")
+}
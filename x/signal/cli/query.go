@@ -10,6 +10,9 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// FlagHistory is the flag to print the upgrade history.
+const FlagHistory = "history"
+
 // GetQueryCmd returns the CLI query commands for this module.
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -22,6 +25,68 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdQueryTally())
 	cmd.AddCommand(CmdGetUpgrade())
+	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryValidatorSignals())
+	return cmd
+}
+
+func CmdQueryValidatorSignals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "validators [version]",
+		Short:   "Query for the version that each bonded validator signals for and the participation for a version",
+		Args:    cobra.MaximumNArgs(1),
+		Example: "validators 5",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var version uint64
+			if len(args) == 1 {
+				version, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.ValidatorSignals(cmd.Context(), &types.QueryValidatorSignalsRequest{Version: version})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query for the signal parameters, e.g. the signalling threshold",
+		Args:    cobra.NoArgs,
+		Example: "params",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&resp.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
 
@@ -74,13 +139,28 @@ func CmdGetUpgrade() *cobra.Command {
 				return err
 			}
 
+			showHistory, err := cmd.Flags().GetBool(FlagHistory)
+			if err != nil {
+				return err
+			}
+			if showHistory {
+				return clientCtx.PrintProto(resp)
+			}
+
 			if resp.Upgrade != nil {
-				return clientCtx.PrintString(fmt.Sprintf("An upgrade is pending to app version %d at height %d.\n", resp.Upgrade.AppVersion, resp.Upgrade.UpgradeHeight))
+				return clientCtx.PrintString(fmt.Sprintf("An upgrade is pending to app version %d at height %d: %s.\n", resp.Upgrade.AppVersion, resp.Upgrade.UpgradeHeight, resp.Upgrade.Reason))
+			}
+			if len(resp.History) > 0 {
+				last := resp.History[len(resp.History)-1]
+				if last.Status == types.UpgradeStatus_UPGRADE_STATUS_CANCELLED {
+					return clientCtx.PrintString(fmt.Sprintf("No upgrade is pending. The upgrade to app version %d was cancelled at height %d: %s.\n", last.Upgrade.AppVersion, last.Height, last.Reason))
+				}
 			}
 			return clientCtx.PrintString("No upgrade is pending.\n")
 		},
 	}
 
+	cmd.Flags().Bool(FlagHistory, false, "Print the pending upgrade and the upgrade history")
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
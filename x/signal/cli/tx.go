@@ -12,6 +12,15 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	// FlagActivationStartHeight is the flag for the start of the activation
+	// window of a signal.
+	FlagActivationStartHeight = "activation-start-height"
+	// FlagActivationEndHeight is the flag for the end of the activation window
+	// of a signal.
+	FlagActivationEndHeight = "activation-end-height"
+)
+
 // GetTxCmd returns the transaction commands for this module.
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -43,13 +52,30 @@ func CmdSignalVersion() *cobra.Command {
 				return err
 			}
 
+			startHeight, err := cmd.Flags().GetInt64(FlagActivationStartHeight)
+			if err != nil {
+				return err
+			}
+			endHeight, err := cmd.Flags().GetInt64(FlagActivationEndHeight)
+			if err != nil {
+				return err
+			}
+
 			addr := clientCtx.GetFromAddress().Bytes()
 			valAddr := sdk.ValAddress(addr)
 			msg := types.NewMsgSignalVersion(valAddr.String(), version)
+			if startHeight != 0 || endHeight != 0 {
+				msg = types.NewMsgSignalVersionWithWindow(valAddr.String(), version, types.ActivationWindow{
+					StartHeight: startHeight,
+					EndHeight:   endHeight,
+				})
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Int64(FlagActivationStartHeight, 0, "The first height at which the version may activate (optional)")
+	cmd.Flags().Int64(FlagActivationEndHeight, 0, "The last height at which the version may activate (optional)")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -5,6 +5,8 @@ import (
 	"context"
 	"encoding/binary"
 	"errors"
+	"fmt"
+	"sort"
 
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
@@ -12,25 +14,29 @@ import (
 	"github.com/celestiaorg/celestia-app/v5/x/signal/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
+// MaxSignalHistory is the number of previous signals that are kept for each
+// validator until the next upgrade.
+const MaxSignalHistory = 10
+
 // Keeper implements the MsgServer and QueryServer interfaces
 var (
 	_ types.MsgServer   = &Keeper{}
 	_ types.QueryServer = Keeper{}
-
-	// defaultSignalThreshold is 5/6 or approximately 83.33%
-	defaultSignalThreshold = math.LegacyNewDec(5).Quo(math.LegacyNewDec(6))
 )
 
 // Threshold is the fraction of voting power that is required
 // to signal for a version change. It is set to 5/6 as the middle point
 // between 2/3 and 3/3 providing 1/6 fault tolerance to halting the
-// network during an upgrade period. It can be modified through a
-// hard fork change that modified the app version
+// network during an upgrade period.
+//
+// Deprecated: the threshold is a param of the module from app version 6
+// onwards. Use Keeper.GetParams and Params.ThresholdFor instead.
 func Threshold(_ uint64) math.LegacyDec {
-	return defaultSignalThreshold
+	return types.DefaultThreshold
 }
 
 type Keeper struct {
@@ -44,6 +50,10 @@ type Keeper struct {
 	// stakingKeeper is used to fetch validators to calculate the total power
 	// signalled to a version.
 	stakingKeeper StakingKeeper
+
+	// authority is the address allowed to cancel a pending upgrade. It is the
+	// governance module account.
+	authority string
 }
 
 // NewKeeper returns a signal keeper.
@@ -51,14 +61,28 @@ func NewKeeper(
 	binaryCodec codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
 	stakingKeeper StakingKeeper,
+	authority string,
 ) Keeper {
 	return Keeper{
 		binaryCodec:   binaryCodec,
 		storeKey:      storeKey,
 		stakingKeeper: stakingKeeper,
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the address allowed to cancel a pending upgrade.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// supportsV6 returns true if the app version of the context supports the
+// features of the module that were added in app version 6. Before, the module
+// keeps the state and the gas consumption of v5.
+func (k Keeper) supportsV6(ctx sdk.Context) bool {
+	return ctx.ConsensusParams().Version.GetApp() >= appconsts.V6
+}
+
 // SignalVersion is a method required by the MsgServer interface.
 func (k Keeper) SignalVersion(ctx context.Context, req *types.MsgSignalVersion) (*types.MsgSignalVersionResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -77,12 +101,26 @@ func (k Keeper) SignalVersion(ctx context.Context, req *types.MsgSignalVersion)
 		return nil, types.ErrInvalidSignalVersion.Wrapf("signalled version %d, current version %d", req.Version, currentVersion)
 	}
 
+	if req.ActivationWindow != nil {
+		if !k.supportsV6(sdkCtx) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("activation windows are not supported before app version %d", appconsts.V6)
+		}
+		if err := req.ActivationWindow.Validate(); err != nil {
+			return nil, err
+		}
+		header := sdkCtx.HeaderInfo()
+		earliest := header.Height + appconsts.GetUpgradeHeightDelay(header.ChainID)
+		if req.ActivationWindow.EndHeight != 0 && req.ActivationWindow.EndHeight < earliest {
+			return nil, types.ErrInvalidActivation.Wrapf("end height %d is before the earliest upgrade height %d", req.ActivationWindow.EndHeight, earliest)
+		}
+	}
+
 	_, err = k.stakingKeeper.GetValidator(sdkCtx, valAddr)
 	if err != nil {
 		return nil, err
 	}
 
-	k.SetValidatorVersion(sdkCtx, valAddr, req.Version)
+	k.SetValidatorSignal(sdkCtx, valAddr, req.Version, req.ActivationWindow)
 
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -99,6 +137,11 @@ func (k Keeper) SignalVersion(ctx context.Context, req *types.MsgSignalVersion)
 // voting power that has voted on each version. If one version has reached a
 // quorum, an upgrade is persisted to the store. The upgrade is used by the
 // application later when it is time to upgrade to that version.
+//
+// The upgrade height is the earliest height, no sooner than the upgrade height
+// delay, that is within the activation windows of a quorum of the validators
+// that signalled for the version. Validators that signalled without a window
+// accept any height.
 func (k *Keeper) TryUpgrade(ctx context.Context, req *types.MsgTryUpgrade) (*types.MsgTryUpgradeResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
@@ -106,12 +149,7 @@ func (k *Keeper) TryUpgrade(ctx context.Context, req *types.MsgTryUpgrade) (*typ
 		return &types.MsgTryUpgradeResponse{}, types.ErrUpgradePending.Wrapf("can not try upgrade")
 	}
 
-	threshold, err := k.GetVotingPowerThreshold(sdkCtx)
-	if err != nil {
-		return nil, err
-	}
-
-	hasQuorum, version, err := k.TallyVotingPower(sdkCtx, threshold.Int64())
+	hasQuorum, version, err := k.tallyVersionThresholds(sdkCtx)
 	if err != nil {
 		return nil, err
 	}
@@ -122,11 +160,17 @@ func (k *Keeper) TryUpgrade(ctx context.Context, req *types.MsgTryUpgrade) (*typ
 			return &types.MsgTryUpgradeResponse{}, types.ErrInvalidUpgradeVersion.Wrapf("can not upgrade to version %v because it is less than or equal to current version %v", version, appVersion)
 		}
 		header := sdkCtx.HeaderInfo()
-		upgrade := types.Upgrade{
-			AppVersion:    version,
-			UpgradeHeight: header.Height + appconsts.GetUpgradeHeightDelay(header.ChainID),
+		earliest := header.Height + appconsts.GetUpgradeHeightDelay(header.ChainID)
+		if k.supportsV6(sdkCtx) {
+			if err := k.scheduleUpgrade(sdkCtx, version, earliest); err != nil {
+				return nil, err
+			}
+		} else {
+			k.setUpgrade(sdkCtx, types.Upgrade{
+				AppVersion:    version,
+				UpgradeHeight: earliest,
+			})
 		}
-		k.setUpgrade(sdkCtx, upgrade)
 	}
 
 	sdkCtx.EventManager().EmitEvent(
@@ -140,6 +184,235 @@ func (k *Keeper) TryUpgrade(ctx context.Context, req *types.MsgTryUpgrade) (*typ
 	return &types.MsgTryUpgradeResponse{}, nil
 }
 
+// scheduleUpgrade sets the upgrade to the version at the earliest height, no
+// sooner than earliest, that is within the activation windows of a quorum and
+// records it in the upgrade history.
+func (k *Keeper) scheduleUpgrade(ctx sdk.Context, version uint64, earliest int64) error {
+	threshold, err := k.versionVotingPowerThreshold(ctx, version)
+	if err != nil {
+		return err
+	}
+	upgradeHeight, reason, err := k.activationHeight(ctx, version, earliest, threshold.Int64())
+	if err != nil {
+		return err
+	}
+	upgrade := types.Upgrade{
+		AppVersion:    version,
+		UpgradeHeight: upgradeHeight,
+		Reason:        reason,
+	}
+	participation, err := k.participation(ctx, version)
+	if err != nil {
+		return err
+	}
+	k.setUpgrade(ctx, upgrade)
+	k.appendUpgradeHistory(ctx, types.UpgradeRecord{
+		Upgrade:       &upgrade,
+		Status:        types.UpgradeStatus_UPGRADE_STATUS_SCHEDULED,
+		Height:        ctx.HeaderInfo().Height,
+		Reason:        reason,
+		Participation: &participation,
+	})
+	return nil
+}
+
+// CancelUpgrade is a method required by the MsgServer interface. It cancels
+// the pending upgrade if its upgrade height hasn't been reached and clears the
+// signals of all validators, so that they have to signal again once the issue
+// that led to the cancellation is resolved. Only the authority can cancel an
+// upgrade.
+func (k *Keeper) CancelUpgrade(ctx context.Context, req *types.MsgCancelUpgrade) (*types.MsgCancelUpgradeResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Authority != k.authority {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("invalid authority: expected: %s, got: %s", k.authority, req.Authority)
+	}
+	if !k.supportsV6(sdkCtx) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("cancelling an upgrade is not supported before app version %d", appconsts.V6)
+	}
+
+	upgrade, ok := k.getUpgrade(sdkCtx)
+	if !ok {
+		return nil, types.ErrNoUpgradePending.Wrapf("can not cancel upgrade")
+	}
+	if sdkCtx.BlockHeight() >= upgrade.UpgradeHeight {
+		return nil, types.ErrUpgradePending.Wrapf("can not cancel upgrade to version %d because its upgrade height %d has been reached", upgrade.AppVersion, upgrade.UpgradeHeight)
+	}
+
+	k.clearSignals(sdkCtx)
+	k.appendUpgradeHistory(sdkCtx, types.UpgradeRecord{
+		Upgrade: &upgrade,
+		Status:  types.UpgradeStatus_UPGRADE_STATUS_CANCELLED,
+		Height:  sdkCtx.BlockHeight(),
+		Reason:  req.Reason,
+	})
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelUpgrade,
+			sdk.NewAttribute(types.AttributeKeyAppVersion, fmt.Sprint(upgrade.AppVersion)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeHeight, fmt.Sprint(upgrade.UpgradeHeight)),
+			sdk.NewAttribute(types.AttributeKeyReason, req.Reason),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.URLMsgCancelUpgrade),
+		),
+	)
+
+	return &types.MsgCancelUpgradeResponse{}, nil
+}
+
+// activationHeight returns the earliest height, starting from the earliest
+// height, that is within the activation windows of validators holding at
+// least the threshold of voting power for the version, along with the reason
+// for the choice.
+func (k Keeper) activationHeight(ctx sdk.Context, version uint64, earliest, threshold int64) (int64, string, error) {
+	signals, err := k.bondedSignals(ctx, version)
+	if err != nil {
+		return 0, "", err
+	}
+
+	candidates := []int64{earliest}
+	hasWindow := false
+	for _, signal := range signals {
+		if signal.window == nil {
+			continue
+		}
+		hasWindow = true
+		if signal.window.StartHeight > earliest {
+			candidates = append(candidates, signal.window.StartHeight)
+		}
+	}
+	if !hasWindow {
+		return earliest, "quorum reached, upgrading after the upgrade height delay", nil
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i] < candidates[j] })
+
+	for _, height := range candidates {
+		power := int64(0)
+		for _, signal := range signals {
+			if signal.window == nil || signal.window.Contains(height) {
+				power += signal.power
+			}
+		}
+		if power >= threshold {
+			return height, fmt.Sprintf("quorum reached, upgrading at the earliest height within the activation windows of %d voting power", power), nil
+		}
+	}
+	return 0, "", types.ErrInvalidActivation.Wrapf("no height from %d is within the activation windows of a quorum for version %d", earliest, version)
+}
+
+// participation returns the participation of the bonded validators in the
+// signalling for the version.
+func (k Keeper) participation(ctx sdk.Context, version uint64) (types.Participation, error) {
+	signals, err := k.bondedSignals(ctx, version)
+	if err != nil {
+		return types.Participation{}, err
+	}
+	validators, err := k.stakingKeeper.GetBondedValidatorsByPower(ctx)
+	if err != nil {
+		return types.Participation{}, err
+	}
+	totalPower, err := k.stakingKeeper.GetLastTotalPower(ctx)
+	if err != nil {
+		return types.Participation{}, err
+	}
+	signalledPower := int64(0)
+	for _, signal := range signals {
+		signalledPower += signal.power
+	}
+	return types.Participation{
+		AppVersion:          version,
+		SignalledPower:      uint64(signalledPower),
+		TotalPower:          totalPower.Uint64(),
+		SignalledValidators: uint32(len(signals)),
+		TotalValidators:     uint32(len(validators)),
+	}, nil
+}
+
+// ValidatorSignals lists the signal of every bonded validator, ordered by
+// voting power, including the validators that haven't signalled. The
+// participation is reported if a version is requested.
+func (k Keeper) ValidatorSignals(ctx context.Context, req *types.QueryValidatorSignalsRequest) (*types.QueryValidatorSignalsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	validators, err := k.stakingKeeper.GetBondedValidatorsByPower(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	store := sdkCtx.KVStore(k.storeKey)
+	resp := &types.QueryValidatorSignalsResponse{Signals: make([]types.ValidatorSignal, 0, len(validators))}
+	for _, val := range validators {
+		valAddress, err := sdk.ValAddressFromBech32(val.GetOperator())
+		if err != nil {
+			return nil, err
+		}
+		power, err := k.stakingKeeper.GetLastValidatorPower(sdkCtx, valAddress)
+		if err != nil {
+			return nil, err
+		}
+		signal := types.ValidatorSignal{
+			ValidatorAddress: val.GetOperator(),
+			VotingPower:      power,
+		}
+		if value := store.Get(valAddress); value != nil {
+			version, info := k.signalFromBytes(value)
+			signal.Version = version
+			signal.Height = info.Height
+			signal.ActivationWindow = info.ActivationWindow
+			signal.History = info.History
+		}
+		resp.Signals = append(resp.Signals, signal)
+	}
+
+	if req.Version != 0 {
+		participation, err := k.participation(sdkCtx, req.Version)
+		if err != nil {
+			return nil, err
+		}
+		resp.Participation = &participation
+	}
+	return resp, nil
+}
+
+// validatorSignal is the signal of a bonded validator.
+type validatorSignal struct {
+	power  int64
+	window *types.ActivationWindow
+}
+
+// bondedSignals returns the signals of the bonded validators for the version.
+func (k Keeper) bondedSignals(ctx sdk.Context, version uint64) ([]validatorSignal, error) {
+	var signals []validatorSignal
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.FirstSignalKey, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if !types.IsSignalKey(iterator.Key()) {
+			continue
+		}
+		signalled, info := k.signalFromBytes(iterator.Value())
+		if signalled != version {
+			continue
+		}
+		valAddress := sdk.ValAddress(iterator.Key())
+		val, err := k.stakingKeeper.GetValidator(ctx, valAddress)
+		if err != nil {
+			if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+				continue
+			}
+			return nil, err
+		}
+		if !val.IsBonded() {
+			continue
+		}
+		power, err := k.stakingKeeper.GetLastValidatorPower(ctx, valAddress)
+		if err != nil {
+			return nil, err
+		}
+		signals = append(signals, validatorSignal{power: power, window: info.ActivationWindow})
+	}
+	return signals, nil
+}
+
 // VersionTally enables a client to query for the tally of voting power has
 // signalled for a particular version.
 func (k Keeper) VersionTally(ctx context.Context, req *types.QueryVersionTallyRequest) (*types.QueryVersionTallyResponse, error) {
@@ -153,7 +426,7 @@ func (k Keeper) VersionTally(ctx context.Context, req *types.QueryVersionTallyRe
 	iterator := store.Iterator(types.FirstSignalKey, nil)
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		if bytes.Equal(iterator.Key(), types.UpgradeKey) {
+		if !types.IsSignalKey(iterator.Key()) {
 			continue
 		}
 		valAddress := sdk.ValAddress(iterator.Key())
@@ -167,7 +440,7 @@ func (k Keeper) VersionTally(ctx context.Context, req *types.QueryVersionTallyRe
 		}
 	}
 
-	threshold, err := k.GetVotingPowerThreshold(sdkCtx)
+	threshold, err := k.versionVotingPowerThreshold(sdkCtx, req.Version)
 	if err != nil {
 		return nil, err
 	}
@@ -176,13 +449,63 @@ func (k Keeper) VersionTally(ctx context.Context, req *types.QueryVersionTallyRe
 		VotingPower:      currentVotingPower.Uint64(),
 		ThresholdPower:   threshold.Uint64(),
 		TotalVotingPower: totalVotingPower.Uint64(),
+		Threshold:        k.threshold(sdkCtx, req.Version),
 	}, nil
 }
 
 // SetValidatorVersion saves a signalled version for a validator.
 func (k Keeper) SetValidatorVersion(ctx sdk.Context, valAddress sdk.ValAddress, version uint64) {
+	k.SetValidatorSignal(ctx, valAddress, version, nil)
+}
+
+// SetValidatorSignal saves a signalled version for a validator together with
+// its preferred activation window, which may be nil, and the current height.
+// The previous signal of the validator is appended to its signal history.
+// Before app version 6 only the version is saved.
+func (k Keeper) SetValidatorSignal(ctx sdk.Context, valAddress sdk.ValAddress, version uint64, window *types.ActivationWindow) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(valAddress, VersionToBytes(version))
+	if !k.supportsV6(ctx) {
+		store.Set(valAddress, VersionToBytes(version))
+		return
+	}
+	info := types.SignalInfo{
+		ActivationWindow: window,
+		Height:           ctx.BlockHeight(),
+	}
+	if value := store.Get(valAddress); value != nil {
+		previousVersion, previous := k.signalFromBytes(value)
+		info.History = append(previous.History, types.SignalChange{Version: previousVersion, Height: previous.Height})
+		if len(info.History) > MaxSignalHistory {
+			info.History = info.History[len(info.History)-MaxSignalHistory:]
+		}
+	}
+	value := append(VersionToBytes(version), signalInfoFormat)
+	store.Set(valAddress, append(value, k.binaryCodec.MustMarshal(&info)...))
+}
+
+// signalInfoFormat is the format byte that follows the version in the value
+// of a signal holding a SignalInfo. Signals stored with an activation window
+// before signal infos existed are followed by the encoded window instead,
+// which never starts with a byte below 8 as protobuf field numbers start at 1.
+const signalInfoFormat byte = 1
+
+// signalFromBytes decodes the value of a signal. The value starts with the
+// version so that signals stored with only the version decode the same way.
+// It is followed by the format byte and the SignalInfo, or by the activation
+// window of signals stored before the format byte existed.
+func (k Keeper) signalFromBytes(value []byte) (uint64, types.SignalInfo) {
+	version := VersionFromBytes(value)
+	var info types.SignalInfo
+	switch {
+	case len(value) <= 8:
+	case value[8] == signalInfoFormat:
+		k.binaryCodec.MustUnmarshal(value[9:], &info)
+	default:
+		window := &types.ActivationWindow{}
+		k.binaryCodec.MustUnmarshal(value[8:], window)
+		info.ActivationWindow = window
+	}
+	return version, info
 }
 
 // DeleteValidatorVersion deletes a signalled version for a validator.
@@ -195,12 +518,33 @@ func (k Keeper) DeleteValidatorVersion(ctx sdk.Context, valAddress sdk.ValAddres
 // and the version if any version has reached the quorum in voting power.
 // Returns false and 0 otherwise.
 func (k Keeper) TallyVotingPower(ctx sdk.Context, threshold int64) (bool, uint64, error) {
+	return k.tally(ctx, func(uint64) int64 { return threshold })
+}
+
+// tallyVersionThresholds tallies the voting power like TallyVotingPower, but
+// the quorum of each version is the threshold of the params for that version.
+// As thresholds are at least 2/3, at most one version can reach its quorum.
+func (k Keeper) tallyVersionThresholds(ctx sdk.Context) (bool, uint64, error) {
+	totalVotingPower, err := k.stakingKeeper.GetLastTotalPower(ctx)
+	if err != nil {
+		return false, 0, err
+	}
+	params := k.thresholdParams(ctx)
+	return k.tally(ctx, func(version uint64) int64 {
+		return votingPowerThreshold(params.ThresholdFor(version), totalVotingPower).Int64()
+	})
+}
+
+// tally tallies the voting power for each version and returns true and the
+// first version, in the order of the store, that reaches the threshold for
+// that version. Returns false and 0 otherwise.
+func (k Keeper) tally(ctx sdk.Context, threshold func(version uint64) int64) (bool, uint64, error) {
 	versionToPower := make(map[uint64]int64)
 	store := ctx.KVStore(k.storeKey)
 	iterator := store.Iterator(types.FirstSignalKey, nil)
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		if bytes.Equal(iterator.Key(), types.UpgradeKey) {
+		if !types.IsSignalKey(iterator.Key()) {
 			continue
 		}
 		valAddress := sdk.ValAddress(iterator.Key())
@@ -229,7 +573,7 @@ func (k Keeper) TallyVotingPower(ctx sdk.Context, threshold int64) (bool, uint64
 		} else {
 			versionToPower[version] += power
 		}
-		if versionToPower[version] >= threshold {
+		if versionToPower[version] >= threshold(version) {
 			return true, version, nil
 		}
 	}
@@ -237,15 +581,40 @@ func (k Keeper) TallyVotingPower(ctx sdk.Context, threshold int64) (bool, uint64
 }
 
 // GetVotingPowerThreshold returns the voting power threshold required to
-// upgrade to a new version.
+// upgrade to the next app version.
 func (k Keeper) GetVotingPowerThreshold(ctx sdk.Context) (math.Int, error) {
+	return k.versionVotingPowerThreshold(ctx, ctx.BlockHeader().Version.App+1)
+}
+
+// versionVotingPowerThreshold returns the voting power threshold required to
+// upgrade to the version.
+func (k Keeper) versionVotingPowerThreshold(ctx sdk.Context, version uint64) (math.Int, error) {
 	totalVotingPower, err := k.stakingKeeper.GetLastTotalPower(ctx)
 	if err != nil {
 		return math.ZeroInt(), err
 	}
+	return votingPowerThreshold(k.threshold(ctx, version), totalVotingPower), nil
+}
 
-	thresholdFraction := Threshold(ctx.BlockHeader().Version.App)
-	return thresholdFraction.MulInt(totalVotingPower).Ceil().TruncateInt(), nil
+// votingPowerThreshold returns the voting power that the threshold fraction of
+// the total voting power amounts to, rounded up.
+func votingPowerThreshold(thresholdFraction math.LegacyDec, totalVotingPower math.Int) math.Int {
+	return thresholdFraction.MulInt(totalVotingPower).Ceil().TruncateInt()
+}
+
+// threshold returns the fraction of voting power that is required to upgrade
+// to the version.
+func (k Keeper) threshold(ctx sdk.Context, version uint64) math.LegacyDec {
+	return k.thresholdParams(ctx).ThresholdFor(version)
+}
+
+// thresholdParams returns the params that the thresholds are read from. Before
+// app version 6 they are always the default params and the store is not read.
+func (k Keeper) thresholdParams(ctx sdk.Context) types.Params {
+	if !k.supportsV6(ctx) {
+		return types.DefaultParams()
+	}
+	return k.GetParams(ctx)
 }
 
 // ShouldUpgrade returns whether the signalling mechanism has concluded that the
@@ -266,13 +635,34 @@ func (k *Keeper) ShouldUpgrade(ctx sdk.Context) (isQuorumVersion bool, upgrade t
 
 // ResetTally resets the tally after a version change. It iterates over the
 // store and deletes all versions. It also resets the quorumVersion and
-// upgradeHeight to 0.
+// upgradeHeight to 0. From app version 6, if the pending upgrade has reached
+// its upgrade height, it is recorded as applied in the upgrade history.
 func (k *Keeper) ResetTally(ctx sdk.Context) {
+	if !k.supportsV6(ctx) {
+		k.clearSignals(ctx)
+		return
+	}
+	if upgrade, ok := k.getUpgrade(ctx); ok && ctx.BlockHeight() >= upgrade.UpgradeHeight {
+		k.appendUpgradeHistory(ctx, types.UpgradeRecord{
+			Upgrade: &upgrade,
+			Status:  types.UpgradeStatus_UPGRADE_STATUS_APPLIED,
+			Height:  ctx.BlockHeight(),
+		})
+	}
+	k.clearSignals(ctx)
+}
+
+// clearSignals deletes the pending upgrade and all signals. The upgrade
+// history and the params are kept.
+func (k *Keeper) clearSignals(ctx sdk.Context) {
 	store := ctx.KVStore(k.storeKey)
 	iterator := store.Iterator(nil, nil)
 	defer iterator.Close()
 	// delete the value in the upgrade key and all signals.
 	for ; iterator.Valid(); iterator.Next() {
+		if bytes.Equal(iterator.Key(), types.UpgradeHistoryKey) || bytes.Equal(iterator.Key(), types.ParamsKey) {
+			continue
+		}
 		store.Delete(iterator.Key())
 	}
 }
@@ -285,13 +675,35 @@ func VersionFromBytes(version []byte) uint64 {
 	return binary.BigEndian.Uint64(version)
 }
 
-// GetUpgrade returns the current upgrade information.
+// GetUpgrade returns the current upgrade information and the upgrade history.
 func (k Keeper) GetUpgrade(ctx context.Context, _ *types.QueryGetUpgradeRequest) (*types.QueryGetUpgradeResponse, error) {
-	upgrade, ok := k.getUpgrade(sdk.UnwrapSDKContext(ctx))
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	history := k.GetUpgradeHistory(sdkCtx)
+	upgrade, ok := k.getUpgrade(sdkCtx)
 	if !ok {
-		return &types.QueryGetUpgradeResponse{}, nil
+		return &types.QueryGetUpgradeResponse{History: history}, nil
 	}
-	return &types.QueryGetUpgradeResponse{Upgrade: &upgrade}, nil
+	return &types.QueryGetUpgradeResponse{Upgrade: &upgrade, History: history}, nil
+}
+
+// GetUpgradeHistory returns the upgrades that were scheduled, cancelled or
+// applied, oldest first.
+func (k Keeper) GetUpgradeHistory(ctx sdk.Context) []*types.UpgradeRecord {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.UpgradeHistoryKey)
+	if value == nil {
+		return nil
+	}
+	var history types.UpgradeHistory
+	k.binaryCodec.MustUnmarshal(value, &history)
+	return history.Records
+}
+
+// appendUpgradeHistory appends the record to the upgrade history.
+func (k Keeper) appendUpgradeHistory(ctx sdk.Context, record types.UpgradeRecord) {
+	history := types.UpgradeHistory{Records: append(k.GetUpgradeHistory(ctx), &record)}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.UpgradeHistoryKey, k.binaryCodec.MustMarshal(&history))
 }
 
 // IsUpgradePending returns true if an app version has reached quorum and the
@@ -0,0 +1,60 @@
+package signal
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v5/app/encoding"
+	"github.com/celestiaorg/celestia-app/v5/x/signal/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSignalFromBytes tests that the values of signals stored in any format
+// decode to the same version and activation window.
+func TestSignalFromBytes(t *testing.T) {
+	enc := encoding.MakeConfig()
+	k := Keeper{binaryCodec: enc.Codec}
+
+	window := &types.ActivationWindow{StartHeight: 10, EndHeight: 20}
+	info := types.SignalInfo{
+		ActivationWindow: window,
+		Height:           5,
+		History:          []types.SignalChange{{Version: 2, Height: 3}},
+	}
+
+	testCases := []struct {
+		name  string
+		value []byte
+		want  types.SignalInfo
+	}{
+		{
+			name:  "version only",
+			value: VersionToBytes(3),
+		},
+		{
+			name:  "version and activation window",
+			value: append(VersionToBytes(3), enc.Codec.MustMarshal(window)...),
+			want:  types.SignalInfo{ActivationWindow: window},
+		},
+		{
+			name:  "version and activation window without a start height",
+			value: append(VersionToBytes(3), enc.Codec.MustMarshal(&types.ActivationWindow{EndHeight: 20})...),
+			want:  types.SignalInfo{ActivationWindow: &types.ActivationWindow{EndHeight: 20}},
+		},
+		{
+			name:  "version and signal info",
+			value: append(append(VersionToBytes(3), signalInfoFormat), enc.Codec.MustMarshal(&info)...),
+			want:  info,
+		},
+		{
+			name:  "version and empty signal info",
+			value: append(VersionToBytes(3), signalInfoFormat),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			version, got := k.signalFromBytes(tc.value)
+			require.EqualValues(t, 3, version)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
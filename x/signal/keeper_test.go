@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"sort"
 	"testing"
 
 	"cosmossdk.io/core/header"
@@ -23,6 +24,9 @@ import (
 	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
 	dbm "github.com/cosmos/cosmos-db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -66,10 +70,8 @@ func TestGetVotingPowerThreshold(t *testing.T) {
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			config := encoding.MakeConfig(app.ModuleEncodingRegisters...)
-			stakingKeeper := newMockStakingKeeper(tc.validators)
-			k := signal.NewKeeper(config.Codec, nil, stakingKeeper)
-			got, err := k.GetVotingPowerThreshold(sdk.Context{})
+			k, ctx, _ := setupWithValidators(t, tc.validators)
+			got, err := k.GetVotingPowerThreshold(ctx)
 			assert.NoError(t, err)
 			assert.Equal(t, tc.want, got, fmt.Sprintf("want %v, got %v", tc.want.String(), got.String()))
 		})
@@ -523,7 +525,358 @@ func TestTallyAfterTryUpgrade(t *testing.T) {
 	require.EqualValues(t, 120, res.TotalVotingPower)
 }
 
+func TestActivationWindow(t *testing.T) {
+	signalVersion := func(t *testing.T, upgradeKeeper signal.Keeper, ctx sdk.Context, valIndex int, window *types.ActivationWindow) {
+		_, err := upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{
+			ValidatorAddress: testutil.ValAddrs[valIndex].String(),
+			Version:          2,
+			ActivationWindow: window,
+		})
+		require.NoError(t, err)
+	}
+
+	t.Run("should reject invalid windows", func(t *testing.T) {
+		upgradeKeeper, ctx, _ := setup(t)
+		for _, window := range []*types.ActivationWindow{
+			{StartHeight: 20, EndHeight: 10},
+			{StartHeight: -1},
+			// the upgrade height delay is 3 blocks so the window ends too early
+			{EndHeight: 2},
+		} {
+			_, err := upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{
+				ValidatorAddress: testutil.ValAddrs[0].String(),
+				Version:          2,
+				ActivationWindow: window,
+			})
+			require.ErrorIs(t, err, types.ErrInvalidActivation)
+		}
+	})
+
+	t.Run("should upgrade at the earliest height within the windows of a quorum", func(t *testing.T) {
+		upgradeKeeper, ctx, _ := setup(t)
+		signalVersion(t, upgradeKeeper, ctx, 0, &types.ActivationWindow{StartHeight: 10, EndHeight: 20})
+		signalVersion(t, upgradeKeeper, ctx, 1, nil)
+		signalVersion(t, upgradeKeeper, ctx, 2, &types.ActivationWindow{StartHeight: 15})
+		signalVersion(t, upgradeKeeper, ctx, 3, nil)
+
+		_, err := upgradeKeeper.TryUpgrade(ctx, &types.MsgTryUpgrade{})
+		require.NoError(t, err)
+		got, err := upgradeKeeper.GetUpgrade(ctx, &types.QueryGetUpgradeRequest{})
+		require.NoError(t, err)
+		require.EqualValues(t, 2, got.Upgrade.AppVersion)
+		require.EqualValues(t, 15, got.Upgrade.UpgradeHeight)
+		require.NotEmpty(t, got.Upgrade.Reason)
+	})
+
+	t.Run("should not upgrade if no height is within the windows of a quorum", func(t *testing.T) {
+		upgradeKeeper, ctx, _ := setup(t)
+		signalVersion(t, upgradeKeeper, ctx, 0, &types.ActivationWindow{StartHeight: 10, EndHeight: 12})
+		signalVersion(t, upgradeKeeper, ctx, 1, nil)
+		signalVersion(t, upgradeKeeper, ctx, 2, &types.ActivationWindow{StartHeight: 15, EndHeight: 20})
+		signalVersion(t, upgradeKeeper, ctx, 3, &types.ActivationWindow{StartHeight: 15})
+
+		_, err := upgradeKeeper.TryUpgrade(ctx, &types.MsgTryUpgrade{})
+		require.ErrorIs(t, err, types.ErrInvalidActivation)
+		require.False(t, upgradeKeeper.IsUpgradePending(ctx))
+
+		// the tally ignores the windows
+		res, err := upgradeKeeper.VersionTally(ctx, &types.QueryVersionTallyRequest{Version: 2})
+		require.NoError(t, err)
+		require.EqualValues(t, 120, res.VotingPower)
+	})
+}
+
+func TestCancelUpgrade(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+	scheduleUpgrade := func(t *testing.T) types.Upgrade {
+		for _, valAddr := range testutil.ValAddrs[:4] {
+			_, err := upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: valAddr.String(), Version: 2})
+			require.NoError(t, err)
+		}
+		_, err := upgradeKeeper.TryUpgrade(ctx, &types.MsgTryUpgrade{})
+		require.NoError(t, err)
+		got, err := upgradeKeeper.GetUpgrade(ctx, &types.QueryGetUpgradeRequest{})
+		require.NoError(t, err)
+		require.NotNil(t, got.Upgrade)
+		return *got.Upgrade
+	}
+
+	t.Run("should return an error if no upgrade is pending", func(t *testing.T) {
+		_, err := upgradeKeeper.CancelUpgrade(ctx, types.NewMsgCancelUpgrade(testAuthority, "bug"))
+		require.ErrorIs(t, err, types.ErrNoUpgradePending)
+	})
+
+	upgrade := scheduleUpgrade(t)
+
+	t.Run("should return an error if the signer is not the authority", func(t *testing.T) {
+		_, err := upgradeKeeper.CancelUpgrade(ctx, types.NewMsgCancelUpgrade(testutil.ValAddrs[0].String(), "bug"))
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+		require.True(t, upgradeKeeper.IsUpgradePending(ctx))
+	})
+
+	t.Run("should cancel the upgrade and clear the signals", func(t *testing.T) {
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		_, err := upgradeKeeper.CancelUpgrade(ctx, types.NewMsgCancelUpgrade(testAuthority, "bug found in v2"))
+		require.NoError(t, err)
+		require.False(t, upgradeKeeper.IsUpgradePending(ctx))
+		require.Len(t, ctx.EventManager().Events(), 1)
+		require.Equal(t, types.EventTypeCancelUpgrade, ctx.EventManager().Events()[0].Type)
+
+		res, err := upgradeKeeper.VersionTally(ctx, &types.QueryVersionTallyRequest{Version: 2})
+		require.NoError(t, err)
+		require.EqualValues(t, 0, res.VotingPower)
+
+		got, err := upgradeKeeper.GetUpgrade(ctx, &types.QueryGetUpgradeRequest{})
+		require.NoError(t, err)
+		require.Nil(t, got.Upgrade)
+		require.Len(t, got.History, 2)
+		require.Equal(t, types.UpgradeStatus_UPGRADE_STATUS_SCHEDULED, got.History[0].Status)
+		require.Equal(t, upgrade, *got.History[0].Upgrade)
+		require.Equal(t, types.UpgradeStatus_UPGRADE_STATUS_CANCELLED, got.History[1].Status)
+		require.Equal(t, "bug found in v2", got.History[1].Reason)
+	})
+
+	t.Run("should not cancel an upgrade that reached its height", func(t *testing.T) {
+		upgrade := scheduleUpgrade(t)
+		ctx := ctx.WithBlockHeight(upgrade.UpgradeHeight)
+		_, err := upgradeKeeper.CancelUpgrade(ctx, types.NewMsgCancelUpgrade(testAuthority, "too late"))
+		require.ErrorIs(t, err, types.ErrUpgradePending)
+
+		// the history survives the reset that follows the upgrade
+		upgradeKeeper.ResetTally(ctx)
+		history := upgradeKeeper.GetUpgradeHistory(ctx)
+		require.Len(t, history, 4)
+		require.Equal(t, types.UpgradeStatus_UPGRADE_STATUS_APPLIED, history[3].Status)
+		require.Equal(t, upgrade.UpgradeHeight, history[3].Height)
+	})
+}
+
+func TestThresholdParams(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+	require.Equal(t, types.DefaultParams(), upgradeKeeper.GetParams(ctx))
+
+	for _, valAddr := range []sdk.ValAddress{testutil.ValAddrs[0], testutil.ValAddrs[2]} {
+		_, err := upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: valAddr.String(), Version: 2})
+		require.NoError(t, err)
+	}
+	// 99/120 is below the default threshold of 5/6
+	res, err := upgradeKeeper.VersionTally(ctx, &types.QueryVersionTallyRequest{Version: 2})
+	require.NoError(t, err)
+	require.EqualValues(t, 100, res.ThresholdPower)
+	require.Equal(t, types.DefaultThreshold, res.Threshold)
+
+	t.Run("should reject invalid params", func(t *testing.T) {
+		for _, params := range []types.Params{
+			types.NewParams(sdkmath.LegacyNewDecWithPrec(5, 1)),
+			types.NewParams(sdkmath.LegacyNewDec(2)),
+			{Threshold: types.DefaultThreshold, VersionThresholds: []types.VersionThreshold{{AppVersion: 2, Threshold: sdkmath.LegacyZeroDec()}}},
+			{Threshold: types.DefaultThreshold, VersionThresholds: []types.VersionThreshold{{AppVersion: 2, Threshold: types.MinThreshold}, {AppVersion: 2, Threshold: types.MinThreshold}}},
+		} {
+			_, err := upgradeKeeper.UpdateParams(ctx, types.NewMsgUpdateParams(testAuthority, params))
+			require.Error(t, err)
+		}
+		_, err := upgradeKeeper.UpdateParams(ctx, types.NewMsgUpdateParams(testutil.ValAddrs[0].String(), types.DefaultParams()))
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+
+	t.Run("should apply the threshold of the target version", func(t *testing.T) {
+		params := types.Params{
+			Threshold: types.DefaultThreshold,
+			VersionThresholds: []types.VersionThreshold{
+				{AppVersion: 2, Threshold: types.MinThreshold},
+			},
+		}
+		_, err := upgradeKeeper.UpdateParams(ctx, types.NewMsgUpdateParams(testAuthority, params))
+		require.NoError(t, err)
+
+		got, err := upgradeKeeper.Params(ctx, &types.QueryParamsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, params, got.Params)
+
+		res, err := upgradeKeeper.VersionTally(ctx, &types.QueryVersionTallyRequest{Version: 2})
+		require.NoError(t, err)
+		require.EqualValues(t, 81, res.ThresholdPower)
+		require.Equal(t, types.MinThreshold, res.Threshold)
+		res, err = upgradeKeeper.VersionTally(ctx, &types.QueryVersionTallyRequest{Version: 3})
+		require.NoError(t, err)
+		require.EqualValues(t, 100, res.ThresholdPower)
+
+		_, err = upgradeKeeper.TryUpgrade(ctx, &types.MsgTryUpgrade{})
+		require.NoError(t, err)
+		require.True(t, upgradeKeeper.IsUpgradePending(ctx))
+
+		// the params survive the reset that follows the upgrade
+		upgradeKeeper.ResetTally(ctx)
+		require.Equal(t, params, upgradeKeeper.GetParams(ctx))
+	})
+}
+
+func TestGenesis(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+	params := types.NewParams(types.MinThreshold)
+	require.NoError(t, upgradeKeeper.InitGenesis(ctx, types.GenesisState{Params: &params}))
+	require.Equal(t, params, *upgradeKeeper.ExportGenesis(ctx).Params)
+
+	// genesis files from before the module had params use the default params
+	require.NoError(t, upgradeKeeper.InitGenesis(ctx, types.GenesisState{}))
+	require.Equal(t, types.DefaultParams(), upgradeKeeper.GetParams(ctx))
+
+	invalid := types.NewParams(sdkmath.LegacyOneDec().QuoInt64(2))
+	require.Error(t, upgradeKeeper.InitGenesis(ctx, types.GenesisState{Params: &invalid}))
+}
+
+// TestSignalBeforeV6 verifies that the features added in app version 6 are
+// rejected and don't change the state of the module before app version 6.
+func TestSignalBeforeV6(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+	ctx = ctx.WithConsensusParams(tmproto.ConsensusParams{
+		Version: &tmproto.VersionParams{App: appconsts.V6 - 1},
+	}).WithBlockHeight(10)
+
+	_, err := upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{
+		ValidatorAddress: testutil.ValAddrs[0].String(),
+		Version:          2,
+		ActivationWindow: &types.ActivationWindow{StartHeight: 100},
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = upgradeKeeper.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: testAuthority,
+		Params:    types.NewParams(types.MinThreshold),
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	params := types.NewParams(types.MinThreshold)
+	require.Error(t, upgradeKeeper.InitGenesis(ctx, types.GenesisState{Params: &params}))
+	require.NoError(t, upgradeKeeper.InitGenesis(ctx, *types.DefaultGenesis()))
+	require.Equal(t, types.DefaultParams(), upgradeKeeper.GetParams(ctx))
+
+	// the signals are saved without their height and history
+	for _, val := range []int{0, 0, 1, 2} {
+		_, err = upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{
+			ValidatorAddress: testutil.ValAddrs[val].String(),
+			Version:          2,
+		})
+		require.NoError(t, err)
+	}
+	res, err := upgradeKeeper.ValidatorSignals(ctx, &types.QueryValidatorSignalsRequest{})
+	require.NoError(t, err)
+	for _, signal := range res.Signals {
+		require.Zero(t, signal.Height)
+		require.Empty(t, signal.History)
+	}
+
+	tally, err := upgradeKeeper.VersionTally(ctx, &types.QueryVersionTallyRequest{Version: 2})
+	require.NoError(t, err)
+	require.EqualValues(t, 100, tally.ThresholdPower)
+
+	// the upgrade is scheduled after the delay without a reason or a record
+	_, err = upgradeKeeper.TryUpgrade(ctx, &types.MsgTryUpgrade{})
+	require.NoError(t, err)
+	upgrade, err := upgradeKeeper.GetUpgrade(ctx, &types.QueryGetUpgradeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.Upgrade{
+		AppVersion:    2,
+		UpgradeHeight: ctx.HeaderInfo().Height + appconsts.GetUpgradeHeightDelay(appconsts.TestChainID),
+	}, upgrade.Upgrade)
+	require.Empty(t, upgrade.History)
+
+	_, err = upgradeKeeper.CancelUpgrade(ctx, &types.MsgCancelUpgrade{Authority: testAuthority})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	ctx = ctx.WithBlockHeight(upgrade.Upgrade.UpgradeHeight)
+	upgradeKeeper.ResetTally(ctx)
+	upgrade, err = upgradeKeeper.GetUpgrade(ctx, &types.QueryGetUpgradeRequest{})
+	require.NoError(t, err)
+	require.Nil(t, upgrade.Upgrade)
+	require.Empty(t, upgrade.History)
+}
+
+func TestValidatorSignals(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+
+	signalVersion := func(t *testing.T, valIndex int, version uint64) {
+		_, err := upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[valIndex].String(), Version: version})
+		require.NoError(t, err)
+	}
+	ctx = ctx.WithBlockHeight(5)
+	signalVersion(t, 0, 3)
+	ctx = ctx.WithBlockHeight(7)
+	signalVersion(t, 0, 2)
+	signalVersion(t, 3, 2)
+
+	got, err := upgradeKeeper.ValidatorSignals(ctx, &types.QueryValidatorSignalsRequest{Version: 2})
+	require.NoError(t, err)
+	require.Equal(t, []types.ValidatorSignal{
+		{
+			ValidatorAddress: testutil.ValAddrs[2].String(),
+			VotingPower:      59,
+		},
+		{
+			ValidatorAddress: testutil.ValAddrs[0].String(),
+			Version:          2,
+			Height:           7,
+			VotingPower:      40,
+			History:          []types.SignalChange{{Version: 3, Height: 5}},
+		},
+		{
+			ValidatorAddress: testutil.ValAddrs[3].String(),
+			Version:          2,
+			Height:           7,
+			VotingPower:      20,
+		},
+		{
+			ValidatorAddress: testutil.ValAddrs[1].String(),
+			VotingPower:      1,
+		},
+	}, got.Signals)
+	require.Equal(t, &types.Participation{
+		AppVersion:          2,
+		SignalledPower:      60,
+		TotalPower:          120,
+		SignalledValidators: 2,
+		TotalValidators:     4,
+	}, got.Participation)
+
+	t.Run("should bound the signal history", func(t *testing.T) {
+		for i := 0; i < 2*signal.MaxSignalHistory; i++ {
+			signalVersion(t, 1, uint64(2+i%2))
+		}
+		got, err := upgradeKeeper.ValidatorSignals(ctx, &types.QueryValidatorSignalsRequest{})
+		require.NoError(t, err)
+		require.Nil(t, got.Participation)
+		require.Len(t, got.Signals[3].History, signal.MaxSignalHistory)
+	})
+
+	t.Run("should record the participation of scheduled upgrades", func(t *testing.T) {
+		signalVersion(t, 1, 2)
+		signalVersion(t, 2, 2)
+		_, err := upgradeKeeper.TryUpgrade(ctx, &types.MsgTryUpgrade{})
+		require.NoError(t, err)
+		history := upgradeKeeper.GetUpgradeHistory(ctx)
+		require.Len(t, history, 1)
+		require.Equal(t, &types.Participation{
+			AppVersion:          2,
+			SignalledPower:      120,
+			TotalPower:          120,
+			SignalledValidators: 4,
+			TotalValidators:     4,
+		}, history[0].Participation)
+	})
+}
+
+var testAuthority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
 func setup(t *testing.T) (signal.Keeper, sdk.Context, *mockStakingKeeper) {
+	return setupWithValidators(t, map[string]int64{
+		testutil.ValAddrs[0].String(): 40,
+		testutil.ValAddrs[1].String(): 1,
+		testutil.ValAddrs[2].String(): 59,
+		testutil.ValAddrs[3].String(): 20,
+	})
+}
+
+func setupWithValidators(t *testing.T, validators map[string]int64) (signal.Keeper, sdk.Context, *mockStakingKeeper) {
 	signalStore := storetypes.NewKVStoreKey(types.StoreKey)
 	db := dbm.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NoOpMetrics{})
@@ -536,17 +889,12 @@ func setup(t *testing.T) (signal.Keeper, sdk.Context, *mockStakingKeeper) {
 		},
 	}, false, log.NewNopLogger()).WithHeaderInfo(header.Info{
 		ChainID: appconsts.TestChainID, // TryUpgrade reads chainID from header info, not block header.
+	}).WithConsensusParams(tmproto.ConsensusParams{
+		Version: &tmproto.VersionParams{App: appconsts.V6},
 	})
-	mockStakingKeeper := newMockStakingKeeper(
-		map[string]int64{
-			testutil.ValAddrs[0].String(): 40,
-			testutil.ValAddrs[1].String(): 1,
-			testutil.ValAddrs[2].String(): 59,
-			testutil.ValAddrs[3].String(): 20,
-		},
-	)
+	mockStakingKeeper := newMockStakingKeeper(validators)
 	config := encoding.MakeConfig(app.ModuleEncodingRegisters...)
-	upgradeKeeper := signal.NewKeeper(config.Codec, signalStore, mockStakingKeeper)
+	upgradeKeeper := signal.NewKeeper(config.Codec, signalStore, mockStakingKeeper, testAuthority)
 	return upgradeKeeper, mockCtx, mockStakingKeeper
 }
 
@@ -587,3 +935,14 @@ func (m *mockStakingKeeper) GetValidator(_ context.Context, addr sdk.ValAddress)
 	}
 	return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
 }
+
+func (m *mockStakingKeeper) GetBondedValidatorsByPower(_ context.Context) ([]stakingtypes.Validator, error) {
+	validators := make([]stakingtypes.Validator, 0, len(m.validators))
+	for addr := range m.validators {
+		validators = append(validators, stakingtypes.Validator{OperatorAddress: addr, Status: stakingtypes.Bonded})
+	}
+	sort.Slice(validators, func(i, j int) bool {
+		return m.validators[validators[i].OperatorAddress] > m.validators[validators[j].OperatorAddress]
+	})
+	return validators, nil
+}
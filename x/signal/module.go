@@ -3,6 +3,7 @@ package signal
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"cosmossdk.io/core/appmodule"
 	"github.com/celestiaorg/celestia-app/v5/x/signal/cli"
@@ -10,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
@@ -24,6 +26,7 @@ const (
 var (
 	_ module.AppModuleBasic   = AppModule{}
 	_ module.HasGenesisBasics = AppModule{}
+	_ module.HasGenesis       = AppModule{}
 
 	_ appmodule.AppModule   = AppModule{}
 	_ appmodule.HasServices = AppModule{}
@@ -31,11 +34,12 @@ var (
 
 // AppModule implements the AppModule interface for the blobstream module.
 type AppModule struct {
+	cdc    codec.Codec
 	keeper Keeper
 }
 
-func NewAppModule(k Keeper) AppModule {
-	return AppModule{k}
+func NewAppModule(cdc codec.Codec, k Keeper) AppModule {
+	return AppModule{cdc: cdc, keeper: k}
 }
 
 // Name returns the ModuleName
@@ -75,14 +79,37 @@ func (AppModule) GetTxCmd() *cobra.Command {
 	return cli.GetTxCmd()
 }
 
-// DefaultGenesis returns the blob module's default genesis state.
-func (am AppModule) DefaultGenesis(_ codec.JSONCodec) json.RawMessage {
-	return []byte("{}")
+// DefaultGenesis returns the signal module's default genesis state.
+func (am AppModule) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(types.DefaultGenesis())
 }
 
-// ValidateGenesis is always successful, as we ignore the value.
-func (am AppModule) ValidateGenesis(_ codec.JSONCodec, _ client.TxEncodingConfig, _ json.RawMessage) error {
-	return nil
+// ValidateGenesis performs genesis state validation for the signal module.
+func (am AppModule) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	var genState types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+
+	return genState.Validate()
+}
+
+// InitGenesis performs the signal module's genesis initialization.
+func (am AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, gs json.RawMessage) {
+	var genState types.GenesisState
+	if err := am.cdc.UnmarshalJSON(gs, &genState); err != nil {
+		panic(fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err))
+	}
+
+	if err := am.keeper.InitGenesis(ctx, genState); err != nil {
+		panic(fmt.Errorf("failed to initialize %s genesis state: %w", types.ModuleName, err))
+	}
+}
+
+// ExportGenesis returns the signal module's exported genesis state as raw JSON
+// bytes.
+func (am AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMessage {
+	return am.cdc.MustMarshalJSON(am.keeper.ExportGenesis(ctx))
 }
 
 // RegisterServices registers a GRPC query service to respond to the
@@ -0,0 +1,80 @@
+package signal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v5/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v5/x/signal/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetParams returns the params of the module. The default params are returned
+// if none are stored, which is the case until governance or genesis sets
+// them.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if len(bz) == 0 {
+		return types.DefaultParams()
+	}
+
+	var params types.Params
+	k.binaryCodec.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the params of the module.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.binaryCodec.MustMarshal(&params)
+	store.Set(types.ParamsKey, bz)
+}
+
+// UpdateParams is a method required by the MsgServer interface. It sets the
+// params of the module. Only the authority can update the params, from app
+// version 6 onwards.
+func (k *Keeper) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if req.Authority != k.authority {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("invalid authority: expected: %s, got: %s", k.authority, req.Authority)
+	}
+	if !k.supportsV6(sdkCtx) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("the signal params are not supported before app version %d", appconsts.V6)
+	}
+	if err := req.Params.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	k.SetParams(sdkCtx, req.Params)
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// Params returns the params of the module.
+func (k Keeper) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: k.GetParams(sdk.UnwrapSDKContext(ctx))}, nil
+}
+
+// InitGenesis initializes the signal module's state from a provided genesis
+// state. Before app version 6 the params are not stored, so they must be the
+// default params.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) error {
+	params := genState.GetParamsOrDefault()
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("invalid signal genesis state parameters: %w", err)
+	}
+	if !k.supportsV6(ctx) {
+		if !params.IsDefault() {
+			return fmt.Errorf("invalid signal genesis state parameters: the signal params are not supported before app version %d", appconsts.V6)
+		}
+		return nil
+	}
+	k.SetParams(ctx, params)
+	return nil
+}
+
+// ExportGenesis returns the signal module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	params := k.GetParams(ctx)
+	return &types.GenesisState{Params: &params}
+}
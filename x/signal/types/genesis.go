@@ -0,0 +1,24 @@
+package types
+
+// DefaultGenesis returns the default genesis state.
+func DefaultGenesis() *GenesisState {
+	params := DefaultParams()
+	return &GenesisState{
+		Params: &params,
+	}
+}
+
+// GetParamsOrDefault returns the params of the genesis state, or the default
+// params if they are not set.
+func (gs GenesisState) GetParamsOrDefault() Params {
+	if gs.Params == nil {
+		return DefaultParams()
+	}
+	return *gs.Params
+}
+
+// Validate performs basic validation of genesis data returning an error for
+// any failed validation criteria.
+func (gs GenesisState) Validate() error {
+	return gs.GetParamsOrDefault().Validate()
+}
@@ -1,5 +1,7 @@
 package types
 
+import "bytes"
+
 var (
 	// UpgradeKey is the key in the signal store used to persist an upgrade if one is
 	// pending.
@@ -9,4 +11,18 @@ var (
 	// the keys associated with signals from validators. In practice, this key
 	// isn't expected to be set or retrieved.
 	FirstSignalKey = []byte{0x000}
+
+	// UpgradeHistoryKey is the key in the signal store used to persist the
+	// history of upgrades. Like the UpgradeKey, it is a single byte so it can't
+	// collide with the validator addresses that key the signals.
+	UpgradeHistoryKey = []byte{0x01}
+
+	// ParamsKey is the key in the signal store used to persist the params.
+	ParamsKey = []byte{0x02}
 )
+
+// IsSignalKey returns true if the key of the signal store is the address of a
+// validator, as opposed to one of the reserved keys.
+func IsSignalKey(key []byte) bool {
+	return !bytes.Equal(key, UpgradeKey) && !bytes.Equal(key, UpgradeHistoryKey) && !bytes.Equal(key, ParamsKey)
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,17 +14,25 @@ const (
 
 	URLMsgSignalVersion = "/celestia.signal.v1.Msg/SignalVersion"
 	URLMsgTryUpgrade    = "/celestia.signal.v1.Msg/TryUpgrade"
+	URLMsgCancelUpgrade = "/celestia.signal.v1.Msg/CancelUpgrade"
+	URLMsgUpdateParams  = "/celestia.signal.v1.Msg/UpdateParams"
 
 	EventTypeTryUpgrade    = "signal_try_upgrade"
 	EventTypeSignalVersion = "signal_version"
+	EventTypeCancelUpgrade = "signal_cancel_upgrade"
 
 	AttributeKeyValidatorAddress = "validator_address"
 	AttributeKeySigner           = "signer"
+	AttributeKeyAppVersion       = "app_version"
+	AttributeKeyUpgradeHeight    = "upgrade_height"
+	AttributeKeyReason           = "reason"
 )
 
 var (
 	_ sdk.Msg = &MsgSignalVersion{}
 	_ sdk.Msg = &MsgTryUpgrade{}
+	_ sdk.Msg = &MsgCancelUpgrade{}
+	_ sdk.Msg = &MsgUpdateParams{}
 )
 
 var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
@@ -34,9 +44,39 @@ func NewMsgSignalVersion(valAddress string, version uint64) *MsgSignalVersion {
 	}
 }
 
+// NewMsgSignalVersionWithWindow returns a MsgSignalVersion that signals for
+// the version to activate within the window of heights.
+func NewMsgSignalVersionWithWindow(valAddress string, version uint64, window ActivationWindow) *MsgSignalVersion {
+	msg := NewMsgSignalVersion(valAddress, version)
+	msg.ActivationWindow = &window
+	return msg
+}
+
 func (msg *MsgSignalVersion) ValidateBasic() error {
 	_, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
-	return err
+	if err != nil {
+		return err
+	}
+	if msg.ActivationWindow != nil {
+		return msg.ActivationWindow.Validate()
+	}
+	return nil
+}
+
+// Validate returns an error if the window is empty.
+func (w ActivationWindow) Validate() error {
+	if w.StartHeight < 0 || w.EndHeight < 0 {
+		return ErrInvalidActivation.Wrapf("heights can not be negative: start %d, end %d", w.StartHeight, w.EndHeight)
+	}
+	if w.EndHeight != 0 && w.EndHeight < w.StartHeight {
+		return ErrInvalidActivation.Wrapf("end height %d is less than start height %d", w.EndHeight, w.StartHeight)
+	}
+	return nil
+}
+
+// Contains returns true if the height is within the window.
+func (w ActivationWindow) Contains(height int64) bool {
+	return height >= w.StartHeight && (w.EndHeight == 0 || height <= w.EndHeight)
 }
 
 func NewMsgTryUpgrade(signer sdk.AccAddress) *MsgTryUpgrade {
@@ -49,3 +89,38 @@ func (msg *MsgTryUpgrade) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(msg.Signer)
 	return err
 }
+
+// NewMsgCancelUpgrade returns a MsgCancelUpgrade that cancels the pending
+// upgrade for the reason. The authority is the governance module account.
+func NewMsgCancelUpgrade(authority, reason string) *MsgCancelUpgrade {
+	return &MsgCancelUpgrade{
+		Authority: authority,
+		Reason:    reason,
+	}
+}
+
+func (msg *MsgCancelUpgrade) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+	if msg.Reason == "" {
+		return fmt.Errorf("a reason is required to cancel an upgrade")
+	}
+	return nil
+}
+
+// NewMsgUpdateParams returns a MsgUpdateParams that sets the params. The
+// authority is the governance module account.
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+	return msg.Params.Validate()
+}
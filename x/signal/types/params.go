@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+var (
+	// DefaultThreshold is 5/6 or approximately 83.33%. It is the middle point
+	// between 2/3 and 3/3 providing 1/6 fault tolerance to halting the network
+	// during an upgrade period.
+	DefaultThreshold = math.LegacyNewDec(5).Quo(math.LegacyNewDec(6))
+
+	// MinThreshold is the lowest threshold that the params accept. With less
+	// than 2/3 of the voting power running the new version, the network can't
+	// produce blocks after the upgrade. It is rounded up so that it is not
+	// below 2/3.
+	MinThreshold = math.LegacyNewDec(2).QuoRoundUp(math.LegacyNewDec(3))
+
+	// MaxThreshold is the highest threshold that the params accept.
+	MaxThreshold = math.LegacyOneDec()
+)
+
+// DefaultParams returns the default parameters for the module.
+func DefaultParams() Params {
+	return NewParams(DefaultThreshold)
+}
+
+// NewParams creates a new instance of Params with the threshold and no
+// version thresholds.
+func NewParams(threshold math.LegacyDec) Params {
+	return Params{
+		Threshold: threshold,
+	}
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if err := validateThreshold(p.Threshold); err != nil {
+		return err
+	}
+	seen := make(map[uint64]bool, len(p.VersionThresholds))
+	for _, vt := range p.VersionThresholds {
+		if seen[vt.AppVersion] {
+			return fmt.Errorf("duplicate threshold for app version %d", vt.AppVersion)
+		}
+		seen[vt.AppVersion] = true
+		if err := validateThreshold(vt.Threshold); err != nil {
+			return fmt.Errorf("app version %d: %w", vt.AppVersion, err)
+		}
+	}
+	return nil
+}
+
+// IsDefault returns true if the params are the default params.
+func (p Params) IsDefault() bool {
+	return p.Threshold.Equal(DefaultThreshold) && len(p.VersionThresholds) == 0
+}
+
+// ThresholdFor returns the threshold for upgrades to the app version.
+func (p Params) ThresholdFor(appVersion uint64) math.LegacyDec {
+	for _, vt := range p.VersionThresholds {
+		if vt.AppVersion == appVersion {
+			return vt.Threshold
+		}
+	}
+	return p.Threshold
+}
+
+func validateThreshold(threshold math.LegacyDec) error {
+	if threshold.IsNil() || threshold.LT(MinThreshold) || threshold.GT(MaxThreshold) {
+		return fmt.Errorf("threshold must be in [%s, %s]: %s", MinThreshold, MaxThreshold, threshold)
+	}
+	return nil
+}
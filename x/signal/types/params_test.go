@@ -0,0 +1,96 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v5/x/signal/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		params  types.Params
+		wantErr bool
+	}{
+		{
+			name:   "default params",
+			params: types.DefaultParams(),
+		},
+		{
+			name:   "min threshold",
+			params: types.NewParams(types.MinThreshold),
+		},
+		{
+			name:   "max threshold",
+			params: types.NewParams(types.MaxThreshold),
+		},
+		{
+			name:    "unset threshold",
+			params:  types.Params{},
+			wantErr: true,
+		},
+		{
+			name:    "threshold below 2/3",
+			params:  types.NewParams(math.LegacyNewDecWithPrec(6, 1)),
+			wantErr: true,
+		},
+		{
+			name:    "threshold truncated below 2/3",
+			params:  types.NewParams(math.LegacyMustNewDecFromStr("0.666666666666666666")),
+			wantErr: true,
+		},
+		{
+			name:   "threshold rounded up above 2/3",
+			params: types.NewParams(math.LegacyMustNewDecFromStr("0.666666666666666667")),
+		},
+		{
+			name:    "threshold above 1",
+			params:  types.NewParams(math.LegacyNewDecWithPrec(11, 1)),
+			wantErr: true,
+		},
+		{
+			name: "version threshold below 2/3",
+			params: types.Params{
+				Threshold:         types.DefaultThreshold,
+				VersionThresholds: []types.VersionThreshold{{AppVersion: 5, Threshold: math.LegacyNewDecWithPrec(5, 1)}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate version thresholds",
+			params: types.Params{
+				Threshold: types.DefaultThreshold,
+				VersionThresholds: []types.VersionThreshold{
+					{AppVersion: 5, Threshold: types.MaxThreshold},
+					{AppVersion: 5, Threshold: types.MinThreshold},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMinThreshold(t *testing.T) {
+	require.True(t, types.MinThreshold.MulInt64(3).GTE(math.LegacyNewDec(2)))
+}
+
+func TestThresholdFor(t *testing.T) {
+	params := types.Params{
+		Threshold:         types.DefaultThreshold,
+		VersionThresholds: []types.VersionThreshold{{AppVersion: 5, Threshold: types.MinThreshold}},
+	}
+	require.Equal(t, types.MinThreshold, params.ThresholdFor(5))
+	require.Equal(t, types.DefaultThreshold, params.ThresholdFor(6))
+}
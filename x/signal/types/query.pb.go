@@ -5,7 +5,10 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -78,6 +81,9 @@ type QueryVersionTallyResponse struct {
 	VotingPower      uint64 `protobuf:"varint,1,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
 	ThresholdPower   uint64 `protobuf:"varint,2,opt,name=threshold_power,json=thresholdPower,proto3" json:"threshold_power,omitempty"`
 	TotalVotingPower uint64 `protobuf:"varint,3,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+	// threshold is the fraction of the total voting power that must signal for
+	// the version.
+	Threshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=threshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"threshold"`
 }
 
 func (m *QueryVersionTallyResponse) Reset()         { *m = QueryVersionTallyResponse{} }
@@ -174,6 +180,9 @@ var xxx_messageInfo_QueryGetUpgradeRequest proto.InternalMessageInfo
 // QueryGetUpgradeResponse is the response type for the GetUpgrade query.
 type QueryGetUpgradeResponse struct {
 	Upgrade *Upgrade `protobuf:"bytes,1,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
+	// History lists the upgrades that were scheduled, cancelled or applied,
+	// oldest first.
+	History []*UpgradeRecord `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
 }
 
 func (m *QueryGetUpgradeResponse) Reset()         { *m = QueryGetUpgradeResponse{} }
@@ -216,43 +225,257 @@ func (m *QueryGetUpgradeResponse) GetUpgrade() *Upgrade {
 	return nil
 }
 
+func (m *QueryGetUpgradeResponse) GetHistory() []*UpgradeRecord {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+// QueryParamsRequest is the request type for the Params query.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{4}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Params query.
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{5}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryValidatorSignalsRequest is the request type for the ValidatorSignals
+// query.
+type QueryValidatorSignalsRequest struct {
+	// version is the version to report the participation for. The participation
+	// is not reported if it is zero.
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *QueryValidatorSignalsRequest) Reset()         { *m = QueryValidatorSignalsRequest{} }
+func (m *QueryValidatorSignalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorSignalsRequest) ProtoMessage()    {}
+func (*QueryValidatorSignalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{6}
+}
+func (m *QueryValidatorSignalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorSignalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorSignalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorSignalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorSignalsRequest.Merge(m, src)
+}
+func (m *QueryValidatorSignalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorSignalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorSignalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorSignalsRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorSignalsRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// QueryValidatorSignalsResponse is the response type for the ValidatorSignals
+// query.
+type QueryValidatorSignalsResponse struct {
+	// signals lists the bonded validators ordered by voting power.
+	Signals []ValidatorSignal `protobuf:"bytes,1,rep,name=signals,proto3" json:"signals"`
+	// participation is the participation for the requested version.
+	Participation *Participation `protobuf:"bytes,2,opt,name=participation,proto3" json:"participation,omitempty"`
+}
+
+func (m *QueryValidatorSignalsResponse) Reset()         { *m = QueryValidatorSignalsResponse{} }
+func (m *QueryValidatorSignalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorSignalsResponse) ProtoMessage()    {}
+func (*QueryValidatorSignalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{7}
+}
+func (m *QueryValidatorSignalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorSignalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorSignalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorSignalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorSignalsResponse.Merge(m, src)
+}
+func (m *QueryValidatorSignalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorSignalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorSignalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorSignalsResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorSignalsResponse) GetSignals() []ValidatorSignal {
+	if m != nil {
+		return m.Signals
+	}
+	return nil
+}
+
+func (m *QueryValidatorSignalsResponse) GetParticipation() *Participation {
+	if m != nil {
+		return m.Participation
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryVersionTallyRequest)(nil), "celestia.signal.v1.QueryVersionTallyRequest")
 	proto.RegisterType((*QueryVersionTallyResponse)(nil), "celestia.signal.v1.QueryVersionTallyResponse")
 	proto.RegisterType((*QueryGetUpgradeRequest)(nil), "celestia.signal.v1.QueryGetUpgradeRequest")
 	proto.RegisterType((*QueryGetUpgradeResponse)(nil), "celestia.signal.v1.QueryGetUpgradeResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "celestia.signal.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.signal.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryValidatorSignalsRequest)(nil), "celestia.signal.v1.QueryValidatorSignalsRequest")
+	proto.RegisterType((*QueryValidatorSignalsResponse)(nil), "celestia.signal.v1.QueryValidatorSignalsResponse")
 }
 
 func init() { proto.RegisterFile("celestia/signal/v1/query.proto", fileDescriptor_7af24246367e432c) }
 
 var fileDescriptor_7af24246367e432c = []byte{
-	// 411 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x4f, 0xe2, 0x40,
-	0x18, 0xc6, 0x19, 0xf6, 0x0f, 0xc9, 0x40, 0x76, 0x37, 0x93, 0xcd, 0x6e, 0xb7, 0x4b, 0x1a, 0xb6,
-	0x97, 0xdd, 0xac, 0xd0, 0x09, 0xa8, 0x5f, 0xc0, 0x8b, 0x17, 0x0f, 0x48, 0x94, 0x83, 0x17, 0x32,
-	0xc0, 0xa4, 0x34, 0xa9, 0x9d, 0xa1, 0x33, 0xad, 0x12, 0xe3, 0x41, 0xbf, 0x80, 0x26, 0xc6, 0xa3,
-	0xdf, 0xc7, 0x23, 0x89, 0x17, 0x8f, 0x06, 0xfc, 0x20, 0x86, 0x99, 0x16, 0x30, 0x85, 0x84, 0x5b,
-	0xfb, 0xbe, 0xbf, 0xf7, 0x99, 0xe7, 0x7d, 0x66, 0xa0, 0xd5, 0xa3, 0x3e, 0x15, 0xd2, 0x23, 0x58,
-	0x78, 0x6e, 0x40, 0x7c, 0x1c, 0xd7, 0xf1, 0x30, 0xa2, 0xe1, 0xc8, 0xe1, 0x21, 0x93, 0x0c, 0xa1,
-	0xb4, 0xef, 0xe8, 0xbe, 0x13, 0xd7, 0xcd, 0xb2, 0xcb, 0x98, 0xeb, 0x53, 0x4c, 0xb8, 0x87, 0x49,
-	0x10, 0x30, 0x49, 0xa4, 0xc7, 0x02, 0xa1, 0x27, 0xcc, 0xca, 0x0a, 0xc5, 0x88, 0xbb, 0x21, 0xe9,
-	0x53, 0x4d, 0xd8, 0x3b, 0xd0, 0x38, 0x9c, 0x1d, 0xd1, 0xa6, 0xa1, 0xf0, 0x58, 0x70, 0x44, 0x7c,
-	0x7f, 0xd4, 0xa2, 0xc3, 0x88, 0x0a, 0x89, 0x0c, 0x58, 0x88, 0x75, 0xd9, 0x00, 0x15, 0xf0, 0xef,
-	0x63, 0x2b, 0xfd, 0xb5, 0xef, 0x01, 0xfc, 0xb5, 0x62, 0x4c, 0x70, 0x16, 0x08, 0x8a, 0xfe, 0xc0,
-	0x52, 0xcc, 0xa4, 0x17, 0xb8, 0x1d, 0xce, 0xce, 0x68, 0x98, 0x0c, 0x17, 0x75, 0xad, 0x39, 0x2b,
-	0xa1, 0xbf, 0xf0, 0xab, 0x1c, 0x84, 0x54, 0x0c, 0x98, 0xdf, 0x4f, 0xa8, 0xbc, 0xa2, 0xbe, 0xcc,
-	0xcb, 0x1a, 0xac, 0x42, 0x24, 0x99, 0x24, 0x7e, 0xe7, 0x9d, 0xe2, 0x07, 0xc5, 0x7e, 0x53, 0x9d,
-	0xf6, 0x42, 0xd6, 0x36, 0xe0, 0x0f, 0x65, 0x6b, 0x9f, 0xca, 0x63, 0xbd, 0x66, 0xb2, 0x8b, 0xdd,
-	0x84, 0x3f, 0x33, 0x9d, 0xc4, 0xee, 0x2e, 0x2c, 0x24, 0x99, 0x28, 0xa7, 0xc5, 0xc6, 0x6f, 0x27,
-	0x1b, 0xb4, 0x93, 0x4e, 0xa5, 0x6c, 0xe3, 0x21, 0x0f, 0x3f, 0x29, 0x49, 0x74, 0x03, 0x60, 0x69,
-	0x39, 0x08, 0x54, 0x5d, 0x25, 0xb0, 0x2e, 0x66, 0xb3, 0xb6, 0x21, 0xad, 0xed, 0xda, 0xf6, 0xf5,
-	0xd3, 0xeb, 0x5d, 0xbe, 0x8c, 0xcc, 0xa5, 0x3b, 0x95, 0x33, 0x02, 0x5f, 0x24, 0xd7, 0x73, 0x89,
-	0xae, 0x00, 0x84, 0x8b, 0x4d, 0xd1, 0xff, 0xb5, 0x27, 0x64, 0x82, 0x32, 0xb7, 0x36, 0x62, 0x13,
-	0x2f, 0xa6, 0xf2, 0xf2, 0x1d, 0xa1, 0xec, 0xfb, 0xda, 0x3b, 0x78, 0x9c, 0x58, 0x60, 0x3c, 0xb1,
-	0xc0, 0xcb, 0xc4, 0x02, 0xb7, 0x53, 0x2b, 0x37, 0x9e, 0x5a, 0xb9, 0xe7, 0xa9, 0x95, 0x3b, 0x69,
-	0xb8, 0x9e, 0x1c, 0x44, 0x5d, 0xa7, 0xc7, 0x4e, 0x71, 0x7a, 0x18, 0x0b, 0xdd, 0xf9, 0x77, 0x8d,
-	0x70, 0x8e, 0xcf, 0x53, 0x49, 0x39, 0xe2, 0x54, 0x74, 0x3f, 0xab, 0xe7, 0xba, 0xfd, 0x16, 0x00,
-	0x00, 0xff, 0xff, 0x5b, 0x0c, 0x46, 0x0b, 0x24, 0x03, 0x00, 0x00,
+	// 682 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x4f, 0xd4, 0x40,
+	0x14, 0xde, 0xc2, 0xca, 0x86, 0x07, 0x2a, 0x0e, 0x28, 0x4b, 0x81, 0xb2, 0xd4, 0x44, 0x88, 0x42,
+	0xeb, 0xae, 0x9a, 0x90, 0x78, 0x43, 0x12, 0x2e, 0x24, 0xe0, 0xaa, 0x1c, 0xbc, 0x90, 0xa1, 0x3b,
+	0xe9, 0x36, 0x76, 0x3b, 0xa5, 0x33, 0xbb, 0xba, 0x31, 0x1e, 0xf4, 0x6e, 0x34, 0x31, 0xf1, 0x57,
+	0xe8, 0xcd, 0x1f, 0xc1, 0x91, 0xe8, 0xc5, 0x78, 0x20, 0x06, 0x8c, 0xbf, 0xc3, 0x74, 0x66, 0xba,
+	0xec, 0xb2, 0x2d, 0xe0, 0xad, 0x7d, 0xef, 0xfb, 0xde, 0xfb, 0xde, 0x9b, 0x6f, 0x06, 0x0c, 0x87,
+	0xf8, 0x84, 0x71, 0x0f, 0xdb, 0xcc, 0x73, 0x03, 0xec, 0xdb, 0xad, 0xb2, 0xbd, 0xd7, 0x24, 0x51,
+	0xdb, 0x0a, 0x23, 0xca, 0x29, 0x42, 0x49, 0xde, 0x92, 0x79, 0xab, 0x55, 0xd6, 0x67, 0x5c, 0x4a,
+	0x5d, 0x9f, 0xd8, 0x38, 0xf4, 0x6c, 0x1c, 0x04, 0x94, 0x63, 0xee, 0xd1, 0x80, 0x49, 0x86, 0x5e,
+	0x4a, 0xa9, 0xd8, 0x0c, 0xdd, 0x08, 0xd7, 0x88, 0x42, 0xcc, 0xa5, 0x20, 0x42, 0x1c, 0xe1, 0x06,
+	0x3b, 0x03, 0xa0, 0xda, 0x4b, 0xc0, 0x84, 0x4b, 0x5d, 0x2a, 0x3e, 0xed, 0xf8, 0x4b, 0x45, 0xa7,
+	0x1c, 0xca, 0x1a, 0x94, 0xed, 0xc8, 0x84, 0xfc, 0x91, 0x29, 0xf3, 0x3e, 0x14, 0x1f, 0xc7, 0x53,
+	0x6d, 0x93, 0x88, 0x79, 0x34, 0x78, 0x8a, 0x7d, 0xbf, 0x5d, 0x25, 0x7b, 0x4d, 0xc2, 0x38, 0x2a,
+	0x42, 0xa1, 0x25, 0xc3, 0x45, 0xad, 0xa4, 0x2d, 0xe6, 0xab, 0xc9, 0xaf, 0xf9, 0x57, 0x83, 0xa9,
+	0x14, 0x1a, 0x0b, 0x69, 0xc0, 0x08, 0x9a, 0x87, 0xd1, 0x16, 0xe5, 0x5e, 0xe0, 0xee, 0x84, 0xf4,
+	0x25, 0x89, 0x14, 0x79, 0x44, 0xc6, 0xb6, 0xe2, 0x10, 0x5a, 0x80, 0xab, 0xbc, 0x1e, 0x11, 0x56,
+	0xa7, 0x7e, 0x4d, 0xa1, 0x06, 0x04, 0xea, 0x4a, 0x27, 0x2c, 0x81, 0x4b, 0x80, 0x38, 0xe5, 0xd8,
+	0xdf, 0xe9, 0xa9, 0x38, 0x28, 0xb0, 0x63, 0x22, 0xb3, 0xdd, 0x55, 0x76, 0x13, 0x86, 0x3b, 0xfc,
+	0x62, 0xbe, 0xa4, 0x2d, 0x0e, 0xaf, 0x96, 0xf7, 0x0f, 0xe7, 0x72, 0xbf, 0x0e, 0xe7, 0xa6, 0xe5,
+	0xd8, 0xac, 0xf6, 0xc2, 0xf2, 0xa8, 0xdd, 0xc0, 0xbc, 0x6e, 0x6d, 0x10, 0x17, 0x3b, 0xed, 0x35,
+	0xe2, 0x7c, 0xff, 0xb6, 0x0c, 0x6a, 0x2b, 0x6b, 0xc4, 0xa9, 0x9e, 0xd4, 0x30, 0x8b, 0x70, 0x43,
+	0xcc, 0xb9, 0x4e, 0xf8, 0x33, 0x79, 0x54, 0x6a, 0x39, 0xe6, 0x7b, 0x0d, 0x26, 0xfb, 0x52, 0x6a,
+	0x01, 0x0f, 0xa0, 0xa0, 0x0e, 0x56, 0xcc, 0x3e, 0x52, 0x99, 0xb6, 0xfa, 0xdd, 0x62, 0x25, 0xac,
+	0x04, 0x8b, 0x1e, 0x42, 0xa1, 0xee, 0x31, 0x4e, 0xa3, 0x76, 0x71, 0xa0, 0x34, 0xb8, 0x38, 0x52,
+	0x99, 0x3f, 0x8b, 0x46, 0x1c, 0x1a, 0xd5, 0xaa, 0x09, 0xc3, 0x9c, 0x00, 0x24, 0xe4, 0x6c, 0x09,
+	0xbf, 0x24, 0x2a, 0x37, 0x61, 0xbc, 0x27, 0xaa, 0x04, 0xae, 0xc0, 0x90, 0xf4, 0x95, 0xd2, 0xa7,
+	0xa7, 0x35, 0x92, 0x9c, 0xd5, 0x7c, 0xbc, 0xc0, 0xaa, 0xc2, 0x9b, 0x2b, 0x30, 0x23, 0x0f, 0x1e,
+	0xfb, 0x5e, 0x0d, 0x73, 0x1a, 0x3d, 0x11, 0x78, 0x76, 0xbe, 0x67, 0xbe, 0x6a, 0x30, 0x9b, 0x41,
+	0x55, 0xaa, 0x1e, 0x41, 0x41, 0x76, 0x8f, 0x65, 0xc5, 0xf3, 0xdf, 0x4c, 0x93, 0x75, 0x8a, 0xae,
+	0xf4, 0x25, 0x4c, 0xb4, 0x0e, 0x97, 0x43, 0x1c, 0x71, 0xcf, 0xf1, 0x42, 0x71, 0xfb, 0x84, 0xaf,
+	0x32, 0x56, 0xb9, 0xd5, 0x0d, 0xac, 0xf6, 0xf2, 0x2a, 0x5f, 0xf2, 0x70, 0x49, 0xe8, 0x45, 0x1f,
+	0x34, 0x18, 0xed, 0x36, 0x3a, 0x5a, 0x4a, 0x2b, 0x96, 0x75, 0x8d, 0xf4, 0xe5, 0x0b, 0xa2, 0xe5,
+	0x16, 0x4c, 0xf3, 0xdd, 0x8f, 0x3f, 0x9f, 0x06, 0x66, 0x90, 0xde, 0x75, 0xc7, 0x79, 0x8c, 0xb0,
+	0x5f, 0xab, 0x55, 0xbe, 0x41, 0x6f, 0x35, 0x80, 0x13, 0xdf, 0xa1, 0xdb, 0x99, 0x1d, 0xfa, 0x7c,
+	0xab, 0xdf, 0xb9, 0x10, 0x56, 0x69, 0xd1, 0x85, 0x96, 0x09, 0x84, 0xfa, 0x9f, 0x2c, 0xc4, 0x61,
+	0x48, 0x3a, 0x04, 0xdd, 0xca, 0x2c, 0xd9, 0x63, 0x46, 0x7d, 0xe1, 0x5c, 0x9c, 0x6a, 0x3b, 0x25,
+	0xda, 0x8e, 0xa3, 0x6b, 0x7d, 0xef, 0x20, 0xfa, 0xac, 0xc1, 0xd8, 0x69, 0x03, 0xa1, 0xbb, 0xd9,
+	0x1b, 0x4e, 0xb7, 0xa9, 0x5e, 0xfe, 0x0f, 0x86, 0x12, 0x35, 0x2b, 0x44, 0x4d, 0xa2, 0xeb, 0x5d,
+	0xa2, 0x5a, 0x09, 0x98, 0xad, 0x6e, 0xec, 0x1f, 0x19, 0xda, 0xc1, 0x91, 0xa1, 0xfd, 0x3e, 0x32,
+	0xb4, 0x8f, 0xc7, 0x46, 0xee, 0xe0, 0xd8, 0xc8, 0xfd, 0x3c, 0x36, 0x72, 0xcf, 0x2b, 0xae, 0xc7,
+	0xeb, 0xcd, 0x5d, 0xcb, 0xa1, 0x0d, 0x3b, 0xe9, 0x4a, 0x23, 0xb7, 0xf3, 0xbd, 0x8c, 0xc3, 0xd0,
+	0x7e, 0x95, 0x54, 0xe5, 0xed, 0x90, 0xb0, 0xdd, 0x21, 0xf1, 0x3a, 0xdf, 0xfb, 0x17, 0x00, 0x00,
+	0xff, 0xff, 0x57, 0xfb, 0xf7, 0x80, 0x86, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -271,8 +494,15 @@ type QueryClient interface {
 	// has signalled for a particular version.
 	VersionTally(ctx context.Context, in *QueryVersionTallyRequest, opts ...grpc.CallOption) (*QueryVersionTallyResponse, error)
 	// GetUpgrade enables a client to query for upgrade information if an upgrade
-	// is pending. The response will be empty if no upgrade is pending.
+	// is pending. The upgrade will be empty if no upgrade is pending. The
+	// history of upgrades is always returned.
 	GetUpgrade(ctx context.Context, in *QueryGetUpgradeRequest, opts ...grpc.CallOption) (*QueryGetUpgradeResponse, error)
+	// Params queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ValidatorSignals lists the signal of every bonded validator, including
+	// the validators that haven't signalled, along with the participation for a
+	// version.
+	ValidatorSignals(ctx context.Context, in *QueryValidatorSignalsRequest, opts ...grpc.CallOption) (*QueryValidatorSignalsResponse, error)
 }
 
 type queryClient struct {
@@ -301,14 +531,39 @@ func (c *queryClient) GetUpgrade(ctx context.Context, in *QueryGetUpgradeRequest
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.signal.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorSignals(ctx context.Context, in *QueryValidatorSignalsRequest, opts ...grpc.CallOption) (*QueryValidatorSignalsResponse, error) {
+	out := new(QueryValidatorSignalsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.signal.v1.Query/ValidatorSignals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// VersionTally enables a client to query for the tally of voting power that
 	// has signalled for a particular version.
 	VersionTally(context.Context, *QueryVersionTallyRequest) (*QueryVersionTallyResponse, error)
 	// GetUpgrade enables a client to query for upgrade information if an upgrade
-	// is pending. The response will be empty if no upgrade is pending.
+	// is pending. The upgrade will be empty if no upgrade is pending. The
+	// history of upgrades is always returned.
 	GetUpgrade(context.Context, *QueryGetUpgradeRequest) (*QueryGetUpgradeResponse, error)
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ValidatorSignals lists the signal of every bonded validator, including
+	// the validators that haven't signalled, along with the participation for a
+	// version.
+	ValidatorSignals(context.Context, *QueryValidatorSignalsRequest) (*QueryValidatorSignalsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -321,6 +576,12 @@ func (*UnimplementedQueryServer) VersionTally(ctx context.Context, req *QueryVer
 func (*UnimplementedQueryServer) GetUpgrade(ctx context.Context, req *QueryGetUpgradeRequest) (*QueryGetUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUpgrade not implemented")
 }
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorSignals(ctx context.Context, req *QueryValidatorSignalsRequest) (*QueryValidatorSignalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSignals not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -362,6 +623,42 @@ func _Query_GetUpgrade_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.signal.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorSignals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorSignalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorSignals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.signal.v1.Query/ValidatorSignals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorSignals(ctx, req.(*QueryValidatorSignalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.signal.v1.Query",
@@ -375,6 +672,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetUpgrade",
 			Handler:    _Query_GetUpgrade_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ValidatorSignals",
+			Handler:    _Query_ValidatorSignals_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/signal/v1/query.proto",
@@ -428,6 +733,16 @@ func (m *QueryVersionTallyResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if m.TotalVotingPower != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.TotalVotingPower))
 		i--
@@ -489,6 +804,20 @@ func (m *QueryGetUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.Upgrade != nil {
 		{
 			size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
@@ -504,74 +833,266 @@ func (m *QueryGetUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryVersionTallyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Version != 0 {
-		n += 1 + sovQuery(uint64(m.Version))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryVersionTallyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.VotingPower != 0 {
-		n += 1 + sovQuery(uint64(m.VotingPower))
-	}
-	if m.ThresholdPower != 0 {
-		n += 1 + sovQuery(uint64(m.ThresholdPower))
-	}
-	if m.TotalVotingPower != 0 {
-		n += 1 + sovQuery(uint64(m.TotalVotingPower))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetUpgradeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryValidatorSignalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetUpgradeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryValidatorSignalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorSignalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Upgrade != nil {
-		l = m.Upgrade.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryValidatorSignalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorSignalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorSignalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Participation != nil {
+		{
+			size, err := m.Participation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signals) > 0 {
+		for iNdEx := len(m.Signals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryVersionTallyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryVersionTallyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	if m.ThresholdPower != 0 {
+		n += 1 + sovQuery(uint64(m.ThresholdPower))
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalVotingPower))
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetUpgradeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Upgrade != nil {
+		l = m.Upgrade.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorSignalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryValidatorSignalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signals) > 0 {
+		for _, e := range m.Signals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Participation != nil {
+		l = m.Participation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *QueryVersionTallyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -728,6 +1249,40 @@ func (m *QueryVersionTallyResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -864,6 +1419,362 @@ func (m *QueryGetUpgradeResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, &UpgradeRecord{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorSignalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorSignalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorSignalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorSignalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorSignalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorSignalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signals = append(m.Signals, ValidatorSignal{})
+			if err := m.Signals[len(m.Signals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Participation == nil {
+				m.Participation = &Participation{}
+			}
+			if err := m.Participation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -34,6 +35,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type MsgSignalVersion struct {
 	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	Version          uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// ActivationWindow is the optional range of heights at which the validator
+	// prefers the version to activate.
+	ActivationWindow *ActivationWindow `protobuf:"bytes,3,opt,name=activation_window,json=activationWindow,proto3" json:"activation_window,omitempty"`
 }
 
 func (m *MsgSignalVersion) Reset()         { *m = MsgSignalVersion{} }
@@ -83,6 +87,13 @@ func (m *MsgSignalVersion) GetVersion() uint64 {
 	return 0
 }
 
+func (m *MsgSignalVersion) GetActivationWindow() *ActivationWindow {
+	if m != nil {
+		return m.ActivationWindow
+	}
+	return nil
+}
+
 // MsgSignalVersionResponse is the response type for the SignalVersion method.
 type MsgSignalVersionResponse struct {
 }
@@ -202,44 +213,247 @@ func (m *MsgTryUpgradeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgTryUpgradeResponse proto.InternalMessageInfo
 
+// MsgCancelUpgrade cancels a pending upgrade and clears the signals of all
+// validators.
+type MsgCancelUpgrade struct {
+	// Authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Reason is why the upgrade is cancelled.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgCancelUpgrade) Reset()         { *m = MsgCancelUpgrade{} }
+func (m *MsgCancelUpgrade) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUpgrade) ProtoMessage()    {}
+func (*MsgCancelUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_815f2cc162e6e27e, []int{4}
+}
+func (m *MsgCancelUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUpgrade.Merge(m, src)
+}
+func (m *MsgCancelUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelUpgrade proto.InternalMessageInfo
+
+func (m *MsgCancelUpgrade) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCancelUpgrade) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// MsgCancelUpgradeResponse is the response type for the CancelUpgrade method.
+type MsgCancelUpgradeResponse struct {
+}
+
+func (m *MsgCancelUpgradeResponse) Reset()         { *m = MsgCancelUpgradeResponse{} }
+func (m *MsgCancelUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUpgradeResponse) ProtoMessage()    {}
+func (*MsgCancelUpgradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_815f2cc162e6e27e, []int{5}
+}
+func (m *MsgCancelUpgradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUpgradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUpgradeResponse.Merge(m, src)
+}
+func (m *MsgCancelUpgradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUpgradeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelUpgradeResponse proto.InternalMessageInfo
+
+// MsgUpdateParams updates the parameters of the module.
+type MsgUpdateParams struct {
+	// Authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Params are the new parameters of the module.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_815f2cc162e6e27e, []int{6}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse is the response type for the UpdateParams method.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_815f2cc162e6e27e, []int{7}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSignalVersion)(nil), "celestia.signal.v1.MsgSignalVersion")
 	proto.RegisterType((*MsgSignalVersionResponse)(nil), "celestia.signal.v1.MsgSignalVersionResponse")
 	proto.RegisterType((*MsgTryUpgrade)(nil), "celestia.signal.v1.MsgTryUpgrade")
 	proto.RegisterType((*MsgTryUpgradeResponse)(nil), "celestia.signal.v1.MsgTryUpgradeResponse")
+	proto.RegisterType((*MsgCancelUpgrade)(nil), "celestia.signal.v1.MsgCancelUpgrade")
+	proto.RegisterType((*MsgCancelUpgradeResponse)(nil), "celestia.signal.v1.MsgCancelUpgradeResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "celestia.signal.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "celestia.signal.v1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("celestia/signal/v1/tx.proto", fileDescriptor_815f2cc162e6e27e) }
 
 var fileDescriptor_815f2cc162e6e27e = []byte{
-	// 424 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4d, 0x6f, 0xd3, 0x30,
-	0x1c, 0xc6, 0xeb, 0x02, 0x43, 0x18, 0x4d, 0xda, 0xcc, 0x60, 0x59, 0x80, 0x68, 0x8b, 0x38, 0x8c,
-	0x89, 0xc6, 0x6c, 0xdc, 0x76, 0x63, 0x67, 0xba, 0x43, 0x07, 0x3b, 0x70, 0xa9, 0xdc, 0xc6, 0x32,
-	0x96, 0x92, 0x38, 0xf2, 0xdf, 0x8d, 0xda, 0x0b, 0x42, 0x15, 0x1f, 0x00, 0x09, 0x3e, 0x48, 0x0f,
-	0xfd, 0x10, 0x1c, 0xab, 0x72, 0xe1, 0x88, 0x5a, 0xa4, 0x7e, 0x0d, 0xd4, 0x38, 0x29, 0x7d, 0x11,
-	0x62, 0x37, 0xff, 0xf3, 0x3c, 0x79, 0x7e, 0x8f, 0x5f, 0xf0, 0xe3, 0x36, 0x8f, 0x38, 0x18, 0xc9,
-	0x28, 0x48, 0x91, 0xb0, 0x88, 0x66, 0xa7, 0xd4, 0x74, 0x83, 0x54, 0x2b, 0xa3, 0x08, 0x29, 0xc5,
-	0xc0, 0x8a, 0x41, 0x76, 0xea, 0x3e, 0x11, 0x4a, 0x89, 0x88, 0x53, 0x96, 0x4a, 0xca, 0x92, 0x44,
-	0x19, 0x66, 0xa4, 0x4a, 0xc0, 0xfe, 0xe1, 0xee, 0xb7, 0x15, 0xc4, 0x0a, 0x68, 0x0c, 0x62, 0x9e,
-	0x14, 0x83, 0x28, 0x84, 0x03, 0x2b, 0x34, 0xf3, 0x89, 0xda, 0xc1, 0x4a, 0xfe, 0x37, 0x84, 0x77,
-	0xea, 0x20, 0xae, 0x72, 0xc4, 0x35, 0xd7, 0x20, 0x55, 0x42, 0x2e, 0xf1, 0x6e, 0xc6, 0x22, 0x19,
-	0x32, 0xa3, 0x74, 0x93, 0x85, 0xa1, 0xe6, 0x00, 0x0e, 0x3a, 0x44, 0xc7, 0xf7, 0x2e, 0x8e, 0xc6,
-	0xc3, 0xda, 0xd3, 0x22, 0xe1, 0xba, 0xf4, 0xbc, 0xb6, 0x96, 0x2b, 0xa3, 0x65, 0x22, 0x1a, 0x3b,
-	0xd9, 0xda, 0x77, 0xe2, 0xe0, 0xbb, 0x99, 0x8d, 0x76, 0xaa, 0x87, 0xe8, 0xf8, 0x76, 0xa3, 0x1c,
-	0xcf, 0x1f, 0xf5, 0x67, 0x83, 0x93, 0x4d, 0x98, 0xef, 0x62, 0x67, 0xbd, 0x55, 0x83, 0x43, 0xaa,
-	0x12, 0xe0, 0xfe, 0x25, 0xde, 0xae, 0x83, 0x78, 0xab, 0x7b, 0xef, 0x52, 0xa1, 0x59, 0xc8, 0xc9,
-	0x4b, 0xbc, 0x35, 0x3f, 0x22, 0xae, 0x8b, 0x8e, 0xce, 0x78, 0x58, 0xdb, 0x2b, 0x3a, 0xae, 0x56,
-	0x2b, 0x7c, 0xe7, 0xf7, 0xe7, 0xd8, 0x62, 0xf0, 0xf7, 0xf1, 0xc3, 0x95, 0xbc, 0x12, 0x74, 0xf6,
-	0xb9, 0x8a, 0x6f, 0xd5, 0x41, 0x90, 0x8f, 0x78, 0x7b, 0xf5, 0x7c, 0x9e, 0x05, 0x9b, 0x77, 0x13,
-	0xac, 0xf7, 0x75, 0x5f, 0xdc, 0xc4, 0xb5, 0xd8, 0xd5, 0x41, 0xff, 0xc7, 0xef, 0xaf, 0xd5, 0x07,
-	0xfe, 0xee, 0xd2, 0x5b, 0xb0, 0x2b, 0x92, 0x61, 0xbc, 0xb4, 0xdb, 0xa3, 0x7f, 0xc4, 0xfe, 0xb5,
-	0xb8, 0xcf, 0xff, 0x6b, 0x59, 0x60, 0xdd, 0x1c, 0xbb, 0xe7, 0x93, 0x25, 0x6c, 0xa7, 0x88, 0xb9,
-	0xf3, 0x69, 0x36, 0x38, 0x41, 0x17, 0x6f, 0xbe, 0x4f, 0x3c, 0x34, 0x9a, 0x78, 0xe8, 0xd7, 0xc4,
-	0x43, 0x5f, 0xa6, 0x5e, 0x65, 0x34, 0xf5, 0x2a, 0x3f, 0xa7, 0x5e, 0xe5, 0xfd, 0x99, 0x90, 0xe6,
-	0x43, 0xa7, 0x15, 0xb4, 0x55, 0x4c, 0x4b, 0xa2, 0xd2, 0x62, 0xb1, 0xae, 0xb1, 0x34, 0xa5, 0xdd,
-	0x32, 0xd9, 0xf4, 0x52, 0x0e, 0xad, 0xad, 0xfc, 0xdd, 0xbd, 0xfa, 0x13, 0x00, 0x00, 0xff, 0xff,
-	0xac, 0xd7, 0x83, 0x5d, 0xfc, 0x02, 0x00, 0x00,
+	// 614 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x4f, 0xd4, 0x40,
+	0x14, 0xc7, 0xb7, 0x80, 0x6b, 0x18, 0x44, 0x61, 0x44, 0x28, 0x55, 0xcb, 0x52, 0x3d, 0x20, 0x4a,
+	0x2b, 0x6b, 0x62, 0x0c, 0x37, 0xf0, 0xea, 0x12, 0x2d, 0x82, 0x89, 0x17, 0x1c, 0xda, 0xc9, 0xd0,
+	0x64, 0xb7, 0xd3, 0xcc, 0xcc, 0x16, 0xf6, 0x62, 0x0c, 0x7f, 0x81, 0x09, 0xff, 0x08, 0x07, 0xfe,
+	0x08, 0x12, 0x2f, 0x04, 0x2f, 0x9e, 0x8c, 0xd9, 0x35, 0xd9, 0x7f, 0xc3, 0x6c, 0x67, 0xda, 0xdd,
+	0xee, 0x8f, 0x40, 0xbc, 0xcd, 0x9b, 0xf7, 0x99, 0xf7, 0xfd, 0xbe, 0xb7, 0xaf, 0x0b, 0x1e, 0x7a,
+	0xb8, 0x8a, 0xb9, 0x08, 0x90, 0xc3, 0x03, 0x12, 0xa2, 0xaa, 0x13, 0xaf, 0x3b, 0xe2, 0xd8, 0x8e,
+	0x18, 0x15, 0x14, 0xc2, 0x34, 0x69, 0xcb, 0xa4, 0x1d, 0xaf, 0x1b, 0x8f, 0x08, 0xa5, 0xa4, 0x8a,
+	0x1d, 0x14, 0x05, 0x0e, 0x0a, 0x43, 0x2a, 0x90, 0x08, 0x68, 0xc8, 0xe5, 0x0b, 0x63, 0xc1, 0xa3,
+	0xbc, 0x46, 0xb9, 0x53, 0xe3, 0xa4, 0x53, 0xa9, 0xc6, 0x89, 0x4a, 0x2c, 0xca, 0xc4, 0x7e, 0x12,
+	0x39, 0x32, 0x50, 0xa9, 0xd2, 0x10, 0x0b, 0xf5, 0x88, 0x30, 0xe4, 0x63, 0x45, 0x2c, 0x0d, 0x21,
+	0x22, 0xc4, 0x50, 0x2d, 0x2d, 0x31, 0x47, 0x28, 0xa1, 0xb2, 0x74, 0xe7, 0x24, 0x6f, 0xad, 0xb6,
+	0x06, 0x66, 0x2a, 0x9c, 0xec, 0x24, 0x6f, 0xf6, 0x30, 0xe3, 0x01, 0x0d, 0xe1, 0x36, 0x98, 0x8d,
+	0x51, 0x35, 0xf0, 0x91, 0xa0, 0x6c, 0x1f, 0xf9, 0x3e, 0xc3, 0x9c, 0xeb, 0x5a, 0x49, 0x5b, 0x99,
+	0xdc, 0x5a, 0xbe, 0x3a, 0x5f, 0x7b, 0xac, 0xac, 0xed, 0xa5, 0xcc, 0xa6, 0x44, 0x76, 0x04, 0x0b,
+	0x42, 0xe2, 0xce, 0xc4, 0x7d, 0xf7, 0x50, 0x07, 0xb7, 0x63, 0x59, 0x5a, 0x1f, 0x2b, 0x69, 0x2b,
+	0x13, 0x6e, 0x1a, 0xc2, 0x0f, 0x60, 0x16, 0x79, 0x22, 0x88, 0x93, 0x01, 0xed, 0x1f, 0x05, 0xa1,
+	0x4f, 0x8f, 0xf4, 0xf1, 0x92, 0xb6, 0x32, 0x55, 0x7e, 0x6a, 0x0f, 0x4e, 0xd6, 0xde, 0xcc, 0xe0,
+	0x4f, 0x09, 0xeb, 0xce, 0xa0, 0xbe, 0x9b, 0x8d, 0xf9, 0x93, 0xf6, 0xd9, 0xea, 0xa0, 0x7f, 0xcb,
+	0x00, 0x7a, 0x7f, 0xa3, 0x2e, 0xe6, 0x11, 0x0d, 0x39, 0xb6, 0xb6, 0xc1, 0x74, 0x85, 0x93, 0x8f,
+	0xac, 0xb1, 0x2b, 0x67, 0x0a, 0x5f, 0x82, 0x62, 0x47, 0x14, 0x33, 0xd5, 0xb6, 0x7e, 0x75, 0xbe,
+	0x36, 0xa7, 0xda, 0xce, 0x77, 0xab, 0xb8, 0x8d, 0xa9, 0x8e, 0xac, 0x0a, 0xac, 0x05, 0xf0, 0x20,
+	0x57, 0x2f, 0x13, 0x62, 0xc9, 0xb4, 0xdf, 0xa2, 0xd0, 0xc3, 0xd5, 0x54, 0xeb, 0x35, 0x98, 0x44,
+	0x75, 0x71, 0x48, 0x59, 0x20, 0x1a, 0xd7, 0xca, 0x75, 0x51, 0x38, 0x0f, 0x8a, 0x0c, 0x23, 0xae,
+	0x86, 0x3a, 0xe9, 0xaa, 0x68, 0xe3, 0x6e, 0xc7, 0x49, 0x97, 0x53, 0x8d, 0xe7, 0x34, 0x33, 0x3f,
+	0xa7, 0x1a, 0xb8, 0x57, 0xe1, 0x64, 0x37, 0xf2, 0x91, 0xc0, 0xef, 0x93, 0x75, 0xf9, 0x6f, 0x3f,
+	0x6f, 0x40, 0x51, 0x2e, 0x5c, 0xe2, 0x67, 0xaa, 0x6c, 0x0c, 0xfb, 0x01, 0xa5, 0xc6, 0xd6, 0xc4,
+	0xc5, 0xef, 0xa5, 0x82, 0xab, 0xf8, 0x01, 0xc7, 0x8b, 0x60, 0xa1, 0xcf, 0x54, 0x6a, 0xb8, 0xfc,
+	0x63, 0x1c, 0x8c, 0x57, 0x38, 0x81, 0x5f, 0xc1, 0x74, 0x7e, 0x67, 0x87, 0xae, 0x4b, 0xff, 0x0f,
+	0x6e, 0xbc, 0xb8, 0x09, 0x95, 0x4d, 0x67, 0xf1, 0xe4, 0xe7, 0xdf, 0xd3, 0xb1, 0xfb, 0xd6, 0x6c,
+	0xcf, 0x37, 0x25, 0x4f, 0x30, 0x06, 0xa0, 0x67, 0x5d, 0x96, 0x47, 0x94, 0xed, 0x22, 0xc6, 0xb3,
+	0x6b, 0x91, 0x4c, 0xd6, 0x48, 0x64, 0xe7, 0x2c, 0x38, 0xf8, 0xb1, 0x43, 0x0f, 0x4c, 0xe7, 0xb7,
+	0x67, 0x54, 0xdf, 0x39, 0x6a, 0x64, 0xdf, 0x43, 0xb7, 0x02, 0x7e, 0x01, 0x77, 0x72, 0x1b, 0xf1,
+	0x64, 0xc4, 0xeb, 0x5e, 0xc8, 0x78, 0x7e, 0x03, 0x28, 0x55, 0x30, 0x6e, 0x7d, 0x6b, 0x9f, 0xad,
+	0x6a, 0x5b, 0xef, 0x2e, 0x9a, 0xa6, 0x76, 0xd9, 0x34, 0xb5, 0x3f, 0x4d, 0x53, 0xfb, 0xde, 0x32,
+	0x0b, 0x97, 0x2d, 0xb3, 0xf0, 0xab, 0x65, 0x16, 0x3e, 0x97, 0x49, 0x20, 0x0e, 0xeb, 0x07, 0xb6,
+	0x47, 0x6b, 0x4e, 0x5a, 0x97, 0x32, 0x92, 0x9d, 0xd7, 0x50, 0x14, 0x39, 0xc7, 0xe9, 0x80, 0x44,
+	0x23, 0xc2, 0xfc, 0xa0, 0x98, 0xfc, 0xa5, 0xbd, 0xfa, 0x17, 0x00, 0x00, 0xff, 0xff, 0x56, 0x49,
+	0x80, 0x83, 0xb0, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -259,6 +473,12 @@ type MsgClient interface {
 	// TryUpgrade tallies all the votes for all the versions to determine if a
 	// quorum has been reached for a version.
 	TryUpgrade(ctx context.Context, in *MsgTryUpgrade, opts ...grpc.CallOption) (*MsgTryUpgradeResponse, error)
+	// CancelUpgrade cancels a pending upgrade before it reaches its upgrade
+	// height. It can only be executed by the governance module.
+	CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opts ...grpc.CallOption) (*MsgCancelUpgradeResponse, error)
+	// UpdateParams updates the parameters of the module. It can only be executed
+	// by the governance module.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -287,6 +507,24 @@ func (c *msgClient) TryUpgrade(ctx context.Context, in *MsgTryUpgrade, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opts ...grpc.CallOption) (*MsgCancelUpgradeResponse, error) {
+	out := new(MsgCancelUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/celestia.signal.v1.Msg/CancelUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.signal.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SignalVersion allows a validator to signal for a version.
@@ -294,6 +532,12 @@ type MsgServer interface {
 	// TryUpgrade tallies all the votes for all the versions to determine if a
 	// quorum has been reached for a version.
 	TryUpgrade(context.Context, *MsgTryUpgrade) (*MsgTryUpgradeResponse, error)
+	// CancelUpgrade cancels a pending upgrade before it reaches its upgrade
+	// height. It can only be executed by the governance module.
+	CancelUpgrade(context.Context, *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error)
+	// UpdateParams updates the parameters of the module. It can only be executed
+	// by the governance module.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -306,6 +550,12 @@ func (*UnimplementedMsgServer) SignalVersion(ctx context.Context, req *MsgSignal
 func (*UnimplementedMsgServer) TryUpgrade(ctx context.Context, req *MsgTryUpgrade) (*MsgTryUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TryUpgrade not implemented")
 }
+func (*UnimplementedMsgServer) CancelUpgrade(ctx context.Context, req *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelUpgrade not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -347,6 +597,42 @@ func _Msg_TryUpgrade_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.signal.v1.Msg/CancelUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelUpgrade(ctx, req.(*MsgCancelUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.signal.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.signal.v1.Msg",
@@ -360,6 +646,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TryUpgrade",
 			Handler:    _Msg_TryUpgrade_Handler,
 		},
+		{
+			MethodName: "CancelUpgrade",
+			Handler:    _Msg_CancelUpgrade_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/signal/v1/tx.proto",
@@ -385,6 +679,18 @@ func (m *MsgSignalVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ActivationWindow != nil {
+		{
+			size, err := m.ActivationWindow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Version != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Version))
 		i--
@@ -476,6 +782,129 @@ func (m *MsgTryUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -500,6 +929,10 @@ func (m *MsgSignalVersion) Size() (n int) {
 	if m.Version != 0 {
 		n += 1 + sovTx(uint64(m.Version))
 	}
+	if m.ActivationWindow != nil {
+		l = m.ActivationWindow.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -534,11 +967,61 @@ func (m *MsgTryUpgradeResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgCancelUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *MsgSignalVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -620,6 +1103,42 @@ func (m *MsgSignalVersion) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivationWindow == nil {
+				m.ActivationWindow = &ActivationWindow{}
+			}
+			if err := m.ActivationWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -823,6 +1342,335 @@ func (m *MsgTryUpgradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelUpgradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelUpgradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
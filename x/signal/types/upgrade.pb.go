@@ -22,6 +22,44 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// UpgradeStatus is the status of an upgrade in the upgrade history.
+type UpgradeStatus int32
+
+const (
+	// UPGRADE_STATUS_UNSPECIFIED is the default value.
+	UpgradeStatus_UPGRADE_STATUS_UNSPECIFIED UpgradeStatus = 0
+	// UPGRADE_STATUS_SCHEDULED is set when a version reached quorum and an
+	// upgrade height was chosen.
+	UpgradeStatus_UPGRADE_STATUS_SCHEDULED UpgradeStatus = 1
+	// UPGRADE_STATUS_CANCELLED is set when a pending upgrade was cancelled by
+	// governance before its upgrade height.
+	UpgradeStatus_UPGRADE_STATUS_CANCELLED UpgradeStatus = 2
+	// UPGRADE_STATUS_APPLIED is set when the network upgraded to the version.
+	UpgradeStatus_UPGRADE_STATUS_APPLIED UpgradeStatus = 3
+)
+
+var UpgradeStatus_name = map[int32]string{
+	0: "UPGRADE_STATUS_UNSPECIFIED",
+	1: "UPGRADE_STATUS_SCHEDULED",
+	2: "UPGRADE_STATUS_CANCELLED",
+	3: "UPGRADE_STATUS_APPLIED",
+}
+
+var UpgradeStatus_value = map[string]int32{
+	"UPGRADE_STATUS_UNSPECIFIED": 0,
+	"UPGRADE_STATUS_SCHEDULED":   1,
+	"UPGRADE_STATUS_CANCELLED":   2,
+	"UPGRADE_STATUS_APPLIED":     3,
+}
+
+func (x UpgradeStatus) String() string {
+	return proto.EnumName(UpgradeStatus_name, int32(x))
+}
+
+func (UpgradeStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7872d1b4aca9f179, []int{0}
+}
+
 // Upgrade is a type that represents a network upgrade.
 type Upgrade struct {
 	// AppVersion is the app version that has received a quorum of validators to
@@ -30,6 +68,8 @@ type Upgrade struct {
 	// UpgradeHeight is the height at which the network should upgrade to the
 	// AppVersion.
 	UpgradeHeight int64 `protobuf:"varint,2,opt,name=upgrade_height,json=upgradeHeight,proto3" json:"upgrade_height,omitempty"`
+	// Reason describes how the upgrade height was chosen.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (m *Upgrade) Reset()         { *m = Upgrade{} }
@@ -79,27 +119,325 @@ func (m *Upgrade) GetUpgradeHeight() int64 {
 	return 0
 }
 
+func (m *Upgrade) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// ActivationWindow is the range of heights at which a validator prefers the
+// version it signals for to activate.
+type ActivationWindow struct {
+	// StartHeight is the first height of the window. Zero means that the window
+	// starts as early as the upgrade height delay allows.
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// EndHeight is the last height of the window. Zero means that the window has
+	// no end.
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *ActivationWindow) Reset()         { *m = ActivationWindow{} }
+func (m *ActivationWindow) String() string { return proto.CompactTextString(m) }
+func (*ActivationWindow) ProtoMessage()    {}
+func (*ActivationWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7872d1b4aca9f179, []int{1}
+}
+func (m *ActivationWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActivationWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActivationWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActivationWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivationWindow.Merge(m, src)
+}
+func (m *ActivationWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActivationWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivationWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActivationWindow proto.InternalMessageInfo
+
+func (m *ActivationWindow) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *ActivationWindow) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// UpgradeRecord is an entry of the upgrade history.
+type UpgradeRecord struct {
+	// Upgrade is the upgrade that the record is about.
+	Upgrade *Upgrade `protobuf:"bytes,1,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
+	// Status is the status of the upgrade after the event.
+	Status UpgradeStatus `protobuf:"varint,2,opt,name=status,proto3,enum=celestia.signal.v1.UpgradeStatus" json:"status,omitempty"`
+	// Height is the height at which the event happened.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Reason is the reason of the event, e.g. the reason given by governance
+	// for cancelling the upgrade.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Participation is the participation of the validators in the signalling
+	// for the upgrade. It is set when the upgrade is scheduled.
+	Participation *Participation `protobuf:"bytes,5,opt,name=participation,proto3" json:"participation,omitempty"`
+}
+
+func (m *UpgradeRecord) Reset()         { *m = UpgradeRecord{} }
+func (m *UpgradeRecord) String() string { return proto.CompactTextString(m) }
+func (*UpgradeRecord) ProtoMessage()    {}
+func (*UpgradeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7872d1b4aca9f179, []int{2}
+}
+func (m *UpgradeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpgradeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeRecord.Merge(m, src)
+}
+func (m *UpgradeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeRecord proto.InternalMessageInfo
+
+func (m *UpgradeRecord) GetUpgrade() *Upgrade {
+	if m != nil {
+		return m.Upgrade
+	}
+	return nil
+}
+
+func (m *UpgradeRecord) GetStatus() UpgradeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return UpgradeStatus_UPGRADE_STATUS_UNSPECIFIED
+}
+
+func (m *UpgradeRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *UpgradeRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *UpgradeRecord) GetParticipation() *Participation {
+	if m != nil {
+		return m.Participation
+	}
+	return nil
+}
+
+// UpgradeHistory is the list of upgrade events, oldest first.
+type UpgradeHistory struct {
+	Records []*UpgradeRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (m *UpgradeHistory) Reset()         { *m = UpgradeHistory{} }
+func (m *UpgradeHistory) String() string { return proto.CompactTextString(m) }
+func (*UpgradeHistory) ProtoMessage()    {}
+func (*UpgradeHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7872d1b4aca9f179, []int{3}
+}
+func (m *UpgradeHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpgradeHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeHistory.Merge(m, src)
+}
+func (m *UpgradeHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeHistory proto.InternalMessageInfo
+
+func (m *UpgradeHistory) GetRecords() []*UpgradeRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+// Participation summarizes how much of the bonded validator set signalled for
+// an app version.
+type Participation struct {
+	AppVersion          uint64 `protobuf:"varint,1,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	SignalledPower      uint64 `protobuf:"varint,2,opt,name=signalled_power,json=signalledPower,proto3" json:"signalled_power,omitempty"`
+	TotalPower          uint64 `protobuf:"varint,3,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	SignalledValidators uint32 `protobuf:"varint,4,opt,name=signalled_validators,json=signalledValidators,proto3" json:"signalled_validators,omitempty"`
+	TotalValidators     uint32 `protobuf:"varint,5,opt,name=total_validators,json=totalValidators,proto3" json:"total_validators,omitempty"`
+}
+
+func (m *Participation) Reset()         { *m = Participation{} }
+func (m *Participation) String() string { return proto.CompactTextString(m) }
+func (*Participation) ProtoMessage()    {}
+func (*Participation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7872d1b4aca9f179, []int{4}
+}
+func (m *Participation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Participation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Participation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Participation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Participation.Merge(m, src)
+}
+func (m *Participation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Participation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Participation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Participation proto.InternalMessageInfo
+
+func (m *Participation) GetAppVersion() uint64 {
+	if m != nil {
+		return m.AppVersion
+	}
+	return 0
+}
+
+func (m *Participation) GetSignalledPower() uint64 {
+	if m != nil {
+		return m.SignalledPower
+	}
+	return 0
+}
+
+func (m *Participation) GetTotalPower() uint64 {
+	if m != nil {
+		return m.TotalPower
+	}
+	return 0
+}
+
+func (m *Participation) GetSignalledValidators() uint32 {
+	if m != nil {
+		return m.SignalledValidators
+	}
+	return 0
+}
+
+func (m *Participation) GetTotalValidators() uint32 {
+	if m != nil {
+		return m.TotalValidators
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("celestia.signal.v1.UpgradeStatus", UpgradeStatus_name, UpgradeStatus_value)
 	proto.RegisterType((*Upgrade)(nil), "celestia.signal.v1.Upgrade")
+	proto.RegisterType((*ActivationWindow)(nil), "celestia.signal.v1.ActivationWindow")
+	proto.RegisterType((*UpgradeRecord)(nil), "celestia.signal.v1.UpgradeRecord")
+	proto.RegisterType((*UpgradeHistory)(nil), "celestia.signal.v1.UpgradeHistory")
+	proto.RegisterType((*Participation)(nil), "celestia.signal.v1.Participation")
 }
 
 func init() { proto.RegisterFile("celestia/signal/v1/upgrade.proto", fileDescriptor_7872d1b4aca9f179) }
 
 var fileDescriptor_7872d1b4aca9f179 = []byte{
-	// 196 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x2f, 0xce, 0x4c, 0xcf, 0x4b, 0xcc, 0xd1, 0x2f, 0x33, 0xd4, 0x2f,
-	0x2d, 0x48, 0x2f, 0x4a, 0x4c, 0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0xa9,
-	0xd0, 0x83, 0xa8, 0xd0, 0x2b, 0x33, 0x54, 0x0a, 0xe4, 0x62, 0x0f, 0x85, 0x28, 0x12, 0x92, 0xe7,
-	0xe2, 0x4e, 0x2c, 0x28, 0x88, 0x2f, 0x4b, 0x2d, 0x2a, 0xce, 0xcc, 0xcf, 0x93, 0x60, 0x54, 0x60,
-	0xd4, 0x60, 0x09, 0xe2, 0x4a, 0x2c, 0x28, 0x08, 0x83, 0x88, 0x08, 0xa9, 0x72, 0xf1, 0x41, 0x0d,
-	0x8c, 0xcf, 0x48, 0xcd, 0x4c, 0xcf, 0x28, 0x91, 0x60, 0x52, 0x60, 0xd4, 0x60, 0x0e, 0xe2, 0x85,
-	0x8a, 0x7a, 0x80, 0x05, 0x9d, 0x7c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1,
-	0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21,
-	0xca, 0x28, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xe6, 0x96, 0xfc,
-	0xa2, 0x74, 0x38, 0x5b, 0x37, 0xb1, 0xa0, 0x40, 0xbf, 0x02, 0xe6, 0xfe, 0x92, 0xca, 0x82, 0xd4,
-	0xe2, 0x24, 0x36, 0xb0, 0xdb, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x63, 0x22, 0x2e, 0x9d,
-	0xdf, 0x00, 0x00, 0x00,
+	// 544 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x5f, 0x6f, 0x12, 0x4f,
+	0x14, 0x65, 0xba, 0x6d, 0x49, 0x2f, 0x3f, 0x28, 0x99, 0x9f, 0x69, 0x36, 0x55, 0x57, 0x4a, 0x62,
+	0x44, 0x13, 0x97, 0x80, 0xf1, 0xc1, 0xf8, 0x84, 0xb0, 0x96, 0x26, 0xd8, 0x6c, 0x16, 0xa8, 0x89,
+	0x2f, 0x64, 0xca, 0x4e, 0x60, 0x12, 0xdc, 0x99, 0xcc, 0x0e, 0xd4, 0x7e, 0x02, 0x5f, 0xfd, 0x58,
+	0x3e, 0xf6, 0xb1, 0x8f, 0x06, 0xbe, 0x85, 0x4f, 0x86, 0xd9, 0x59, 0xfe, 0xd4, 0xaa, 0x6f, 0xbb,
+	0xe7, 0x9c, 0x7b, 0xee, 0xbd, 0x67, 0x66, 0xa0, 0x34, 0xa4, 0x13, 0x1a, 0x2b, 0x46, 0xaa, 0x31,
+	0x1b, 0x45, 0x64, 0x52, 0x9d, 0xd5, 0xaa, 0x53, 0x31, 0x92, 0x24, 0xa4, 0xae, 0x90, 0x5c, 0x71,
+	0x8c, 0x53, 0x85, 0x9b, 0x28, 0xdc, 0x59, 0xad, 0xcc, 0x20, 0xdb, 0x4f, 0x44, 0xf8, 0x09, 0xe4,
+	0x88, 0x10, 0x83, 0x19, 0x95, 0x31, 0xe3, 0x91, 0x8d, 0x4a, 0xa8, 0xb2, 0x1b, 0x00, 0x11, 0xe2,
+	0x22, 0x41, 0xf0, 0x53, 0x28, 0x18, 0xc3, 0xc1, 0x98, 0xb2, 0xd1, 0x58, 0xd9, 0x3b, 0x25, 0x54,
+	0xb1, 0x82, 0xbc, 0x41, 0xdb, 0x1a, 0xc4, 0x47, 0xb0, 0x2f, 0x29, 0x89, 0x79, 0x64, 0x5b, 0x25,
+	0x54, 0x39, 0x08, 0xcc, 0x5f, 0xb9, 0x07, 0xc5, 0xc6, 0x50, 0xb1, 0x19, 0x51, 0x8c, 0x47, 0x1f,
+	0x59, 0x14, 0xf2, 0x2b, 0x7c, 0x02, 0xff, 0xc5, 0x8a, 0x48, 0x95, 0x1a, 0x22, 0x6d, 0x98, 0xd3,
+	0x98, 0xb1, 0x7b, 0x0c, 0x40, 0xa3, 0x70, 0xbb, 0xe3, 0x01, 0x8d, 0xc2, 0x84, 0x2e, 0xff, 0x44,
+	0x90, 0x37, 0x1b, 0x04, 0x74, 0xc8, 0x65, 0x88, 0x5f, 0x43, 0xd6, 0x0c, 0xa4, 0xed, 0x72, 0xf5,
+	0x87, 0xee, 0xef, 0x8b, 0xbb, 0x69, 0x4d, 0xaa, 0xc5, 0x6f, 0x60, 0x3f, 0x56, 0x44, 0x4d, 0x63,
+	0xdd, 0xa3, 0x50, 0x3f, 0xf9, 0x4b, 0x55, 0x57, 0x0b, 0x03, 0x53, 0xb0, 0xdc, 0xd8, 0x8c, 0x67,
+	0xe9, 0xf1, 0xcc, 0xdf, 0x46, 0x12, 0xbb, 0x9b, 0x49, 0xe0, 0x53, 0xc8, 0x0b, 0x22, 0x15, 0x1b,
+	0x32, 0xa1, 0xc3, 0xb0, 0xf7, 0xf4, 0x9c, 0xf7, 0x76, 0xf4, 0x37, 0x85, 0xc1, 0x76, 0x5d, 0xf9,
+	0x03, 0x14, 0xcc, 0x44, 0x6d, 0x16, 0x2b, 0x2e, 0xaf, 0xf1, 0x5b, 0xc8, 0x4a, 0x1d, 0x43, 0x6c,
+	0xa3, 0x92, 0xf5, 0x27, 0xd3, 0xad, 0xc0, 0x82, 0xb4, 0xa2, 0x7c, 0x8b, 0x20, 0xbf, 0xd5, 0xef,
+	0xdf, 0x77, 0xe2, 0x19, 0x1c, 0x26, 0xb6, 0x13, 0x1a, 0x0e, 0x04, 0xbf, 0xa2, 0x52, 0xc7, 0xb7,
+	0x1b, 0x14, 0x56, 0xb0, 0xbf, 0x44, 0x97, 0x4e, 0x8a, 0x2b, 0x32, 0x31, 0x22, 0x2b, 0x71, 0xd2,
+	0x50, 0x22, 0xa8, 0xc1, 0x83, 0xb5, 0xd3, 0x8c, 0x4c, 0x58, 0x48, 0x14, 0x97, 0xb1, 0x8e, 0x2e,
+	0x1f, 0xfc, 0xbf, 0xe2, 0x2e, 0x56, 0x14, 0x7e, 0x0e, 0xc5, 0xc4, 0x73, 0x43, 0xbe, 0xa7, 0xe5,
+	0x87, 0x1a, 0x5f, 0x4b, 0x5f, 0x7c, 0x5d, 0x5f, 0x93, 0xe4, 0xf0, 0xb0, 0x03, 0xc7, 0x7d, 0xff,
+	0x34, 0x68, 0xb4, 0xbc, 0x41, 0xb7, 0xd7, 0xe8, 0xf5, 0xbb, 0x83, 0xfe, 0x79, 0xd7, 0xf7, 0x9a,
+	0x67, 0xef, 0xcf, 0xbc, 0x56, 0x31, 0x83, 0x1f, 0x81, 0x7d, 0x87, 0xef, 0x36, 0xdb, 0x5e, 0xab,
+	0xdf, 0xf1, 0x5a, 0x45, 0x74, 0x0f, 0xdb, 0x6c, 0x9c, 0x37, 0xbd, 0xce, 0x92, 0xdd, 0xc1, 0xc7,
+	0x70, 0x74, 0x87, 0x6d, 0xf8, 0x7e, 0x67, 0xe9, 0x6b, 0xbd, 0xeb, 0x7c, 0x9f, 0x3b, 0xe8, 0x66,
+	0xee, 0xa0, 0x1f, 0x73, 0x07, 0x7d, 0x5b, 0x38, 0x99, 0x9b, 0x85, 0x93, 0xb9, 0x5d, 0x38, 0x99,
+	0x4f, 0xf5, 0x11, 0x53, 0xe3, 0xe9, 0xa5, 0x3b, 0xe4, 0x9f, 0xab, 0xe9, 0xa1, 0x71, 0x39, 0x5a,
+	0x7d, 0xbf, 0x24, 0x42, 0x54, 0xbf, 0xa4, 0xcf, 0x5b, 0x5d, 0x0b, 0x1a, 0x5f, 0xee, 0xeb, 0xa7,
+	0xfd, 0xea, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7a, 0xd6, 0x11, 0x22, 0xfe, 0x03, 0x00, 0x00,
 }
 
 func (m *Upgrade) Marshal() (dAtA []byte, err error) {
@@ -122,6 +460,13 @@ func (m *Upgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.UpgradeHeight != 0 {
 		i = encodeVarintUpgrade(dAtA, i, uint64(m.UpgradeHeight))
 		i--
@@ -135,64 +480,331 @@ func (m *Upgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
-	offset -= sovUpgrade(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ActivationWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Upgrade) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ActivationWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivationWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AppVersion != 0 {
-		n += 1 + sovUpgrade(uint64(m.AppVersion))
+	if m.EndHeight != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.UpgradeHeight != 0 {
-		n += 1 + sovUpgrade(uint64(m.UpgradeHeight))
+	if m.StartHeight != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovUpgrade(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *UpgradeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozUpgrade(x uint64) (n int) {
-	return sovUpgrade(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *UpgradeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Upgrade) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowUpgrade
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+
+func (m *UpgradeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Participation != nil {
+		{
+			size, err := m.Participation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
+			i -= size
+			i = encodeVarintUpgrade(dAtA, i, uint64(size))
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Upgrade: wiretype end group for non-group")
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Upgrade != nil {
+		{
+			size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintUpgrade(dAtA, i, uint64(size))
 		}
-		if fieldNum <= 0 {
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUpgrade(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Participation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Participation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Participation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalValidators != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.TotalValidators))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SignalledValidators != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.SignalledValidators))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalPower != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SignalledPower != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.SignalledPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AppVersion != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.AppVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUpgrade(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Upgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppVersion != 0 {
+		n += 1 + sovUpgrade(uint64(m.AppVersion))
+	}
+	if m.UpgradeHeight != 0 {
+		n += 1 + sovUpgrade(uint64(m.UpgradeHeight))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	return n
+}
+
+func (m *ActivationWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovUpgrade(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovUpgrade(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *UpgradeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Upgrade != nil {
+		l = m.Upgrade.Size()
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovUpgrade(uint64(m.Status))
+	}
+	if m.Height != 0 {
+		n += 1 + sovUpgrade(uint64(m.Height))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.Participation != nil {
+		l = m.Participation.Size()
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	return n
+}
+
+func (m *UpgradeHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovUpgrade(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Participation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppVersion != 0 {
+		n += 1 + sovUpgrade(uint64(m.AppVersion))
+	}
+	if m.SignalledPower != 0 {
+		n += 1 + sovUpgrade(uint64(m.SignalledPower))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovUpgrade(uint64(m.TotalPower))
+	}
+	if m.SignalledValidators != 0 {
+		n += 1 + sovUpgrade(uint64(m.SignalledValidators))
+	}
+	if m.TotalValidators != 0 {
+		n += 1 + sovUpgrade(uint64(m.TotalValidators))
+	}
+	return n
+}
+
+func sovUpgrade(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUpgrade(x uint64) (n int) {
+	return sovUpgrade(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Upgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Upgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
 			return fmt.Errorf("proto: Upgrade: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
@@ -234,6 +846,547 @@ func (m *Upgrade) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivationWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivationWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivationWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradeRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Upgrade == nil {
+				m.Upgrade = &Upgrade{}
+			}
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= UpgradeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Participation == nil {
+				m.Participation = &Participation{}
+			}
+			if err := m.Participation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradeHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &UpgradeRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Participation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Participation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Participation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppVersion", wireType)
+			}
+			m.AppVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalledPower", wireType)
+			}
+			m.SignalledPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignalledPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalledValidators", wireType)
+			}
+			m.SignalledValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignalledValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalValidators", wireType)
+			}
+			m.TotalValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUpgrade(dAtA[iNdEx:])